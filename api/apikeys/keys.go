@@ -0,0 +1,31 @@
+// Package apikeys generates the API keys clients authenticate with
+package apikeys
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// Keys are keyPrefix followed by 64 hex digits
+const (
+	keyPrefix   = "lib_"
+	prefixBytes = 8
+)
+
+// GenerateKey returns a new random key and the prefix shown to tell it apart
+func GenerateKey() (key string, prefix string, err error) {
+	secret := make([]byte, 32)
+	_, err = rand.Read(secret)
+	if err != nil {
+		return "", "", err
+	}
+	key = keyPrefix + hex.EncodeToString(secret)
+	return key, key[:len(keyPrefix)+prefixBytes], nil
+}
+
+// HashKey returns the hash a key is stored and looked up by
+func HashKey(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"github.com/minand-mohan/library-app-api/database/dberrors"
+	"github.com/minand-mohan/library-app-api/database/models"
+)
+
+// Store a new API key
+func (repo *APIKeyRepositoryImpl) CreateAPIKey(key *models.APIKey) error {
+	result := repo.db.Create(key)
+	if result.Error != nil {
+		return dberrors.Translate(result.Error)
+	}
+	return nil
+}
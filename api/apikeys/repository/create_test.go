@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/minand-mohan/library-app-api/database/models"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+func TestCreateAPIKey(t *testing.T) {
+	test_id := "123e4567-e89b-12d3-a456-426614174000"
+	query := regexp.QuoteMeta(`INSERT INTO "api_keys" ("name","prefix","key_hash","created_at","revoked_at") VALUES ($1,$2,$3,$4,$5) RETURNING "id"`)
+
+	tc := []struct {
+		name          string
+		mockFunction  func(mock sqlmock.Sqlmock, key *models.APIKey) error
+		expectedError error
+	}{
+		{
+			name: "API key created successfully",
+			mockFunction: func(mock sqlmock.Sqlmock, key *models.APIKey) error {
+				mock.ExpectBegin()
+				mock.ExpectQuery(query).
+					WithArgs(*key.Name, *key.Prefix, *key.KeyHash, *key.CreatedAt, nil).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(test_id))
+				mock.ExpectCommit()
+				return nil
+			},
+			expectedError: nil,
+		},
+		{
+			name: "API key creation failed",
+			mockFunction: func(mock sqlmock.Sqlmock, key *models.APIKey) error {
+				err := sqlmock.ErrCancelled
+				mock.ExpectBegin()
+				mock.ExpectQuery(query).
+					WillReturnError(err)
+				mock.ExpectRollback()
+				return err
+			},
+			expectedError: sqlmock.ErrCancelled,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			key := generateAPIKey01()
+			mock, apiKeyRepository := createAPIKeyRepository()
+			tt.mockFunction(mock, &key)
+			err := apiKeyRepository.CreateAPIKey(&key)
+			if err != tt.expectedError {
+				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
+			}
+			if err == nil && key.ID.String() != test_id {
+				t.Errorf("Expected id: %s, got: %v", test_id, key.ID)
+			}
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"github.com/minand-mohan/library-app-api/database/dberrors"
+	"github.com/minand-mohan/library-app-api/database/models"
+)
+
+// Retrieve the unrevoked API key with the given hash
+func (repo *APIKeyRepositoryImpl) FindActiveByKeyHash(keyHash string) (*models.APIKey, error) {
+	var key models.APIKey
+	result := repo.db.Where("key_hash = ? AND revoked_at IS NULL", keyHash).First(&key)
+	if result.Error != nil {
+		return nil, dberrors.Translate(result.Error)
+	}
+	return &key, nil
+}
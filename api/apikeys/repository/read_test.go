@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/minand-mohan/library-app-api/database/dberrors"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+func TestFindActiveByKeyHash(t *testing.T) {
+	test_key := generateAPIKey01()
+	test_id := "123e4567-e89b-12d3-a456-426614174000"
+	query := regexp.QuoteMeta(`SELECT * FROM "api_keys" WHERE key_hash = $1 AND revoked_at IS NULL ORDER BY "api_keys"."id" LIMIT 1`)
+
+	tc := []struct {
+		name          string
+		mockFunction  func(mock sqlmock.Sqlmock)
+		expectedError error
+	}{
+		{
+			name: "Find API key successfully",
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(*test_key.KeyHash).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "prefix", "key_hash", "created_at"}).
+						AddRow(test_id, *test_key.Name, *test_key.Prefix, *test_key.KeyHash, *test_key.CreatedAt))
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Unknown or revoked API key",
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(*test_key.KeyHash).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "prefix", "key_hash", "created_at"}))
+			},
+			expectedError: dberrors.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, apiKeyRepository := createAPIKeyRepository()
+			tt.mockFunction(mock)
+			key, err := apiKeyRepository.FindActiveByKeyHash(*test_key.KeyHash)
+			if !errors.Is(err, tt.expectedError) {
+				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
+			}
+			if err == nil && key.ID.String() != test_id {
+				t.Errorf("Expected id: %s, got: %v", test_id, key.ID)
+			}
+		})
+	}
+}
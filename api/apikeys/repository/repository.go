@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"github.com/minand-mohan/library-app-api/database/models"
+	"gorm.io/gorm"
+)
+
+type APIKeyRepository interface {
+	CreateAPIKey(key *models.APIKey) error
+	FindActiveByKeyHash(keyHash string) (*models.APIKey, error)
+}
+
+type APIKeyRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewAPIKeyRepository(db *gorm.DB) APIKeyRepository {
+	return &APIKeyRepositoryImpl{db}
+}
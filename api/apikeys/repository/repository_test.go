@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/minand-mohan/library-app-api/database/models"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func createAPIKeyRepository() (sqlmock.Sqlmock, APIKeyRepository) {
+	var (
+		db   *sql.DB
+		mock sqlmock.Sqlmock
+	)
+
+	db, mock, _ = sqlmock.New()
+	dialector := postgres.New(postgres.Config{
+		DSN:                  "sqlmock_db_0",
+		DriverName:           "postgres",
+		Conn:                 db,
+		PreferSimpleProtocol: true,
+	})
+	sDb, _ := gorm.Open(dialector, &gorm.Config{})
+
+	apiKeyRepository := NewAPIKeyRepository(sDb)
+
+	return mock, apiKeyRepository
+}
+
+func generateAPIKey01() models.APIKey {
+	//initialize variables
+	test_name := "circulation desk"
+	test_prefix := "lib_0123abcd"
+	test_key_hash := "5e884898da28047151d0e56f8dc6292773603d0d6aabbdd62a11ef721d1542d8"
+	test_created_at := time.Now().UTC()
+	return models.APIKey{
+		Name:      &test_name,
+		Prefix:    &test_prefix,
+		KeyHash:   &test_key_hash,
+		CreatedAt: &test_created_at,
+	}
+}
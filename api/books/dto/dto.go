@@ -0,0 +1,18 @@
+package dto
+
+type BookRequestBody struct {
+	Title           string `json:"title"`
+	Subtitle        string `json:"subtitle"`
+	ISBN10          string `json:"isbn10"`
+	ISBN13          string `json:"isbn13"`
+	PublicationYear int    `json:"publication_year"`
+	Language        string `json:"language"`
+	PageCount       int    `json:"page_count"`
+	Edition         string `json:"edition"`
+}
+
+type BookQueryParams struct {
+	Title    string `query:"title"`
+	ISBN     string `query:"isbn"`
+	Language string `query:"language"`
+}
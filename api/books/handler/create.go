@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"fmt"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/minand-mohan/library-app-api/api/books/dto"
+	"github.com/minand-mohan/library-app-api/api/response"
+	"github.com/minand-mohan/library-app-api/utils"
+)
+
+func (handler *BookHandler) CreateBook(ctx *fiber.Ctx) error {
+	log := utils.ContextLogger(ctx)
+	log.Info("Create book")
+	bookReq := &dto.BookRequestBody{}
+	err := response.DecodeJSONObject(ctx.Request().Body(), bookReq)
+	if err != nil {
+		log.Error(fmt.Sprintf("Error while unmarshalling request body %v", err))
+		responseBody := response.GetValidationErrorHTTPResponseBody(err)
+		err := response.WriteHTTPResponse(ctx, 400, responseBody)
+		if err != nil {
+			log.Error(fmt.Sprintf("Error while writing response %v", err))
+			return err
+		}
+		return nil
+	}
+	err = handler.validator.ValidateBook(bookReq)
+	if err != nil {
+		log.Error(fmt.Sprintf("Error while validating request body %v", err))
+		responseBody := response.HTTPResponse{
+			Code:    400,
+			Message: "Bad request, invalid request body",
+			Content: map[string]interface{}{},
+		}
+		err = response.WriteHTTPResponse(ctx, 400, &responseBody)
+		if err != nil {
+			log.Error(fmt.Sprintf("Error while writing response %v", err))
+			return err
+		}
+		return nil
+	}
+
+	responseBody, err := handler.service.CreateBook(bookReq)
+	if err != nil {
+		log.Error(fmt.Sprintf("BookHandler: Error while creating book %v", err))
+		err = response.WriteHTTPResponse(ctx, responseBody.Code, responseBody)
+		if err != nil {
+			log.Error(fmt.Sprintf("Error while writing response %v", err))
+			return err
+		}
+		return nil
+	}
+
+	response.WriteHTTPResponse(ctx, 200, responseBody)
+	return nil
+
+}
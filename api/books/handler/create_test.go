@@ -0,0 +1,156 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	gomock "github.com/golang/mock/gomock"
+	servicemocks "github.com/minand-mohan/library-app-api/api/books/service/mocks"
+	validatormocks "github.com/minand-mohan/library-app-api/api/books/validator/mocks"
+	"github.com/minand-mohan/library-app-api/api/response"
+)
+
+func TestCreateBook(t *testing.T) {
+
+	testCases := []struct {
+		name                      string
+		requestBody               map[string]interface{}
+		mockServiceExpectResponse *response.HTTPResponse
+		mockServiceExpectError    error
+		mockValidatorExpectError  error
+		expectedStatus            int
+		expectedMessage           string
+	}{
+		{
+			name: "Create book with valid request body",
+			requestBody: map[string]interface{}{
+				"title":  "The Go Programming Language",
+				"isbn13": "9780134190440",
+			},
+			mockServiceExpectResponse: &response.HTTPResponse{
+				Code:    200,
+				Message: "Book created successfully",
+				Content: map[string]interface{}{},
+			},
+			mockServiceExpectError:   nil,
+			mockValidatorExpectError: nil,
+			expectedStatus:           200,
+			expectedMessage:          "Book created successfully",
+		},
+		{
+			name: "Create book with invalid request body - book already exists",
+			requestBody: map[string]interface{}{
+				"title":  "The Go Programming Language",
+				"isbn13": "9780134190440",
+			},
+			mockServiceExpectResponse: &response.HTTPResponse{
+				Code:    400,
+				Message: "Bad request, book already exists",
+				Content: map[string]interface{}{},
+			},
+			mockServiceExpectError:   errors.New("Book already exists"),
+			mockValidatorExpectError: nil,
+			expectedStatus:           400,
+			expectedMessage:          "Bad request, book already exists",
+		},
+		{
+			name: "Create book with empty isbn",
+			requestBody: map[string]interface{}{
+				"title": "The Go Programming Language",
+			},
+			mockServiceExpectResponse: nil,
+			mockServiceExpectError:    nil,
+			mockValidatorExpectError:  errors.New("ISBN is empty"),
+			expectedStatus:            400,
+			expectedMessage:           "Bad request, invalid request body",
+		},
+		{
+			name: "Create book with service error",
+			requestBody: map[string]interface{}{
+				"title":  "The Go Programming Language",
+				"isbn13": "",
+			},
+			mockServiceExpectResponse: &response.HTTPResponse{
+				Code:    500,
+				Message: "Internal Server Error",
+				Content: map[string]interface{}{},
+			},
+			mockServiceExpectError:   errors.New("Internal Server Error"),
+			mockValidatorExpectError: nil,
+			expectedStatus:           500,
+			expectedMessage:          "Internal Server Error",
+		},
+		{
+			name:            "Create book with null request body",
+			requestBody:     nil,
+			expectedStatus:  400,
+			expectedMessage: "Bad request, invalid request body",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Create a new fiber context for testing
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			app := setupApp()
+			app.Post("/books", func(c *fiber.Ctx) error {
+				// logger := utils.NewLogger()
+				validator := validatormocks.NewMockBookValidator(mockCtrl)
+				service := servicemocks.NewMockBookService(mockCtrl)
+				if tc.mockServiceExpectResponse != nil {
+					service.EXPECT().CreateBook(gomock.Any()).Return(tc.mockServiceExpectResponse, tc.mockServiceExpectError)
+				}
+				if tc.requestBody != nil {
+					validator.EXPECT().ValidateBook(gomock.Any()).Return(tc.mockValidatorExpectError)
+				}
+				handler := NewBookHandler(service, validator)
+				t.Logf("Handler: %v", handler)
+				return handler.CreateBook(c)
+			})
+			requestBody, err := json.Marshal(tc.requestBody)
+			if err != nil {
+				t.Errorf("Error while marshalling request body: %v", err)
+			}
+			t.Logf("Request body: %v", tc.requestBody)
+			request := httptest.NewRequest("POST", "/books/", strings.NewReader(string(requestBody)))
+
+			response, err := app.Test(request)
+			if err != nil {
+				t.Errorf("Error while making request %v", err)
+			}
+
+			if response.StatusCode != tc.expectedStatus {
+				t.Errorf("Expected status code %d, got %d", tc.expectedStatus, response.StatusCode)
+			}
+
+			// Read the entire response body
+			bodyBytes, err := ioutil.ReadAll(response.Body)
+			if err != nil {
+				t.Errorf("Error while reading response body: %v", err)
+			}
+
+			// Define a map or struct to hold the response body
+			var responseBody map[string]interface{}
+
+			// Parse the JSON response body
+			err = json.Unmarshal(bodyBytes, &responseBody)
+			if err != nil {
+				t.Errorf("Error while parsing response body: %v", err)
+			}
+
+			// Now you can access the fields of the response body
+			message := responseBody["message"].(string)
+			if message != tc.expectedMessage {
+				t.Errorf("Expected message %s, got %s", tc.expectedMessage, message)
+			}
+		})
+	}
+
+}
@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"github.com/minand-mohan/library-app-api/api/books/service"
+	"github.com/minand-mohan/library-app-api/api/books/validator"
+)
+
+type BookHandler struct {
+	service   service.BookService
+	validator validator.BookValidator
+}
+
+func NewBookHandler(service service.BookService, validator validator.BookValidator) *BookHandler {
+	return &BookHandler{
+		service:   service,
+		validator: validator,
+	}
+}
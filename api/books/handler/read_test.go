@@ -0,0 +1,205 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	gomock "github.com/golang/mock/gomock"
+	servicemocks "github.com/minand-mohan/library-app-api/api/books/service/mocks"
+	"github.com/minand-mohan/library-app-api/api/books/validator"
+	validatormocks "github.com/minand-mohan/library-app-api/api/books/validator/mocks"
+	"github.com/minand-mohan/library-app-api/api/response"
+	"github.com/minand-mohan/library-app-api/utils"
+)
+
+func TestFindAllBooks(t *testing.T) {
+	testCases := []struct {
+		name                      string
+		queryParams               string
+		mockServiceExpectResponse *response.HTTPResponse
+		mockServiceExpectError    error
+		mockValidatorExpectError  error
+		expectedStatus            int
+		expectedMessage           string
+	}{
+		{
+			name:        "Find all books with valid query params",
+			queryParams: "title=go&isbn=9780134190440",
+			mockServiceExpectResponse: &response.HTTPResponse{
+				Code:    200,
+				Message: "Books found successfully",
+				Content: map[string]interface{}{},
+			},
+			mockServiceExpectError:   nil,
+			mockValidatorExpectError: nil,
+			expectedStatus:           200,
+			expectedMessage:          "Books found successfully",
+		},
+		{
+			name:        "Find books that do not exist",
+			queryParams: "title=rust",
+			mockServiceExpectResponse: &response.HTTPResponse{
+				Code:    404,
+				Message: "No Books found",
+				Content: map[string]interface{}{},
+			},
+			mockServiceExpectError:   errors.New("No Books found"),
+			mockValidatorExpectError: nil,
+			expectedStatus:           404,
+			expectedMessage:          "No Books found",
+		},
+		{
+			name:                      "Find all books with invalid query params",
+			queryParams:               "isbn=invalid-isbn",
+			mockServiceExpectResponse: nil,
+			mockServiceExpectError:    nil,
+			mockValidatorExpectError:  errors.New("ISBN is invalid"),
+			expectedStatus:            400,
+			expectedMessage:           "Bad request, invalid query params",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Create a new fiber context for testing
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			app := setupApp()
+			app.Get("/books", func(c *fiber.Ctx) error {
+				validator := validatormocks.NewMockBookValidator(mockCtrl)
+				service := servicemocks.NewMockBookService(mockCtrl)
+				if tc.mockServiceExpectResponse != nil {
+					service.EXPECT().FindAllBooks(gomock.Any()).Return(tc.mockServiceExpectResponse, tc.mockServiceExpectError)
+				}
+				validator.EXPECT().ValidateBookQueryParams(gomock.Any()).Return(tc.mockValidatorExpectError)
+				handler := NewBookHandler(service, validator)
+				return handler.FindAllBooks(c)
+			})
+			request := httptest.NewRequest("GET", "/books?"+tc.queryParams, nil)
+
+			response, err := app.Test(request)
+			if err != nil {
+				t.Errorf("Error while making request %v", err)
+			}
+
+			if response.StatusCode != tc.expectedStatus {
+				t.Errorf("Expected status code %d, got %d", tc.expectedStatus, response.StatusCode)
+			}
+
+			// Read the entire response body
+			bodyBytes, err := ioutil.ReadAll(response.Body)
+			if err != nil {
+				t.Errorf("Error while reading response body: %v", err)
+			}
+
+			// Define a map or struct to hold the response body
+			var responseBody map[string]interface{}
+
+			// Parse the JSON response body
+			err = json.Unmarshal(bodyBytes, &responseBody)
+			if err != nil {
+				t.Errorf("Error while parsing response body: %v", err)
+			}
+
+			// Now you can access the fields of the response body
+			message := responseBody["message"].(string)
+			if message != tc.expectedMessage {
+				t.Errorf("Expected message %s, got %s", tc.expectedMessage, message)
+			}
+		})
+	}
+}
+func TestFindByBookId(t *testing.T) {
+	testCases := []struct {
+		name                      string
+		id                        string
+		mockServiceExpectResponse *response.HTTPResponse
+		mockServiceExpectError    error
+		expectedStatus            int
+		expectedMessage           string
+	}{
+		{
+			name: "Find book by id with valid id",
+			id:   "d3b3b3b3-3b3b-3b3b-3b3b-3b3b3b3b3b3b",
+			mockServiceExpectResponse: &response.HTTPResponse{
+				Code:    200,
+				Message: "Book found successfully",
+				Content: map[string]interface{}{},
+			},
+			mockServiceExpectError: nil,
+			expectedStatus:         200,
+			expectedMessage:        "Book found successfully",
+		},
+		{
+			name:                      "Find book by id with invalid id",
+			id:                        "invalid-id",
+			mockServiceExpectResponse: nil,
+			mockServiceExpectError:    nil,
+			expectedStatus:            400,
+			expectedMessage:           "Bad request, invalid id",
+		},
+		{
+			name: "Find book by id that does not exist",
+			id:   "d3b3b3b3-3b3b-3b3b-3b3b-3b3b3b3b3b3b",
+			mockServiceExpectResponse: &response.HTTPResponse{
+				Code:    404,
+				Message: "Book not found",
+				Content: map[string]interface{}{},
+			},
+			mockServiceExpectError: errors.New("Book not found"),
+			expectedStatus:         404,
+			expectedMessage:        "Book not found",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			app := setupApp()
+			app.Get("/books/:id", func(c *fiber.Ctx) error {
+				logger := utils.NewLogger()
+				service := servicemocks.NewMockBookService(mockCtrl)
+				validator := validator.NewBookValidator(*logger)
+				if tc.mockServiceExpectResponse != nil {
+					service.EXPECT().FindByBookId(gomock.Any()).Return(tc.mockServiceExpectResponse, tc.mockServiceExpectError)
+				}
+				handler := NewBookHandler(service, validator)
+				return handler.FindByBookId(c)
+			})
+			request := httptest.NewRequest("GET", "/books/"+tc.id, nil)
+
+			response, err := app.Test(request)
+			if err != nil {
+				t.Errorf("Error while making request %v", err)
+			}
+
+			if response.StatusCode != tc.expectedStatus {
+				t.Errorf("Expected status code %d, got %d", tc.expectedStatus, response.StatusCode)
+			}
+
+			bodyBytes, err := ioutil.ReadAll(response.Body)
+			if err != nil {
+				t.Errorf("Error while reading response body: %v", err)
+			}
+
+			var responseBody map[string]interface{}
+
+			err = json.Unmarshal(bodyBytes, &responseBody)
+			if err != nil {
+				t.Errorf("Error while parsing response body: %v", err)
+			}
+
+			message := responseBody["message"].(string)
+			if message != tc.expectedMessage {
+				t.Errorf("Expected message %s, got %s", tc.expectedMessage, message)
+			}
+		})
+	}
+}
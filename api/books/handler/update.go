@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"fmt"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/api/books/dto"
+	"github.com/minand-mohan/library-app-api/api/response"
+	"github.com/minand-mohan/library-app-api/utils"
+)
+
+func (handler *BookHandler) UpdateByBookId(ctx *fiber.Ctx) error {
+	log := utils.ContextLogger(ctx)
+	log.Info("Update book by id")
+	id := ctx.Params("id")
+	uuid, err := uuid.Parse(id)
+	if err != nil {
+		log.Error(fmt.Sprintf("Error while parsing uuid %v", err))
+		responseBody := response.HTTPResponse{
+			Code:    400,
+			Message: "Bad request, invalid id",
+			Content: map[string]interface{}{},
+		}
+		err = response.WriteHTTPResponse(ctx, 400, &responseBody)
+		if err != nil {
+			log.Error(fmt.Sprintf("Error while writing response %v", err))
+			return err
+		}
+		return nil
+	}
+	bookReq := &dto.BookRequestBody{}
+	err = response.DecodeJSONObject(ctx.Request().Body(), bookReq)
+	if err != nil {
+		log.Error(fmt.Sprintf("Error while unmarshalling request body %v", err))
+		responseBody := response.GetValidationErrorHTTPResponseBody(err)
+		err := response.WriteHTTPResponse(ctx, 400, responseBody)
+		if err != nil {
+			log.Error(fmt.Sprintf("Error while writing response %v", err))
+			return err
+		}
+		return nil
+	}
+	err = handler.validator.ValidateBook(bookReq)
+	if err != nil {
+		log.Error(fmt.Sprintf("Error while validating request body %v", err))
+		responseBody := response.HTTPResponse{
+			Code:    400,
+			Message: "Bad request, invalid request body",
+			Content: map[string]interface{}{},
+		}
+		err = response.WriteHTTPResponse(ctx, 400, &responseBody)
+		if err != nil {
+			log.Error(fmt.Sprintf("Error while writing response %v", err))
+			return err
+		}
+		return nil
+	}
+	responseBody, err := handler.service.UpdateByBookId(uuid, bookReq)
+	if err != nil {
+		log.Error(fmt.Sprintf("BookHandler: Error while updating book by id %v", err))
+		err = response.WriteHTTPResponse(ctx, responseBody.Code, responseBody)
+		if err != nil {
+			log.Error(fmt.Sprintf("Error while writing response %v", err))
+			return err
+		}
+		return nil
+	}
+	err = response.WriteHTTPResponse(ctx, 200, responseBody)
+	if err != nil {
+		log.Error(fmt.Sprintf("Error while writing response %v", err))
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"github.com/minand-mohan/library-app-api/database/dberrors"
+	"github.com/minand-mohan/library-app-api/database/models"
+)
+
+// CreateBook creates a new book
+func (repo *BookRepositoryImpl) CreateBook(bookObj *models.Book) error {
+	result := repo.db.Create(&bookObj)
+	if result.Error != nil {
+		return dberrors.Translate(result.Error)
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/minand-mohan/library-app-api/database/models"
+
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+func TestCreateBook(t *testing.T) {
+	test_id := "123e4567-e89b-12d3-a456-426614174000"
+	newBook := func() *models.Book {
+		book := generateRandomBook01()
+		book.ID = nil
+		return &book
+	}
+
+	tc := []struct {
+		name          string
+		book          *models.Book
+		mockFunction  func(mock sqlmock.Sqlmock, book *models.Book) error
+		expectedError error
+	}{
+		{
+			name: "Book created successfully",
+			book: newBook(),
+			mockFunction: func(mock sqlmock.Sqlmock, book *models.Book) error {
+				query := regexp.QuoteMeta(`INSERT INTO "books" ("title","subtitle","isbn10","isbn13","publication_year","language","page_count","edition") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`)
+				mock.ExpectBegin()
+				mock.ExpectQuery(query).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(test_id))
+				mock.ExpectCommit()
+				return nil
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Book creation failed",
+			book: newBook(),
+			mockFunction: func(mock sqlmock.Sqlmock, book *models.Book) error {
+				err := sqlmock.ErrCancelled
+				query := regexp.QuoteMeta(`INSERT INTO "books" ("title","subtitle","isbn10","isbn13","publication_year","language","page_count","edition") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`)
+				mock.ExpectBegin()
+				mock.ExpectQuery(query).
+					WillReturnError(err)
+				mock.ExpectRollback()
+				return err
+			},
+			expectedError: sqlmock.ErrCancelled,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, bookRepository := createBookRepository()
+			tt.mockFunction(mock, tt.book)
+			err := bookRepository.CreateBook(tt.book)
+			if err != tt.expectedError {
+				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
+			}
+		})
+	}
+}
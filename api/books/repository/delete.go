@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/database/dberrors"
+	"github.com/minand-mohan/library-app-api/database/models"
+)
+
+// DeleteByBookId deletes a book by id
+func (repo *BookRepositoryImpl) DeleteByBookId(id uuid.UUID) error {
+	var book models.Book
+	result := repo.db.Delete(&book, id)
+	if result.Error != nil {
+		return dberrors.Translate(result.Error)
+	}
+	return nil
+}
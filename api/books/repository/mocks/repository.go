@@ -0,0 +1,108 @@
+package mocks
+
+import (
+	"reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/api/books/dto"
+	"github.com/minand-mohan/library-app-api/database/models"
+)
+
+// MockBookRepository is a mock of BookRepository interface.
+type MockBookRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBookRepositoryMockRecorder
+}
+
+// MockBookRepositoryMockRecorder is the mock recorder for MockBookRepository.
+type MockBookRepositoryMockRecorder struct {
+	mock *MockBookRepository
+}
+
+// NewMockBookRepository creates a new mock instance.
+func NewMockBookRepository(ctrl *gomock.Controller) *MockBookRepository {
+	mock := &MockBookRepository{ctrl: ctrl}
+	mock.recorder = &MockBookRepositoryMockRecorder{mock}
+	return mock
+}
+
+func (m *MockBookRepository) EXPECT() *MockBookRepositoryMockRecorder {
+	return m.recorder
+}
+
+func (m *MockBookRepository) CreateBook(arg0 *models.Book) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBook", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockBookRepositoryMockRecorder) CreateBook(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBook", reflect.TypeOf((*MockBookRepository)(nil).CreateBook), arg0)
+}
+
+func (m *MockBookRepository) FindByISBN(arg0 string, arg1 string) (*models.Book, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByISBN", arg0, arg1)
+	ret0, _ := ret[0].(*models.Book)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockBookRepositoryMockRecorder) FindByISBN(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByISBN", reflect.TypeOf((*MockBookRepository)(nil).FindByISBN), arg0, arg1)
+}
+
+func (m *MockBookRepository) FindAllBooks(arg0 *dto.BookQueryParams) ([]models.Book, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllBooks", arg0)
+	ret0, _ := ret[0].([]models.Book)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockBookRepositoryMockRecorder) FindAllBooks(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllBooks", reflect.TypeOf((*MockBookRepository)(nil).FindAllBooks), arg0)
+}
+
+func (m *MockBookRepository) FindByBookId(arg0 uuid.UUID) (*models.Book, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByBookId", arg0)
+	ret0, _ := ret[0].(*models.Book)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockBookRepositoryMockRecorder) FindByBookId(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByBookId", reflect.TypeOf((*MockBookRepository)(nil).FindByBookId), arg0)
+}
+
+func (m *MockBookRepository) UpdateByBookId(arg0 uuid.UUID, arg1 *models.Book) (*models.Book, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateByBookId", arg0, arg1)
+	ret0, _ := ret[0].(*models.Book)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockBookRepositoryMockRecorder) UpdateByBookId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateByBookId", reflect.TypeOf((*MockBookRepository)(nil).UpdateByBookId), arg0, arg1)
+}
+
+func (m *MockBookRepository) DeleteByBookId(arg0 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByBookId", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockBookRepositoryMockRecorder) DeleteByBookId(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByBookId", reflect.TypeOf((*MockBookRepository)(nil).DeleteByBookId), arg0)
+}
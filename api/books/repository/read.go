@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/api/books/dto"
+	"github.com/minand-mohan/library-app-api/database/dberrors"
+	"github.com/minand-mohan/library-app-api/database/models"
+)
+
+// List all books
+func (repo *BookRepositoryImpl) FindAllBooks(queryParams *dto.BookQueryParams) ([]models.Book, error) {
+	var books []models.Book
+	result := GenerateDbQueries(repo.db, queryParams).Find(&books)
+	if result.Error != nil {
+		return nil, dberrors.Translate(result.Error)
+	}
+	return books, nil
+}
+
+// Retrieve a book by its ID
+func (repo *BookRepositoryImpl) FindByBookId(id uuid.UUID) (*models.Book, error) {
+	var book models.Book
+	result := repo.db.First(&book, id)
+	if result.Error != nil {
+		return nil, dberrors.Translate(result.Error)
+	}
+	return &book, nil
+}
+
+// Used by create to check for an existing book with the same ISBN
+func (repo *BookRepositoryImpl) FindByISBN(isbn10 string, isbn13 string) (*models.Book, error) {
+	var book models.Book
+	result := repo.db.First(&book, "isbn10 = ? OR isbn13 = ?", isbn10, isbn13)
+	if result.Error != nil {
+		return nil, dberrors.Translate(result.Error)
+	}
+	return &book, nil
+}
@@ -0,0 +1,197 @@
+package repository
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/api/books/dto"
+	"github.com/minand-mohan/library-app-api/database/models"
+
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+func TestFindAllBooks(t *testing.T) {
+
+	book1 := generateRandomBook01()
+	book2 := generateRandomBook02()
+
+	tc := []struct {
+		name          string
+		params        *dto.BookQueryParams
+		mockFunction  func(mock sqlmock.Sqlmock, params dto.BookQueryParams) error
+		expectedError error
+		expectedList  []models.Book
+	}{
+		{
+			name:   "Find all books successfully",
+			params: &dto.BookQueryParams{},
+			mockFunction: func(mock sqlmock.Sqlmock, params dto.BookQueryParams) error {
+				query := regexp.QuoteMeta(`SELECT * FROM "books"`)
+				mock.ExpectQuery(query).
+					WillReturnRows(sqlmock.NewRows(bookColumns).
+						AddRow(book1.ID, book1.Title, book1.Subtitle, book1.ISBN10, book1.ISBN13, book1.PublicationYear, book1.Language, book1.PageCount, book1.Edition).
+						AddRow(book2.ID, book2.Title, book2.Subtitle, book2.ISBN10, book2.ISBN13, book2.PublicationYear, book2.Language, book2.PageCount, book2.Edition))
+				return nil
+			},
+			expectedError: nil,
+			expectedList:  []models.Book{book1, book2},
+		},
+		{
+			name:   "Find no books",
+			params: &dto.BookQueryParams{Title: "rust"},
+			mockFunction: func(mock sqlmock.Sqlmock, params dto.BookQueryParams) error {
+				query := regexp.QuoteMeta(`SELECT * FROM "books" WHERE "title" ILIKE $1`)
+				mock.ExpectQuery(query).
+					WithArgs("%rust%").
+					WillReturnRows(sqlmock.NewRows(bookColumns))
+				return nil
+			},
+			expectedError: nil,
+			expectedList:  []models.Book{},
+		},
+		{
+			name:   "Find all books with error",
+			params: &dto.BookQueryParams{ISBN: "9780134190440"},
+			mockFunction: func(mock sqlmock.Sqlmock, params dto.BookQueryParams) error {
+				err := sqlmock.ErrCancelled
+				query := regexp.QuoteMeta(`SELECT * FROM "books" WHERE ("isbn10" = $1 OR "isbn13" = $2)`)
+				mock.ExpectQuery(query).
+					WithArgs(params.ISBN, params.ISBN).
+					WillReturnError(err)
+				return err
+			},
+			expectedError: sqlmock.ErrCancelled,
+			expectedList:  []models.Book{},
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, bookRepository := createBookRepository()
+			tt.mockFunction(mock, *tt.params)
+			books, err := bookRepository.FindAllBooks(tt.params)
+			if err != tt.expectedError {
+				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
+			}
+			if len(books) != len(tt.expectedList) {
+				t.Errorf("Expected list length: %v, got: %v", len(tt.expectedList), len(books))
+			}
+		})
+	}
+}
+
+func TestFindBookByID(t *testing.T) {
+
+	book := generateRandomBook01()
+
+	tc := []struct {
+		name          string
+		id            uuid.UUID
+		mockFunction  func(mock sqlmock.Sqlmock, id string) error
+		expectedError error
+		expectedBook  *models.Book
+	}{
+		{
+			name: "Find book by id successfully",
+			id:   *book.ID,
+			mockFunction: func(mock sqlmock.Sqlmock, id string) error {
+				query := regexp.QuoteMeta(`SELECT * FROM "books" WHERE "books"."id" = $1 ORDER BY "books"."id" LIMIT 1`)
+				mock.ExpectQuery(query).
+					WithArgs(id).
+					WillReturnRows(sqlmock.NewRows(bookColumns).
+						AddRow(book.ID, book.Title, book.Subtitle, book.ISBN10, book.ISBN13, book.PublicationYear, book.Language, book.PageCount, book.Edition))
+				return nil
+			},
+			expectedError: nil,
+			expectedBook:  &book,
+		},
+		{
+			name: "Find book by id with error",
+			id:   *book.ID,
+			mockFunction: func(mock sqlmock.Sqlmock, id string) error {
+				err := sqlmock.ErrCancelled
+				query := regexp.QuoteMeta(`SELECT * FROM "books" WHERE "books"."id" = $1 ORDER BY "books"."id" LIMIT 1`)
+				mock.ExpectQuery(query).
+					WithArgs(id).
+					WillReturnError(err)
+				return err
+			},
+			expectedError: sqlmock.ErrCancelled,
+			expectedBook:  nil,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, bookRepository := createBookRepository()
+			tt.mockFunction(mock, tt.id.String())
+			book, err := bookRepository.FindByBookId(tt.id)
+			if err != tt.expectedError {
+				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
+			}
+			if book != nil && *book.Title != *tt.expectedBook.Title {
+				t.Errorf("Expected book: %v, got: %v", tt.expectedBook, book)
+			}
+		})
+	}
+}
+
+func TestFindByISBN(t *testing.T) {
+
+	book := generateRandomBook01()
+
+	tc := []struct {
+		name          string
+		isbn10        string
+		isbn13        string
+		mockFunction  func(mock sqlmock.Sqlmock, isbn10 string, isbn13 string) error
+		expectedError error
+		expectedBook  *models.Book
+	}{
+		{
+			name:   "Find book by isbn successfully",
+			isbn10: *book.ISBN10,
+			isbn13: "",
+			mockFunction: func(mock sqlmock.Sqlmock, isbn10 string, isbn13 string) error {
+				query := regexp.QuoteMeta(`SELECT * FROM "books" WHERE isbn10 = $1 OR isbn13 = $2 ORDER BY "books"."id" LIMIT 1`)
+				mock.ExpectQuery(query).
+					WithArgs(isbn10, isbn13).
+					WillReturnRows(sqlmock.NewRows(bookColumns).
+						AddRow(book.ID, book.Title, book.Subtitle, book.ISBN10, book.ISBN13, book.PublicationYear, book.Language, book.PageCount, book.Edition))
+				return nil
+			},
+			expectedError: nil,
+			expectedBook:  &book,
+		},
+		{
+			name:   "Find book by isbn with error",
+			isbn10: "",
+			isbn13: *book.ISBN13,
+			mockFunction: func(mock sqlmock.Sqlmock, isbn10 string, isbn13 string) error {
+				err := sqlmock.ErrCancelled
+				query := regexp.QuoteMeta(`SELECT * FROM "books" WHERE isbn10 = $1 OR isbn13 = $2 ORDER BY "books"."id" LIMIT 1`)
+				mock.ExpectQuery(query).
+					WithArgs(isbn10, isbn13).
+					WillReturnError(err)
+				return err
+			},
+			expectedError: sqlmock.ErrCancelled,
+			expectedBook:  nil,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, bookRepository := createBookRepository()
+			tt.mockFunction(mock, tt.isbn10, tt.isbn13)
+			book, err := bookRepository.FindByISBN(tt.isbn10, tt.isbn13)
+			if err != tt.expectedError {
+				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
+			}
+			if book != nil && *book.ISBN13 != *tt.expectedBook.ISBN13 {
+				t.Errorf("Expected book: %v, got: %v", tt.expectedBook, book)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/api/books/dto"
+	"github.com/minand-mohan/library-app-api/database/models"
+	"gorm.io/gorm"
+)
+
+type BookRepository interface {
+	CreateBook(bookObj *models.Book) error
+	FindByISBN(isbn10 string, isbn13 string) (*models.Book, error)
+	FindAllBooks(queryParams *dto.BookQueryParams) ([]models.Book, error)
+	FindByBookId(id uuid.UUID) (*models.Book, error)
+	UpdateByBookId(id uuid.UUID, book *models.Book) (*models.Book, error)
+	DeleteByBookId(id uuid.UUID) error
+}
+
+type BookRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewBookRepository(db *gorm.DB) BookRepository {
+	return &BookRepositoryImpl{db}
+}
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/database/models"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+var bookColumns = []string{"id", "title", "subtitle", "isbn10", "isbn13", "publication_year", "language", "page_count", "edition"}
+
+func createBookRepository() (sqlmock.Sqlmock, BookRepository) {
+	var (
+		db   *sql.DB
+		mock sqlmock.Sqlmock
+	)
+
+	db, mock, _ = sqlmock.New()
+	dialector := postgres.New(postgres.Config{
+		DSN:                  "sqlmock_db_0",
+		DriverName:           "postgres",
+		Conn:                 db,
+		PreferSimpleProtocol: true,
+	})
+	sDb, _ := gorm.Open(dialector, &gorm.Config{})
+
+	bookRepository := NewBookRepository(sDb)
+
+	return mock, bookRepository
+}
+
+func generateRandomBook01() models.Book {
+	//initialize variables
+	test_title := "The Go Programming Language"
+	test_isbn10 := "0134190440"
+	test_isbn13 := "9780134190440"
+	test_year := 2015
+	test_language := "en"
+	test_page_count := 380
+	test_id := uuid.New()
+	return models.Book{
+		ID:              &test_id,
+		Title:           &test_title,
+		ISBN10:          &test_isbn10,
+		ISBN13:          &test_isbn13,
+		PublicationYear: &test_year,
+		Language:        &test_language,
+		PageCount:       &test_page_count,
+	}
+}
+
+func generateRandomBook02() models.Book {
+	//initialize variables
+	test_title := "Concurrency in Go"
+	test_isbn13 := "9781491941195"
+	test_year := 2017
+	test_language := "en"
+	test_id := uuid.New()
+	return models.Book{
+		ID:              &test_id,
+		Title:           &test_title,
+		ISBN13:          &test_isbn13,
+		PublicationYear: &test_year,
+		Language:        &test_language,
+	}
+}
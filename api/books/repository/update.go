@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/database/dberrors"
+	"github.com/minand-mohan/library-app-api/database/models"
+)
+
+// Replace a book by id. Every column but the id is written, so fields left
+// nil in book are cleared, and the book is returned as stored.
+func (repo *BookRepositoryImpl) UpdateByBookId(id uuid.UUID, book *models.Book) (*models.Book, error) {
+	result := repo.db.Model(&models.Book{}).Where("id = ?", id).Select("*").Omit("id").Updates(book)
+	if result.Error != nil {
+		return nil, dberrors.Translate(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, dberrors.ErrNotFound
+	}
+	return repo.FindByBookId(id)
+}
@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/database/dberrors"
+	"github.com/minand-mohan/library-app-api/database/models"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+func TestUpdateBook(t *testing.T) {
+	//initialize variables
+	test_title := "The Go Programming Language"
+	test_edition := "2nd"
+	updateQuery := regexp.QuoteMeta(`UPDATE "books" SET "title"=$1,"subtitle"=$2,"isbn10"=$3,"isbn13"=$4,"publication_year"=$5,"language"=$6,"page_count"=$7,"edition"=$8 WHERE id = $9`)
+	selectQuery := regexp.QuoteMeta(`SELECT * FROM "books" WHERE "books"."id" = $1 ORDER BY "books"."id" LIMIT 1`)
+
+	tc := []struct {
+		name          string
+		book          *models.Book
+		id            uuid.UUID
+		mockFunction  func(mock sqlmock.Sqlmock, id uuid.UUID, book *models.Book) error
+		expectedError error
+		expectedBook  *models.Book
+	}{
+		{
+			name: "Book updated successfully",
+			book: &models.Book{
+				Title:   &test_title,
+				Edition: &test_edition,
+			},
+			id: uuid.New(),
+			mockFunction: func(mock sqlmock.Sqlmock, id uuid.UUID, book *models.Book) error {
+				mock.ExpectBegin()
+				mock.ExpectExec(updateQuery).
+					WithArgs(*book.Title, nil, nil, nil, nil, nil, nil, *book.Edition, id).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+				mock.ExpectQuery(selectQuery).
+					WithArgs(id).
+					WillReturnRows(sqlmock.NewRows(bookColumns).
+						AddRow(id.String(), *book.Title, nil, nil, nil, nil, nil, nil, *book.Edition))
+				return nil
+			},
+			expectedError: nil,
+			expectedBook: &models.Book{
+				Title:   &test_title,
+				Edition: &test_edition,
+			},
+		},
+		{
+			name: "Book update clears optional fields",
+			book: &models.Book{
+				Title: &test_title,
+			},
+			id: uuid.New(),
+			mockFunction: func(mock sqlmock.Sqlmock, id uuid.UUID, book *models.Book) error {
+				mock.ExpectBegin()
+				mock.ExpectExec(updateQuery).
+					WithArgs(*book.Title, nil, nil, nil, nil, nil, nil, nil, id).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+				mock.ExpectQuery(selectQuery).
+					WithArgs(id).
+					WillReturnRows(sqlmock.NewRows(bookColumns).
+						AddRow(id.String(), *book.Title, nil, nil, nil, nil, nil, nil, nil))
+				return nil
+			},
+			expectedError: nil,
+			expectedBook: &models.Book{
+				Title: &test_title,
+			},
+		},
+		{
+			name: "Book deleted before update",
+			book: &models.Book{
+				Title: &test_title,
+			},
+			id: uuid.New(),
+			mockFunction: func(mock sqlmock.Sqlmock, id uuid.UUID, book *models.Book) error {
+				mock.ExpectBegin()
+				mock.ExpectExec(updateQuery).
+					WithArgs(*book.Title, nil, nil, nil, nil, nil, nil, nil, id).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectCommit()
+				return nil
+			},
+			expectedError: dberrors.ErrNotFound,
+		},
+		{
+			name: "Book update failed",
+			book: &models.Book{
+				Title: &test_title,
+			},
+			id: uuid.New(),
+			mockFunction: func(mock sqlmock.Sqlmock, id uuid.UUID, book *models.Book) error {
+				err := sqlmock.ErrCancelled
+				mock.ExpectBegin()
+				mock.ExpectExec(updateQuery).
+					WithArgs(*book.Title, nil, nil, nil, nil, nil, nil, nil, id).
+					WillReturnError(err)
+				mock.ExpectRollback()
+				return err
+			},
+			expectedError: sqlmock.ErrCancelled,
+		},
+	}
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, bookRepository := createBookRepository()
+			tt.mockFunction(mock, tt.id, tt.book)
+			book, err := bookRepository.UpdateByBookId(tt.id, tt.book)
+			if err != tt.expectedError {
+				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
+			}
+			if tt.expectedBook != nil {
+				if book == nil || *book.ID != tt.id || *book.Title != *tt.expectedBook.Title {
+					t.Errorf("Expected book %v, got %v", tt.expectedBook, book)
+				} else if (book.Edition == nil) != (tt.expectedBook.Edition == nil) || book.Subtitle != nil || book.ISBN13 != nil || book.PageCount != nil {
+					t.Errorf("Expected optional fields of %v, got %v", tt.expectedBook, book)
+				}
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Unmet expectations: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"github.com/minand-mohan/library-app-api/api/books/dto"
+	"github.com/minand-mohan/library-app-api/database/filters"
+	"gorm.io/gorm"
+)
+
+// Columns books can be filtered and sorted on
+var bookFilterColumns = []string{"id", "title", "isbn10", "isbn13", "publication_year", "language"}
+
+// GenerateDbQueries applies the filters from the query params to the db query
+func GenerateDbQueries(db *gorm.DB, queryParams *dto.BookQueryParams) *gorm.DB {
+	if queryParams == nil {
+		return db
+	}
+	builder := filters.NewBuilder(bookFilterColumns...)
+	if queryParams.Title != "" {
+		builder.Contains("title", queryParams.Title)
+	}
+	if queryParams.ISBN != "" {
+		builder.Or(
+			builder.New().Eq("isbn10", queryParams.ISBN),
+			builder.New().Eq("isbn13", queryParams.ISBN),
+		)
+	}
+	if queryParams.Language != "" {
+		builder.Eq("language", queryParams.Language)
+	}
+	return builder.Apply(db)
+}
@@ -0,0 +1,42 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/minand-mohan/library-app-api/api/books/dto"
+	"github.com/minand-mohan/library-app-api/api/response"
+	"github.com/minand-mohan/library-app-api/database/dberrors"
+)
+
+func (service *BookServiceImpl) CreateBook(bookReq *dto.BookRequestBody) (*response.HTTPResponse, error) {
+	service.logger.Info("Book Service: Create book")
+	bookObj := newBookModel(bookReq)
+
+	existingBook, err := service.repo.FindByISBN(bookReq.ISBN10, bookReq.ISBN13)
+	if err == nil {
+		service.logger.Error(fmt.Sprintf("BookService: Book with isbn10 %s or isbn13 %s already exists", bookReq.ISBN10, bookReq.ISBN13))
+		responseBody := response.HTTPResponse{
+			Code:    400,
+			Message: "Bad request, book already exists",
+			Content: bookResponseContent(existingBook),
+		}
+		return &responseBody, errors.New("book already exists")
+	}
+	if !errors.Is(err, dberrors.ErrNotFound) {
+		service.logger.Error(fmt.Sprintf("BookService: Error while finding existing book: %s", err))
+		return response.GetRepositoryErrorHTTPResponseBody(err, "Book not found."), err
+	}
+
+	err = service.repo.CreateBook(bookObj)
+	if err != nil {
+		service.logger.Error(fmt.Sprintf("BookService: Error while creating book: %s", err))
+		return response.GetRepositoryErrorHTTPResponseBody(err, "Book not found."), err
+	}
+	responseBody := response.HTTPResponse{
+		Code:    200,
+		Message: "Book created successfully",
+		Content: bookResponseContent(bookObj),
+	}
+	return &responseBody, nil
+}
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/minand-mohan/library-app-api/api/books/dto"
+	repomocks "github.com/minand-mohan/library-app-api/api/books/repository/mocks"
+	"github.com/minand-mohan/library-app-api/api/response"
+	"github.com/minand-mohan/library-app-api/database/dberrors"
+	"github.com/minand-mohan/library-app-api/database/models"
+	"github.com/minand-mohan/library-app-api/utils"
+)
+
+func TestCreateBook(t *testing.T) {
+	existingBook := generateRandomBook01()
+
+	test_cases_that_require_create_book := map[string]bool{
+		"Create Book sucessfully":        true,
+		"Create Book with service error": true,
+	}
+
+	tc := []struct {
+		name                string
+		requestbody         *dto.BookRequestBody
+		expectedResponse    *response.HTTPResponse
+		expectedError       error
+		mockFindBookReturn  *models.Book
+		mockFindBookError   error
+		mockCreateBookError error
+	}{
+		{
+			name: "Create Book sucessfully",
+			requestbody: &dto.BookRequestBody{
+				Title:  "The Go Programming Language",
+				ISBN13: "9780134190440",
+			},
+			expectedResponse: &response.HTTPResponse{
+				Code:    200,
+				Message: "Book created successfully",
+			},
+			expectedError:       nil,
+			mockFindBookReturn:  nil,
+			mockFindBookError:   dberrors.ErrNotFound,
+			mockCreateBookError: nil,
+		},
+		{
+			name: "Create Book with existing book",
+			requestbody: &dto.BookRequestBody{
+				Title:  "The Go Programming Language",
+				ISBN13: "9780134190440",
+			},
+			expectedResponse: &response.HTTPResponse{
+				Code:    400,
+				Message: "Bad request, book already exists",
+			},
+			expectedError:       errors.New("book already exists"),
+			mockFindBookReturn:  &existingBook,
+			mockFindBookError:   nil,
+			mockCreateBookError: nil,
+		},
+		{
+			name: "Create Book with service error",
+			requestbody: &dto.BookRequestBody{
+				Title:  "The Go Programming Language",
+				ISBN13: "9780134190440",
+			},
+			expectedResponse: &response.HTTPResponse{
+				Code:    500,
+				Message: "Internal Server Error",
+			},
+			expectedError:       errors.New("Internal Server Error"),
+			mockFindBookReturn:  nil,
+			mockFindBookError:   dberrors.ErrNotFound,
+			mockCreateBookError: errors.New("Internal Server Error"),
+		},
+	}
+
+	for _, tt := range tc {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := repomocks.NewMockBookRepository(mockCtrl)
+			mockRepo.EXPECT().FindByISBN(tt.requestbody.ISBN10, tt.requestbody.ISBN13).Return(tt.mockFindBookReturn, tt.mockFindBookError)
+			if test_cases_that_require_create_book[tt.name] {
+				mockRepo.EXPECT().CreateBook(gomock.Any()).Return(tt.mockCreateBookError)
+			}
+			service := NewBookService(mockRepo, *utils.NewLogger())
+
+			response, err := service.CreateBook(tt.requestbody)
+
+			if err != nil && tt.expectedError != nil {
+				if err.Error() != tt.expectedError.Error() {
+					t.Errorf("Expected error %v, got %v", tt.expectedError, err)
+				}
+			}
+			if response.Code != tt.expectedResponse.Code {
+				t.Errorf("Expected code %d, got %d", tt.expectedResponse.Code, response.Code)
+			}
+			if response.Message != tt.expectedResponse.Message {
+				t.Errorf("Expected message %s, got %s", tt.expectedResponse.Message, response.Message)
+			}
+		})
+	}
+}
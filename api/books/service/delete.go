@@ -0,0 +1,29 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/api/response"
+)
+
+func (service *BookServiceImpl) DeleteByBookId(id uuid.UUID) (*response.HTTPResponse, error) {
+	service.logger.Info("Book Service: Delete book by id")
+	_, err := service.repo.FindByBookId(id)
+	if err != nil {
+		service.logger.Error(fmt.Sprintf("BookService: Error while finding book by id: %s", err))
+		return response.GetRepositoryErrorHTTPResponseBody(err, "Book not found."), err
+	}
+	err = service.repo.DeleteByBookId(id)
+	if err != nil {
+		// a conflict means copies of the book still reference it
+		service.logger.Error(fmt.Sprintf("BookService: Error while deleting book: %s", err))
+		return response.GetRepositoryErrorHTTPResponseBody(err, "Book not found."), err
+	}
+	responseBody := response.HTTPResponse{
+		Code:    200,
+		Message: "Book deleted successfully",
+		Content: map[string]interface{}{},
+	}
+	return &responseBody, nil
+}
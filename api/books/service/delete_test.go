@@ -0,0 +1,119 @@
+package service
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	repomocks "github.com/minand-mohan/library-app-api/api/books/repository/mocks"
+	"github.com/minand-mohan/library-app-api/api/response"
+	"github.com/minand-mohan/library-app-api/database/dberrors"
+	"github.com/minand-mohan/library-app-api/utils"
+)
+
+func TestDeleteBook(t *testing.T) {
+
+	test_cases_that_require_find_book := map[string]bool{
+		"Delete Book by id successfully": true,
+		"Cannot find book":               true,
+		"Delete Book by id with error":   true,
+		"Delete Book with copies":        true,
+	}
+	test_cases_that_require_delete_book := map[string]bool{
+		"Delete Book by id successfully": true,
+		"Delete Book by id with error":   true,
+		"Delete Book with copies":        true,
+	}
+	foreignKeyError := &dberrors.ConflictError{Constraint: "fk_items_book", ForeignKey: true, Err: errors.New("ERROR: update or delete on table \"books\" violates foreign key constraint \"fk_items_book\" on table \"items\" (SQLSTATE 23503)")}
+	tc := []struct {
+		name                string
+		id                  string
+		expectedResponse    *response.HTTPResponse
+		expectedError       error
+		mockDeleteBookError error
+		mockFindBookError   error
+	}{
+		{
+			name: "Delete Book by id successfully",
+			id:   "d3b3b3b3-3b3b-3b3b-3b3b-3b3b3b3b3b3b",
+			expectedResponse: &response.HTTPResponse{
+				Code:    200,
+				Message: "Book deleted successfully",
+				Content: map[string]interface{}{},
+			},
+			expectedError:       nil,
+			mockDeleteBookError: nil,
+			mockFindBookError:   nil,
+		},
+		{
+			name: "Cannot find book",
+			id:   "d3b3b3b3-3b3b-3b3b-3b3b-3b3b3b3b3b3b",
+			expectedResponse: &response.HTTPResponse{
+				Code:    404,
+				Message: "Book not found.",
+				Content: map[string]interface{}{},
+			},
+			expectedError:       nil,
+			mockDeleteBookError: nil,
+			mockFindBookError:   dberrors.ErrNotFound,
+		},
+		{
+			name: "Delete Book by id with error",
+			id:   "d3b3b3b3-3b3b-3b3b-3b3b-3b3b3b3b3b3b",
+			expectedResponse: &response.HTTPResponse{
+				Code:    500,
+				Message: "Internal Server Error",
+				Content: map[string]interface{}{},
+			},
+			expectedError:       errors.New("Internal Server Error"),
+			mockDeleteBookError: errors.New("Internal Server Error"),
+			mockFindBookError:   nil,
+		},
+		{
+			name: "Delete Book with copies",
+			id:   "d3b3b3b3-3b3b-3b3b-3b3b-3b3b3b3b3b3b",
+			expectedResponse: &response.HTTPResponse{
+				Code:    409,
+				Message: "Conflict, related records",
+				Content: map[string]interface{}{},
+			},
+			expectedError:       foreignKeyError,
+			mockDeleteBookError: foreignKeyError,
+			mockFindBookError:   nil,
+		},
+	}
+
+	for _, tc := range tc {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+
+		t.Run(tc.name, func(t *testing.T) {
+			id, _ := uuid.Parse(tc.id)
+			mockRepo := repomocks.NewMockBookRepository(mockCtrl)
+
+			if test_cases_that_require_find_book[tc.name] {
+				mockRepo.EXPECT().FindByBookId(id).Return(nil, tc.mockFindBookError)
+			}
+			if test_cases_that_require_delete_book[tc.name] {
+				mockRepo.EXPECT().DeleteByBookId(id).Return(tc.mockDeleteBookError)
+			}
+			service := BookServiceImpl{
+				repo:   mockRepo,
+				logger: utils.NewLogger(),
+			}
+
+			response, err := service.DeleteByBookId(id)
+			if err != nil && tc.expectedError != nil {
+				if err.Error() != tc.expectedError.Error() {
+					t.Errorf("Expected error: %v, got: %v", tc.expectedError, err)
+				}
+			}
+
+			if !reflect.DeepEqual(response, tc.expectedResponse) {
+				t.Errorf("Expected response: %v, got: %v", tc.expectedResponse, response)
+			}
+		})
+	}
+}
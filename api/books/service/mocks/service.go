@@ -0,0 +1,108 @@
+package mocks
+
+import (
+	"reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/api/books/dto"
+	"github.com/minand-mohan/library-app-api/api/response"
+)
+
+// MockBookService is a mock of BookService interface.
+type MockBookService struct {
+	ctrl     *gomock.Controller
+	recorder *MockBookServiceMockRecorder
+}
+
+// MockBookServiceMockRecorder is the mock recorder for MockBookService.
+type MockBookServiceMockRecorder struct {
+	mock *MockBookService
+}
+
+// NewMockBookService creates a new mock instance.
+func NewMockBookService(ctrl *gomock.Controller) *MockBookService {
+	mock := &MockBookService{ctrl: ctrl}
+	mock.recorder = &MockBookServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBookService) EXPECT() *MockBookServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateBook mocks base method.
+func (m *MockBookService) CreateBook(arg0 *dto.BookRequestBody) (*response.HTTPResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBook", arg0)
+	ret0, _ := ret[0].(*response.HTTPResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBook indicates an expected call of CreateBook.
+func (mr *MockBookServiceMockRecorder) CreateBook(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBook", reflect.TypeOf((*MockBookService)(nil).CreateBook), arg0)
+}
+
+// FindAllBooks mocks base method.
+func (m *MockBookService) FindAllBooks(arg0 *dto.BookQueryParams) (*response.HTTPResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllBooks", arg0)
+	ret0, _ := ret[0].(*response.HTTPResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllBooks indicates an expected call of FindAllBooks.
+func (mr *MockBookServiceMockRecorder) FindAllBooks(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllBooks", reflect.TypeOf((*MockBookService)(nil).FindAllBooks), arg0)
+}
+
+// FindByBookId mocks base method.
+func (m *MockBookService) FindByBookId(arg0 uuid.UUID) (*response.HTTPResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByBookId", arg0)
+	ret0, _ := ret[0].(*response.HTTPResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByBookId indicates an expected call of FindByBookId.
+func (mr *MockBookServiceMockRecorder) FindByBookId(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByBookId", reflect.TypeOf((*MockBookService)(nil).FindByBookId), arg0)
+}
+
+// UpdateByBookId mocks base method.
+func (m *MockBookService) UpdateByBookId(arg0 uuid.UUID, arg1 *dto.BookRequestBody) (*response.HTTPResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateByBookId", arg0, arg1)
+	ret0, _ := ret[0].(*response.HTTPResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateByBookId indicates an expected call of UpdateByBookId.
+func (mr *MockBookServiceMockRecorder) UpdateByBookId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateByBookId", reflect.TypeOf((*MockBookService)(nil).UpdateByBookId), arg0, arg1)
+}
+
+// DeleteByBookId mocks base method.
+func (m *MockBookService) DeleteByBookId(arg0 uuid.UUID) (*response.HTTPResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByBookId", arg0)
+	ret0, _ := ret[0].(*response.HTTPResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteByBookId indicates an expected call of DeleteByBookId.
+func (mr *MockBookServiceMockRecorder) DeleteByBookId(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByBookId", reflect.TypeOf((*MockBookService)(nil).DeleteByBookId), arg0)
+}
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/api/books/dto"
+	"github.com/minand-mohan/library-app-api/api/response"
+)
+
+func (service *BookServiceImpl) FindAllBooks(queryParams *dto.BookQueryParams) (*response.HTTPResponse, error) {
+	service.logger.Info("Book Service: Find all books")
+	books, err := service.repo.FindAllBooks(queryParams)
+	if err != nil {
+		service.logger.Error(fmt.Sprintf("BookService: Error while finding all books: %s", err))
+		return response.GetRepositoryErrorHTTPResponseBody(err, "Book not found."), err
+	}
+	if len(books) == 0 {
+		service.logger.Error("BookService: No books found")
+		responseBody := response.HTTPResponse{
+			Code:    404,
+			Message: "No books found",
+			Content: map[string]interface{}{},
+		}
+		return &responseBody, nil
+	}
+	var booksMap []map[string]interface{}
+	for i := range books {
+		booksMap = append(booksMap, bookResponseContent(&books[i]))
+	}
+	responseContent := response.HTTPResponseContent{
+		Count:    len(books),
+		Previous: nil,
+		Next:     nil,
+		Results:  booksMap,
+	}
+	responseBody := response.HTTPResponse{
+		Code:    200,
+		Message: "Books found successfully",
+		Content: responseContent,
+	}
+	return &responseBody, nil
+}
+
+func (service *BookServiceImpl) FindByBookId(id uuid.UUID) (*response.HTTPResponse, error) {
+	service.logger.Info("Book Service: Find book by id")
+	book, err := service.repo.FindByBookId(id)
+	if err != nil {
+		service.logger.Error(fmt.Sprintf("BookService: Error while finding book by id: %s", err))
+		return response.GetRepositoryErrorHTTPResponseBody(err, "Book not found."), err
+	}
+	responseBody := response.HTTPResponse{
+		Code:    200,
+		Message: "Book found",
+		Content: bookResponseContent(book),
+	}
+	return &responseBody, nil
+}
@@ -0,0 +1,161 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/api/books/dto"
+	repomocks "github.com/minand-mohan/library-app-api/api/books/repository/mocks"
+	"github.com/minand-mohan/library-app-api/api/response"
+	"github.com/minand-mohan/library-app-api/database/dberrors"
+	"github.com/minand-mohan/library-app-api/database/models"
+	"github.com/minand-mohan/library-app-api/utils"
+)
+
+func TestListBooks(t *testing.T) {
+
+	internalServerError := errors.New("Internal Server Error")
+
+	tc := []struct {
+		name                   string
+		queryParams            *dto.BookQueryParams
+		expectedResponse       *response.HTTPResponse
+		expectedError          error
+		mockFindAllBooksReturn []models.Book
+		mockFindAllBooksError  error
+	}{
+		{
+			name:        "Find all books successfully",
+			queryParams: nil,
+			expectedResponse: &response.HTTPResponse{
+				Code:    200,
+				Message: "Books found successfully",
+			},
+			expectedError: nil,
+			mockFindAllBooksReturn: []models.Book{
+				generateRandomBook01(),
+				generateRandomBook02(),
+			},
+			mockFindAllBooksError: nil,
+		},
+		{
+			name: "Find no books",
+			queryParams: &dto.BookQueryParams{
+				Title: "rust",
+			},
+			expectedResponse: &response.HTTPResponse{
+				Code:    404,
+				Message: "No books found",
+			},
+			expectedError:          nil,
+			mockFindAllBooksReturn: []models.Book{},
+			mockFindAllBooksError:  nil,
+		},
+		{
+			name: "Find all books with error",
+			queryParams: &dto.BookQueryParams{
+				Title: "go",
+			},
+			expectedResponse: &response.HTTPResponse{
+				Code:    500,
+				Message: "Internal Server Error",
+			},
+			expectedError:          internalServerError,
+			mockFindAllBooksReturn: nil,
+			mockFindAllBooksError:  internalServerError,
+		},
+	}
+
+	for _, tt := range tc {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+
+		t.Run(tt.name, func(t *testing.T) {
+			mockBookRepo := repomocks.NewMockBookRepository(mockCtrl)
+			mockBookRepo.EXPECT().FindAllBooks(tt.queryParams).Return(tt.mockFindAllBooksReturn, tt.mockFindAllBooksError)
+
+			bookService := NewBookService(mockBookRepo, *utils.NewLogger())
+			response, err := bookService.FindAllBooks(tt.queryParams)
+			if err != tt.expectedError {
+				t.Errorf("Expected error to be %v, but got %v", tt.expectedError, err)
+			}
+			if response.Code != tt.expectedResponse.Code {
+				t.Errorf("Expected code to be %d, but got %d", tt.expectedResponse.Code, response.Code)
+			}
+			if response.Message != tt.expectedResponse.Message {
+				t.Errorf("Expected message to be %s, but got %s", tt.expectedResponse.Message, response.Message)
+			}
+		})
+	}
+}
+
+func TestFindBookById(t *testing.T) {
+
+	test_book := generateRandomBook01()
+
+	tc := []struct {
+		name               string
+		id                 uuid.UUID
+		expectedResponse   *response.HTTPResponse
+		expectedError      error
+		mockFindBookReturn *models.Book
+		mockFindBookError  error
+	}{
+		{
+			name: "Find book by id successfully",
+			id:   *test_book.ID,
+			expectedResponse: &response.HTTPResponse{
+				Code:    200,
+				Message: "Book found",
+			},
+			mockFindBookReturn: &test_book,
+			mockFindBookError:  nil,
+		},
+		{
+			name: "Cannot find book",
+			id:   *test_book.ID,
+			expectedResponse: &response.HTTPResponse{
+				Code:    404,
+				Message: "Book not found.",
+			},
+			expectedError:      dberrors.ErrNotFound,
+			mockFindBookReturn: nil,
+			mockFindBookError:  dberrors.ErrNotFound,
+		},
+		{
+			name: "Find book by id with database unavailable",
+			id:   *test_book.ID,
+			expectedResponse: &response.HTTPResponse{
+				Code:    503,
+				Message: "Service unavailable",
+			},
+			expectedError:      dberrors.ErrUnavailable,
+			mockFindBookReturn: nil,
+			mockFindBookError:  dberrors.ErrUnavailable,
+		},
+	}
+
+	for _, tt := range tc {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+
+		t.Run(tt.name, func(t *testing.T) {
+			mockBookRepo := repomocks.NewMockBookRepository(mockCtrl)
+			mockBookRepo.EXPECT().FindByBookId(tt.id).Return(tt.mockFindBookReturn, tt.mockFindBookError)
+
+			bookService := NewBookService(mockBookRepo, *utils.NewLogger())
+			response, err := bookService.FindByBookId(tt.id)
+			if !errors.Is(err, tt.expectedError) {
+				t.Errorf("Expected error %v, but got %v", tt.expectedError, err)
+			}
+			if response.Code != tt.expectedResponse.Code {
+				t.Errorf("Expected code to be %d, but got %d", tt.expectedResponse.Code, response.Code)
+			}
+			if response.Message != tt.expectedResponse.Message {
+				t.Errorf("Expected message to be %s, but got %s", tt.expectedResponse.Message, response.Message)
+			}
+		})
+	}
+}
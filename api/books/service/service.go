@@ -0,0 +1,73 @@
+package service
+
+import (
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/api/books/dto"
+	"github.com/minand-mohan/library-app-api/api/books/repository"
+	"github.com/minand-mohan/library-app-api/api/response"
+	"github.com/minand-mohan/library-app-api/database/models"
+	"github.com/minand-mohan/library-app-api/utils"
+)
+
+type BookService interface {
+	CreateBook(bookReqBody *dto.BookRequestBody) (*response.HTTPResponse, error)
+	FindAllBooks(queryParams *dto.BookQueryParams) (*response.HTTPResponse, error)
+	FindByBookId(id uuid.UUID) (*response.HTTPResponse, error)
+	UpdateByBookId(id uuid.UUID, bookReqBody *dto.BookRequestBody) (*response.HTTPResponse, error)
+	DeleteByBookId(id uuid.UUID) (*response.HTTPResponse, error)
+}
+
+type BookServiceImpl struct {
+	repo   repository.BookRepository
+	logger *utils.AppLogger
+}
+
+func NewBookService(repo repository.BookRepository, logger utils.AppLogger) BookService {
+	return &BookServiceImpl{
+		repo:   repo,
+		logger: &logger,
+	}
+}
+
+// Optional fields are stored as NULL rather than as zero values, so that
+// books without an ISBN-10 or ISBN-13 do not collide on the unique index
+func stringOrNil(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+func intOrNil(value int) *int {
+	if value == 0 {
+		return nil
+	}
+	return &value
+}
+
+func newBookModel(bookReq *dto.BookRequestBody) *models.Book {
+	return &models.Book{
+		Title:           stringOrNil(bookReq.Title),
+		Subtitle:        stringOrNil(bookReq.Subtitle),
+		ISBN10:          stringOrNil(bookReq.ISBN10),
+		ISBN13:          stringOrNil(bookReq.ISBN13),
+		PublicationYear: intOrNil(bookReq.PublicationYear),
+		Language:        stringOrNil(bookReq.Language),
+		PageCount:       intOrNil(bookReq.PageCount),
+		Edition:         stringOrNil(bookReq.Edition),
+	}
+}
+
+func bookResponseContent(book *models.Book) map[string]interface{} {
+	return map[string]interface{}{
+		"id":               book.ID,
+		"title":            book.Title,
+		"subtitle":         book.Subtitle,
+		"isbn10":           book.ISBN10,
+		"isbn13":           book.ISBN13,
+		"publication_year": book.PublicationYear,
+		"language":         book.Language,
+		"page_count":       book.PageCount,
+		"edition":          book.Edition,
+	}
+}
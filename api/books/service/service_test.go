@@ -0,0 +1,32 @@
+package service
+
+import (
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/database/models"
+)
+
+func generateRandomBook01() models.Book {
+	//initialize variables
+	test_title := "The Go Programming Language"
+	test_isbn10 := "0134190440"
+	test_isbn13 := "9780134190440"
+	test_id := uuid.New()
+	return models.Book{
+		ID:     &test_id,
+		Title:  &test_title,
+		ISBN10: &test_isbn10,
+		ISBN13: &test_isbn13,
+	}
+}
+
+func generateRandomBook02() models.Book {
+	//initialize variables
+	test_title := "Concurrency in Go"
+	test_isbn13 := "9781491941195"
+	test_id := uuid.New()
+	return models.Book{
+		ID:     &test_id,
+		Title:  &test_title,
+		ISBN13: &test_isbn13,
+	}
+}
@@ -0,0 +1,31 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/api/books/dto"
+	"github.com/minand-mohan/library-app-api/api/response"
+)
+
+func (service *BookServiceImpl) UpdateByBookId(id uuid.UUID, bookReqBody *dto.BookRequestBody) (*response.HTTPResponse, error) {
+	service.logger.Info("Book Service: Update book by id")
+	bookObj := newBookModel(bookReqBody)
+	_, err := service.repo.FindByBookId(id)
+	if err != nil {
+		service.logger.Error(fmt.Sprintf("BookService: Error while finding book by id: %s", err))
+		return response.GetRepositoryErrorHTTPResponseBody(err, "Book not found."), err
+	}
+
+	updatedBookObj, err := service.repo.UpdateByBookId(id, bookObj)
+	if err != nil {
+		service.logger.Error(fmt.Sprintf("BookService: Error while updating book: %s", err))
+		return response.GetRepositoryErrorHTTPResponseBody(err, "Book not found."), err
+	}
+	responseBody := response.HTTPResponse{
+		Code:    200,
+		Message: "Book updated successfully",
+		Content: bookResponseContent(updatedBookObj),
+	}
+	return &responseBody, nil
+}
@@ -0,0 +1,133 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/minand-mohan/library-app-api/api/books/dto"
+	repomocks "github.com/minand-mohan/library-app-api/api/books/repository/mocks"
+	"github.com/minand-mohan/library-app-api/api/response"
+	"github.com/minand-mohan/library-app-api/database/dberrors"
+	"github.com/minand-mohan/library-app-api/database/models"
+	"github.com/minand-mohan/library-app-api/utils"
+)
+
+func TestUpdateBook(t *testing.T) {
+
+	test_title := "The Go Programming Language"
+
+	internalServerError := errors.New("Internal Server Error")
+	bookNotFoundError := dberrors.ErrNotFound
+	duplicateKeyError := &dberrors.ConflictError{Field: "isbn13", Constraint: "books_isbn13_key", Err: errors.New("duplicate key value violates unique constraint \"books_isbn13_key\"")}
+
+	test_book := generateRandomBook01()
+	test_id := *test_book.ID
+
+	test_cases_that_require_update_book := map[string]bool{
+		"Update Book sucessfully":       true,
+		"Update Book with error":        true,
+		"Update Book non-unique values": true,
+	}
+
+	tc := []struct {
+		name                 string
+		requestbody          *dto.BookRequestBody
+		expectedResponse     *response.HTTPResponse
+		expectedError        error
+		mockFindBookReturn   *models.Book
+		mockFindBookError    error
+		mockUpdateBookReturn *models.Book
+		mockUpdateBookError  error
+	}{
+		{
+			name: "Update Book sucessfully",
+			requestbody: &dto.BookRequestBody{
+				Title: test_title,
+			},
+			expectedResponse: &response.HTTPResponse{
+				Code:    200,
+				Message: "Book updated successfully",
+			},
+			expectedError:        nil,
+			mockFindBookReturn:   &test_book,
+			mockFindBookError:    nil,
+			mockUpdateBookReturn: &models.Book{Title: &test_title},
+			mockUpdateBookError:  nil,
+		},
+		{
+			name: "Update Book with error",
+			requestbody: &dto.BookRequestBody{
+				Title: test_title,
+			},
+			expectedResponse: &response.HTTPResponse{
+				Code:    500,
+				Message: "Internal Server Error",
+			},
+			expectedError:        internalServerError,
+			mockFindBookReturn:   &test_book,
+			mockFindBookError:    nil,
+			mockUpdateBookReturn: nil,
+			mockUpdateBookError:  internalServerError,
+		},
+		{
+			name: "Cannot find book",
+			requestbody: &dto.BookRequestBody{
+				Title: test_title,
+			},
+			expectedResponse: &response.HTTPResponse{
+				Code:    404,
+				Message: "Book not found.",
+			},
+			expectedError:        bookNotFoundError,
+			mockFindBookReturn:   nil,
+			mockFindBookError:    bookNotFoundError,
+			mockUpdateBookReturn: nil,
+			mockUpdateBookError:  nil,
+		},
+		{
+			name: "Update Book non-unique values",
+			requestbody: &dto.BookRequestBody{
+				Title: test_title,
+			},
+			expectedResponse: &response.HTTPResponse{
+				Code:    409,
+				Message: "Conflict, non-unique values",
+			},
+			expectedError:        duplicateKeyError,
+			mockFindBookReturn:   &test_book,
+			mockFindBookError:    nil,
+			mockUpdateBookReturn: nil,
+			mockUpdateBookError:  duplicateKeyError,
+		},
+	}
+
+	for _, tt := range tc {
+
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+
+		t.Run(tt.name, func(t *testing.T) {
+			mockBookRepo := repomocks.NewMockBookRepository(mockCtrl)
+			service := NewBookService(mockBookRepo, *utils.NewLogger())
+			mockBookRepo.EXPECT().FindByBookId(test_id).Return(tt.mockFindBookReturn, tt.mockFindBookError)
+			if test_cases_that_require_update_book[tt.name] {
+				mockBookRepo.EXPECT().UpdateByBookId(test_id, newBookModel(tt.requestbody)).Return(tt.mockUpdateBookReturn, tt.mockUpdateBookError)
+			}
+
+			response, err := service.UpdateByBookId(test_id, tt.requestbody)
+
+			if err != nil {
+				if err.Error() != tt.expectedError.Error() {
+					t.Errorf("expected error %v, got %v", tt.expectedError, err)
+				}
+			}
+			if response.Code != tt.expectedResponse.Code {
+				t.Errorf("expected code %d, got %d", tt.expectedResponse.Code, response.Code)
+			}
+			if response.Message != tt.expectedResponse.Message {
+				t.Errorf("expected message %s, got %s", tt.expectedResponse.Message, response.Message)
+			}
+		})
+	}
+}
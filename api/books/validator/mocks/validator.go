@@ -0,0 +1,52 @@
+package mocks
+
+import (
+	"reflect"
+
+	"github.com/golang/mock/gomock"
+	"github.com/minand-mohan/library-app-api/api/books/dto"
+)
+
+// MockBookValidator is a mock type for the BookValidator type
+type MockBookValidator struct {
+	ctrl     *gomock.Controller
+	recorder *MockBookValidatorMockRecorder
+}
+
+type MockBookValidatorMockRecorder struct {
+	mock *MockBookValidator
+}
+
+func NewMockBookValidator(ctrl *gomock.Controller) *MockBookValidator {
+	mock := &MockBookValidator{ctrl: ctrl}
+	mock.recorder = &MockBookValidatorMockRecorder{mock}
+	return mock
+}
+
+func (m *MockBookValidator) EXPECT() *MockBookValidatorMockRecorder {
+	return m.recorder
+}
+
+func (m *MockBookValidator) ValidateBook(arg0 *dto.BookRequestBody) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateBook", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockBookValidatorMockRecorder) ValidateBook(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateBook", reflect.TypeOf((*MockBookValidator)(nil).ValidateBook), arg0)
+}
+
+func (m *MockBookValidator) ValidateBookQueryParams(arg0 *dto.BookQueryParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateBookQueryParams", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockBookValidatorMockRecorder) ValidateBookQueryParams(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateBookQueryParams", reflect.TypeOf((*MockBookValidator)(nil).ValidateBookQueryParams), arg0)
+}
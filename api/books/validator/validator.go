@@ -0,0 +1,103 @@
+package validator
+
+import (
+	"errors"
+	"time"
+
+	"github.com/minand-mohan/library-app-api/api/books/dto"
+	"github.com/minand-mohan/library-app-api/utils"
+)
+
+type BookValidator interface {
+	ValidateBook(requestBody *dto.BookRequestBody) error
+	ValidateBookQueryParams(queryParams *dto.BookQueryParams) error
+}
+
+type BookValidatorImpl struct {
+	logger *utils.AppLogger
+}
+
+func NewBookValidator(logger utils.AppLogger) BookValidator {
+	return &BookValidatorImpl{
+		logger: &logger,
+	}
+}
+
+// isValidISBN10 checks the length and the mod 11 check digit of an ISBN-10
+func isValidISBN10(isbn string) bool {
+	if len(isbn) != 10 {
+		return false
+	}
+	sum := 0
+	for i := 0; i < 10; i++ {
+		var digit int
+		switch {
+		case isbn[i] >= '0' && isbn[i] <= '9':
+			digit = int(isbn[i] - '0')
+		case i == 9 && (isbn[i] == 'X' || isbn[i] == 'x'):
+			digit = 10
+		default:
+			return false
+		}
+		sum += digit * (10 - i)
+	}
+	return sum%11 == 0
+}
+
+// isValidISBN13 checks the length and the mod 10 check digit of an ISBN-13
+func isValidISBN13(isbn string) bool {
+	if len(isbn) != 13 {
+		return false
+	}
+	sum := 0
+	for i := 0; i < 13; i++ {
+		if isbn[i] < '0' || isbn[i] > '9' {
+			return false
+		}
+		digit := int(isbn[i] - '0')
+		if i%2 == 1 {
+			digit *= 3
+		}
+		sum += digit
+	}
+	return sum%10 == 0
+}
+
+func (validator *BookValidatorImpl) ValidateBook(bookReq *dto.BookRequestBody) error {
+	validator.logger.Info("Validate book")
+	if bookReq.Title == "" {
+		validator.logger.Error("Title is empty")
+		return errors.New("Title is empty")
+	}
+	if bookReq.ISBN10 == "" && bookReq.ISBN13 == "" {
+		validator.logger.Error("ISBN is empty")
+		return errors.New("ISBN is empty")
+	}
+	if bookReq.ISBN10 != "" && !isValidISBN10(bookReq.ISBN10) {
+		validator.logger.Error("ISBN-10 is invalid")
+		return errors.New("ISBN-10 is invalid")
+	}
+	if bookReq.ISBN13 != "" && !isValidISBN13(bookReq.ISBN13) {
+		validator.logger.Error("ISBN-13 is invalid")
+		return errors.New("ISBN-13 is invalid")
+	}
+	if bookReq.PublicationYear < 0 || bookReq.PublicationYear > time.Now().Year() {
+		validator.logger.Error("Publication year is invalid")
+		return errors.New("Publication year is invalid")
+	}
+	if bookReq.PageCount < 0 {
+		validator.logger.Error("Page count is invalid")
+		return errors.New("Page count is invalid")
+	}
+
+	return nil
+}
+
+func (validator *BookValidatorImpl) ValidateBookQueryParams(queryParams *dto.BookQueryParams) error {
+	if queryParams.ISBN != "" && !isValidISBN10(queryParams.ISBN) && !isValidISBN13(queryParams.ISBN) {
+		validator.logger.Error("ISBN is invalid")
+		return errors.New("ISBN is invalid")
+	}
+
+	return nil
+}
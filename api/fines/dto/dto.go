@@ -0,0 +1,8 @@
+package dto
+
+type FineEntryRequestBody struct {
+	Kind        string `json:"kind"`
+	AmountCents int64  `json:"amount_cents"`
+	ItemID      string `json:"item_id"`
+	Note        string `json:"note"`
+}
@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"fmt"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/api/fines/dto"
+	"github.com/minand-mohan/library-app-api/api/response"
+	"github.com/minand-mohan/library-app-api/utils"
+)
+
+func (handler *FineHandler) RecordFineEntry(ctx *fiber.Ctx) error {
+	log := utils.ContextLogger(ctx)
+	log.Info("Record fine entry")
+	id := ctx.Params("id")
+	userId, err := uuid.Parse(id)
+	if err != nil {
+		log.Error(fmt.Sprintf("Error while parsing uuid %v", err))
+		responseBody := response.HTTPResponse{
+			Code:    400,
+			Message: "Bad request, invalid id",
+			Content: map[string]interface{}{},
+		}
+		err = response.WriteHTTPResponse(ctx, 400, &responseBody)
+		if err != nil {
+			log.Error(fmt.Sprintf("Error while writing response %v", err))
+			return err
+		}
+		return nil
+	}
+	fineReq := &dto.FineEntryRequestBody{}
+	err = response.DecodeJSONObject(ctx.Request().Body(), fineReq)
+	if err != nil {
+		log.Error(fmt.Sprintf("Error while unmarshalling request body %v", err))
+		responseBody := response.GetValidationErrorHTTPResponseBody(err)
+		err := response.WriteHTTPResponse(ctx, 400, responseBody)
+		if err != nil {
+			log.Error(fmt.Sprintf("Error while writing response %v", err))
+			return err
+		}
+		return nil
+	}
+	err = handler.validator.ValidateFineEntry(fineReq)
+	if err != nil {
+		log.Error(fmt.Sprintf("Error while validating request body %v", err))
+		responseBody := response.HTTPResponse{
+			Code:    400,
+			Message: "Bad request, invalid request body",
+			Content: map[string]interface{}{},
+		}
+		err = response.WriteHTTPResponse(ctx, 400, &responseBody)
+		if err != nil {
+			log.Error(fmt.Sprintf("Error while writing response %v", err))
+			return err
+		}
+		return nil
+	}
+
+	responseBody, err := handler.service.RecordFineEntry(ctx.UserContext(), userId, fineReq)
+	if err != nil {
+		log.Error(fmt.Sprintf("FineHandler: Error while recording fine entry %v", err))
+		err = response.WriteHTTPResponse(ctx, responseBody.Code, responseBody)
+		if err != nil {
+			log.Error(fmt.Sprintf("Error while writing response %v", err))
+			return err
+		}
+		return nil
+	}
+
+	err = response.WriteHTTPResponse(ctx, 200, responseBody)
+	if err != nil {
+		log.Error(fmt.Sprintf("Error while writing response %v", err))
+		return err
+	}
+	return nil
+}
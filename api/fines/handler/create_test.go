@@ -0,0 +1,150 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	gomock "github.com/golang/mock/gomock"
+	servicemocks "github.com/minand-mohan/library-app-api/api/fines/service/mocks"
+	validatormocks "github.com/minand-mohan/library-app-api/api/fines/validator/mocks"
+	"github.com/minand-mohan/library-app-api/api/response"
+)
+
+func TestRecordFineEntry(t *testing.T) {
+
+	testCases := []struct {
+		name                      string
+		userId                    string
+		requestBody               map[string]interface{}
+		mockServiceExpectResponse *response.HTTPResponse
+		mockServiceExpectError    error
+		mockValidatorExpectError  error
+		expectedStatus            int
+		expectedMessage           string
+	}{
+		{
+			name:   "Record fine entry with valid request body",
+			userId: "d3b3b3b3-3b3b-3b3b-3b3b-3b3b3b3b3b3b",
+			requestBody: map[string]interface{}{
+				"kind":         "payment",
+				"amount_cents": 300,
+			},
+			mockServiceExpectResponse: &response.HTTPResponse{
+				Code:    200,
+				Message: "Fine entry recorded successfully",
+				Content: map[string]interface{}{},
+			},
+			mockServiceExpectError:   nil,
+			mockValidatorExpectError: nil,
+			expectedStatus:           200,
+			expectedMessage:          "Fine entry recorded successfully",
+		},
+		{
+			name:   "Record fine entry with invalid user id",
+			userId: "invalid-id",
+			requestBody: map[string]interface{}{
+				"kind":         "payment",
+				"amount_cents": 300,
+			},
+			mockServiceExpectResponse: nil,
+			mockServiceExpectError:    nil,
+			mockValidatorExpectError:  nil,
+			expectedStatus:            400,
+			expectedMessage:           "Bad request, invalid id",
+		},
+		{
+			name:   "Record fine entry with invalid kind",
+			userId: "d3b3b3b3-3b3b-3b3b-3b3b-3b3b3b3b3b3b",
+			requestBody: map[string]interface{}{
+				"kind":         "overdue",
+				"amount_cents": 300,
+			},
+			mockServiceExpectResponse: nil,
+			mockServiceExpectError:    nil,
+			mockValidatorExpectError:  errors.New("Kind is invalid"),
+			expectedStatus:            400,
+			expectedMessage:           "Bad request, invalid request body",
+		},
+		{
+			name:   "Record payment exceeding balance",
+			userId: "d3b3b3b3-3b3b-3b3b-3b3b-3b3b3b3b3b3b",
+			requestBody: map[string]interface{}{
+				"kind":         "payment",
+				"amount_cents": 300,
+			},
+			mockServiceExpectResponse: &response.HTTPResponse{
+				Code:    400,
+				Message: "Bad request, amount exceeds balance",
+				Content: map[string]interface{}{},
+			},
+			mockServiceExpectError:   errors.New("amount exceeds balance"),
+			mockValidatorExpectError: nil,
+			expectedStatus:           400,
+			expectedMessage:          "Bad request, amount exceeds balance",
+		},
+		{
+			name:            "Record fine entry with null request body",
+			userId:          "d3b3b3b3-3b3b-3b3b-3b3b-3b3b3b3b3b3b",
+			requestBody:     nil,
+			expectedStatus:  400,
+			expectedMessage: "Bad request, invalid request body",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			app := setupApp()
+			app.Post("/users/:id/fines", func(c *fiber.Ctx) error {
+				validator := validatormocks.NewMockFineValidator(mockCtrl)
+				service := servicemocks.NewMockFineService(mockCtrl)
+				if tc.mockServiceExpectResponse != nil {
+					service.EXPECT().RecordFineEntry(gomock.Any(), gomock.Any(), gomock.Any()).Return(tc.mockServiceExpectResponse, tc.mockServiceExpectError)
+				}
+				if tc.userId != "invalid-id" && tc.requestBody != nil {
+					validator.EXPECT().ValidateFineEntry(gomock.Any()).Return(tc.mockValidatorExpectError)
+				}
+				handler := NewFineHandler(service, validator)
+				return handler.RecordFineEntry(c)
+			})
+			requestBody, err := json.Marshal(tc.requestBody)
+			if err != nil {
+				t.Errorf("Error while marshalling request body: %v", err)
+			}
+			request := httptest.NewRequest("POST", "/users/"+tc.userId+"/fines", strings.NewReader(string(requestBody)))
+
+			response, err := app.Test(request)
+			if err != nil {
+				t.Errorf("Error while making request %v", err)
+			}
+
+			if response.StatusCode != tc.expectedStatus {
+				t.Errorf("Expected status code %d, got %d", tc.expectedStatus, response.StatusCode)
+			}
+
+			bodyBytes, err := ioutil.ReadAll(response.Body)
+			if err != nil {
+				t.Errorf("Error while reading response body: %v", err)
+			}
+
+			var responseBody map[string]interface{}
+
+			err = json.Unmarshal(bodyBytes, &responseBody)
+			if err != nil {
+				t.Errorf("Error while parsing response body: %v", err)
+			}
+
+			message := responseBody["message"].(string)
+			if message != tc.expectedMessage {
+				t.Errorf("Expected message %s, got %s", tc.expectedMessage, message)
+			}
+		})
+	}
+}
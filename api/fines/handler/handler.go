@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"github.com/minand-mohan/library-app-api/api/fines/service"
+	"github.com/minand-mohan/library-app-api/api/fines/validator"
+)
+
+type FineHandler struct {
+	service   service.FineService
+	validator validator.FineValidator
+}
+
+func NewFineHandler(service service.FineService, validator validator.FineValidator) *FineHandler {
+	return &FineHandler{
+		service:   service,
+		validator: validator,
+	}
+}
@@ -0,0 +1,8 @@
+package handler
+
+import "github.com/gofiber/fiber/v2"
+
+func setupApp() *fiber.App {
+	app := fiber.New()
+	return app
+}
@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"fmt"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/api/response"
+	"github.com/minand-mohan/library-app-api/utils"
+)
+
+func (handler *FineHandler) FindAllFineEntriesByUserId(ctx *fiber.Ctx) error {
+	log := utils.ContextLogger(ctx)
+	log.Info("Find all fine entries by user id")
+	id := ctx.Params("id")
+	userId, err := uuid.Parse(id)
+	if err != nil {
+		log.Error(fmt.Sprintf("Error while parsing uuid %v", err))
+		responseBody := response.HTTPResponse{
+			Code:    400,
+			Message: "Bad request, invalid id",
+			Content: map[string]interface{}{},
+		}
+		err = response.WriteHTTPResponse(ctx, 400, &responseBody)
+		if err != nil {
+			log.Error(fmt.Sprintf("Error while writing response %v", err))
+			return err
+		}
+		return nil
+	}
+
+	responseBody, err := handler.service.FindAllFineEntriesByUserId(ctx.UserContext(), userId)
+	if err != nil {
+		log.Error(fmt.Sprintf("FineHandler: Error while finding all fine entries %v", err))
+		err = response.WriteHTTPResponse(ctx, responseBody.Code, responseBody)
+		if err != nil {
+			log.Error(fmt.Sprintf("Error while writing response %v", err))
+			return err
+		}
+		return nil
+	}
+	err = response.WriteHTTPResponse(ctx, 200, responseBody)
+	if err != nil {
+		log.Error(fmt.Sprintf("Error while writing response %v", err))
+		return err
+	}
+	return nil
+}
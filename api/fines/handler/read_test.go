@@ -0,0 +1,106 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/minand-mohan/library-app-api/api/fines/service/mocks"
+	"github.com/minand-mohan/library-app-api/api/fines/validator"
+	"github.com/minand-mohan/library-app-api/api/response"
+	"github.com/minand-mohan/library-app-api/utils"
+)
+
+func TestFindAllFineEntriesByUserId(t *testing.T) {
+	testCases := []struct {
+		name                      string
+		id                        string
+		mockServiceExpectResponse *response.HTTPResponse
+		mockServiceExpectError    error
+		expectedStatus            int
+		expectedMessage           string
+	}{
+		{
+			name: "Find all fine entries with valid user id",
+			id:   "d3b3b3b3-3b3b-3b3b-3b3b-3b3b3b3b3b3b",
+			mockServiceExpectResponse: &response.HTTPResponse{
+				Code:    200,
+				Message: "Fine entries found successfully",
+				Content: map[string]interface{}{},
+			},
+			mockServiceExpectError: nil,
+			expectedStatus:         200,
+			expectedMessage:        "Fine entries found successfully",
+		},
+		{
+			name:                      "Find all fine entries with invalid user id",
+			id:                        "invalid-id",
+			mockServiceExpectResponse: nil,
+			mockServiceExpectError:    nil,
+			expectedStatus:            400,
+			expectedMessage:           "Bad request, invalid id",
+		},
+		{
+			name: "Find all fine entries with error",
+			id:   "d3b3b3b3-3b3b-3b3b-3b3b-3b3b3b3b3b3b",
+			mockServiceExpectResponse: &response.HTTPResponse{
+				Code:    500,
+				Message: "Internal server error",
+				Content: map[string]interface{}{},
+			},
+			mockServiceExpectError: errors.New("Internal server error"),
+			expectedStatus:         500,
+			expectedMessage:        "Internal server error",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			app := setupApp()
+			app.Get("/users/:id/fines", func(c *fiber.Ctx) error {
+				logger := utils.NewLogger()
+				service := mocks.NewMockFineService(mockCtrl)
+				validator := validator.NewFineValidator(*logger)
+				if tc.mockServiceExpectResponse != nil {
+					service.EXPECT().FindAllFineEntriesByUserId(gomock.Any(), gomock.Any()).Return(tc.mockServiceExpectResponse, tc.mockServiceExpectError)
+				}
+				handler := NewFineHandler(service, validator)
+				return handler.FindAllFineEntriesByUserId(c)
+			})
+			request := httptest.NewRequest("GET", "/users/"+tc.id+"/fines", nil)
+
+			response, err := app.Test(request)
+			if err != nil {
+				t.Errorf("Error while making request %v", err)
+			}
+
+			if response.StatusCode != tc.expectedStatus {
+				t.Errorf("Expected status code %d, got %d", tc.expectedStatus, response.StatusCode)
+			}
+
+			bodyBytes, err := ioutil.ReadAll(response.Body)
+			if err != nil {
+				t.Errorf("Error while reading response body: %v", err)
+			}
+
+			var responseBody map[string]interface{}
+
+			err = json.Unmarshal(bodyBytes, &responseBody)
+			if err != nil {
+				t.Errorf("Error while parsing response body: %v", err)
+			}
+
+			message := responseBody["message"].(string)
+			if message != tc.expectedMessage {
+				t.Errorf("Expected message %s, got %s", tc.expectedMessage, message)
+			}
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"github.com/minand-mohan/library-app-api/database/dberrors"
+	"github.com/minand-mohan/library-app-api/database/models"
+)
+
+// CreateFineEntry appends an entry to a user's fines ledger
+func (repo *FineRepositoryImpl) CreateFineEntry(entry *models.FineEntry) error {
+	result := repo.db.Create(&entry)
+	if result.Error != nil {
+		return dberrors.Translate(result.Error)
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/minand-mohan/library-app-api/database/models"
+
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+func TestCreateFineEntry(t *testing.T) {
+	test_id := "123e4567-e89b-12d3-a456-426614174000"
+	newFineEntry := func() *models.FineEntry {
+		entry := generateRandomFineEntry01()
+		entry.ID = nil
+		return &entry
+	}
+	query := regexp.QuoteMeta(`INSERT INTO "fine_entries" ("user_id","loan_id","item_id","kind","amount_cents","note","created_at") VALUES ($1,$2,$3,$4,$5,$6,$7) RETURNING "id"`)
+
+	tc := []struct {
+		name          string
+		entry         *models.FineEntry
+		mockFunction  func(mock sqlmock.Sqlmock, entry *models.FineEntry) error
+		expectedError error
+	}{
+		{
+			name:  "Fine entry created successfully",
+			entry: newFineEntry(),
+			mockFunction: func(mock sqlmock.Sqlmock, entry *models.FineEntry) error {
+				mock.ExpectBegin()
+				mock.ExpectQuery(query).
+					WithArgs(*entry.UserID, nil, *entry.ItemID, *entry.Kind, *entry.AmountCents, nil, *entry.CreatedAt).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(test_id))
+				mock.ExpectCommit()
+				return nil
+			},
+			expectedError: nil,
+		},
+		{
+			name:  "Fine entry creation failed",
+			entry: newFineEntry(),
+			mockFunction: func(mock sqlmock.Sqlmock, entry *models.FineEntry) error {
+				err := sqlmock.ErrCancelled
+				mock.ExpectBegin()
+				mock.ExpectQuery(query).
+					WillReturnError(err)
+				mock.ExpectRollback()
+				return err
+			},
+			expectedError: sqlmock.ErrCancelled,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, fineRepository := createFineRepository()
+			tt.mockFunction(mock, tt.entry)
+			err := fineRepository.CreateFineEntry(tt.entry)
+			if err != tt.expectedError {
+				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package mocks
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/api/fines/repository"
+	"github.com/minand-mohan/library-app-api/database/models"
+)
+
+// MockFineRepository is a mock of FineRepository interface.
+type MockFineRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockFineRepositoryMockRecorder
+}
+
+// MockFineRepositoryMockRecorder is the mock recorder for MockFineRepository.
+type MockFineRepositoryMockRecorder struct {
+	mock *MockFineRepository
+}
+
+// NewMockFineRepository creates a new mock instance.
+func NewMockFineRepository(ctrl *gomock.Controller) *MockFineRepository {
+	mock := &MockFineRepository{ctrl: ctrl}
+	mock.recorder = &MockFineRepositoryMockRecorder{mock}
+	return mock
+}
+
+func (m *MockFineRepository) EXPECT() *MockFineRepositoryMockRecorder {
+	return m.recorder
+}
+
+func (m *MockFineRepository) WithTransaction(arg0 func(repository.FineRepository) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTransaction", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockFineRepositoryMockRecorder) WithTransaction(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTransaction", reflect.TypeOf((*MockFineRepository)(nil).WithTransaction), arg0)
+}
+
+func (m *MockFineRepository) CreateFineEntry(arg0 *models.FineEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFineEntry", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockFineRepositoryMockRecorder) CreateFineEntry(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFineEntry", reflect.TypeOf((*MockFineRepository)(nil).CreateFineEntry), arg0)
+}
+
+func (m *MockFineRepository) FindAllFineEntriesByUserId(arg0 context.Context, arg1 uuid.UUID) ([]models.FineEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllFineEntriesByUserId", arg0, arg1)
+	ret0, _ := ret[0].([]models.FineEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockFineRepositoryMockRecorder) FindAllFineEntriesByUserId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllFineEntriesByUserId", reflect.TypeOf((*MockFineRepository)(nil).FindAllFineEntriesByUserId), arg0, arg1)
+}
+
+func (m *MockFineRepository) LockUserForUpdate(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockUserForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockFineRepositoryMockRecorder) LockUserForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockUserForUpdate", reflect.TypeOf((*MockFineRepository)(nil).LockUserForUpdate), arg0, arg1)
+}
+
+func (m *MockFineRepository) SumByUserId(arg0 context.Context, arg1 uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SumByUserId", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockFineRepositoryMockRecorder) SumByUserId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SumByUserId", reflect.TypeOf((*MockFineRepository)(nil).SumByUserId), arg0, arg1)
+}
+
+func (m *MockFineRepository) SumOverdueByLoanId(arg0 context.Context, arg1 uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SumOverdueByLoanId", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockFineRepositoryMockRecorder) SumOverdueByLoanId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SumOverdueByLoanId", reflect.TypeOf((*MockFineRepository)(nil).SumOverdueByLoanId), arg0, arg1)
+}
+
+func (m *MockFineRepository) FindOverdueLoansForUpdate(arg0 context.Context, arg1 time.Time) ([]models.Loan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOverdueLoansForUpdate", arg0, arg1)
+	ret0, _ := ret[0].([]models.Loan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockFineRepositoryMockRecorder) FindOverdueLoansForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOverdueLoansForUpdate", reflect.TypeOf((*MockFineRepository)(nil).FindOverdueLoansForUpdate), arg0, arg1)
+}
+
+func (m *MockFineRepository) FindItemById(arg0 context.Context, arg1 uuid.UUID) (*models.Item, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindItemById", arg0, arg1)
+	ret0, _ := ret[0].(*models.Item)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockFineRepositoryMockRecorder) FindItemById(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindItemById", reflect.TypeOf((*MockFineRepository)(nil).FindItemById), arg0, arg1)
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/database/dberrors"
+	"github.com/minand-mohan/library-app-api/database/models"
+	"gorm.io/gorm/clause"
+)
+
+// List a user's ledger entries, oldest first
+func (repo *FineRepositoryImpl) FindAllFineEntriesByUserId(ctx context.Context, userId uuid.UUID) ([]models.FineEntry, error) {
+	var entries []models.FineEntry
+	result := repo.db.WithContext(ctx).Where("user_id = ?", userId).Order("created_at, id").Find(&entries)
+	if result.Error != nil {
+		return nil, dberrors.Translate(result.Error)
+	}
+	return entries, nil
+}
+
+// Lock a user's row until the transaction ends, so that entries crediting
+// their balance are recorded one at a time against the balance they read
+func (repo *FineRepositoryImpl) LockUserForUpdate(ctx context.Context, userId uuid.UUID) error {
+	var user models.User
+	result := repo.db.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").Where("id = ?", userId).Take(&user)
+	if result.Error != nil {
+		return dberrors.Translate(result.Error)
+	}
+	return nil
+}
+
+// Sum a user's ledger entries into their outstanding balance
+func (repo *FineRepositoryImpl) SumByUserId(ctx context.Context, userId uuid.UUID) (int64, error) {
+	var balance int64
+	result := repo.db.WithContext(ctx).Model(&models.FineEntry{}).
+		Select("COALESCE(SUM(amount_cents), 0)").
+		Where("user_id = ?", userId).
+		Scan(&balance)
+	if result.Error != nil {
+		return 0, dberrors.Translate(result.Error)
+	}
+	return balance, nil
+}
+
+// Sum the overdue fines accrued so far on a loan
+func (repo *FineRepositoryImpl) SumOverdueByLoanId(ctx context.Context, loanId uuid.UUID) (int64, error) {
+	var accrued int64
+	result := repo.db.WithContext(ctx).Model(&models.FineEntry{}).
+		Select("COALESCE(SUM(amount_cents), 0)").
+		Where("loan_id = ? AND kind = ?", loanId, models.FineKindOverdue).
+		Scan(&accrued)
+	if result.Error != nil {
+		return 0, dberrors.Translate(result.Error)
+	}
+	return accrued, nil
+}
+
+// List and lock the loans that are still out past their due date. Loans
+// locked by another accrual run or by a return in progress are skipped, so
+// each loan is settled by one transaction at a time.
+func (repo *FineRepositoryImpl) FindOverdueLoansForUpdate(ctx context.Context, now time.Time) ([]models.Loan, error) {
+	var loans []models.Loan
+	result := repo.db.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).Where("returned_at IS NULL AND due_at < ?", now).Order("due_at").Find(&loans)
+	if result.Error != nil {
+		return nil, dberrors.Translate(result.Error)
+	}
+	return loans, nil
+}
+
+// Retrieve an item by its ID
+func (repo *FineRepositoryImpl) FindItemById(ctx context.Context, id uuid.UUID) (*models.Item, error) {
+	var item models.Item
+	result := repo.db.WithContext(ctx).First(&item, id)
+	if result.Error != nil {
+		return nil, dberrors.Translate(result.Error)
+	}
+	return &item, nil
+}
@@ -0,0 +1,294 @@
+package repository
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/database/models"
+
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+func TestFindAllFineEntriesByUserId(t *testing.T) {
+
+	entry := generateRandomFineEntry01()
+	query := regexp.QuoteMeta(`SELECT * FROM "fine_entries" WHERE user_id = $1 ORDER BY created_at, id`)
+
+	tc := []struct {
+		name          string
+		mockFunction  func(mock sqlmock.Sqlmock)
+		expectedCount int
+		expectedError error
+	}{
+		{
+			name: "Find fine entries successfully",
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(*entry.UserID).
+					WillReturnRows(fineEntryRow(sqlmock.NewRows(fineEntryColumns), entry))
+			},
+			expectedCount: 1,
+			expectedError: nil,
+		},
+		{
+			name: "Find fine entries with error",
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(*entry.UserID).
+					WillReturnError(sqlmock.ErrCancelled)
+			},
+			expectedCount: 0,
+			expectedError: sqlmock.ErrCancelled,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, fineRepository := createFineRepository()
+			tt.mockFunction(mock)
+			entries, err := fineRepository.FindAllFineEntriesByUserId(context.Background(), *entry.UserID)
+			if err != tt.expectedError {
+				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
+			}
+			if len(entries) != tt.expectedCount {
+				t.Errorf("Expected %d entries, got %d", tt.expectedCount, len(entries))
+			}
+		})
+	}
+}
+
+func TestLockUserForUpdate(t *testing.T) {
+
+	userId := uuid.New()
+	query := regexp.QuoteMeta(`SELECT "id" FROM "users" WHERE id = $1 AND "users"."deleted_at" IS NULL LIMIT 1 FOR UPDATE`)
+
+	tc := []struct {
+		name          string
+		mockFunction  func(mock sqlmock.Sqlmock)
+		expectedError error
+	}{
+		{
+			name: "Lock user successfully",
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(userId).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(userId.String()))
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Lock user with error",
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(userId).
+					WillReturnError(sqlmock.ErrCancelled)
+			},
+			expectedError: sqlmock.ErrCancelled,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, fineRepository := createFineRepository()
+			tt.mockFunction(mock)
+			err := fineRepository.LockUserForUpdate(context.Background(), userId)
+			if err != tt.expectedError {
+				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Unmet expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestSumByUserId(t *testing.T) {
+
+	userId := uuid.New()
+	query := regexp.QuoteMeta(`SELECT COALESCE(SUM(amount_cents), 0) FROM "fine_entries" WHERE user_id = $1`)
+
+	tc := []struct {
+		name            string
+		mockFunction    func(mock sqlmock.Sqlmock)
+		expectedBalance int64
+		expectedError   error
+	}{
+		{
+			name: "Sum balance successfully",
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(userId).
+					WillReturnRows(sqlmock.NewRows([]string{"coalesce"}).AddRow(750))
+			},
+			expectedBalance: 750,
+			expectedError:   nil,
+		},
+		{
+			name: "Sum balance with error",
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(userId).
+					WillReturnError(sqlmock.ErrCancelled)
+			},
+			expectedBalance: 0,
+			expectedError:   sqlmock.ErrCancelled,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, fineRepository := createFineRepository()
+			tt.mockFunction(mock)
+			balance, err := fineRepository.SumByUserId(context.Background(), userId)
+			if err != tt.expectedError {
+				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
+			}
+			if balance != tt.expectedBalance {
+				t.Errorf("Expected balance %d, got %d", tt.expectedBalance, balance)
+			}
+		})
+	}
+}
+
+func TestSumOverdueByLoanId(t *testing.T) {
+
+	loanId := uuid.New()
+	query := regexp.QuoteMeta(`SELECT COALESCE(SUM(amount_cents), 0) FROM "fine_entries" WHERE loan_id = $1 AND kind = $2`)
+
+	tc := []struct {
+		name            string
+		mockFunction    func(mock sqlmock.Sqlmock)
+		expectedAccrued int64
+		expectedError   error
+	}{
+		{
+			name: "Sum overdue fines successfully",
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(loanId, models.FineKindOverdue).
+					WillReturnRows(sqlmock.NewRows([]string{"coalesce"}).AddRow(100))
+			},
+			expectedAccrued: 100,
+			expectedError:   nil,
+		},
+		{
+			name: "Sum overdue fines with error",
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(loanId, models.FineKindOverdue).
+					WillReturnError(sqlmock.ErrCancelled)
+			},
+			expectedAccrued: 0,
+			expectedError:   sqlmock.ErrCancelled,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, fineRepository := createFineRepository()
+			tt.mockFunction(mock)
+			accrued, err := fineRepository.SumOverdueByLoanId(context.Background(), loanId)
+			if err != tt.expectedError {
+				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
+			}
+			if accrued != tt.expectedAccrued {
+				t.Errorf("Expected accrued %d, got %d", tt.expectedAccrued, accrued)
+			}
+		})
+	}
+}
+
+func TestFindOverdueLoansForUpdate(t *testing.T) {
+
+	now := time.Date(2023, 2, 1, 10, 0, 0, 0, time.UTC)
+	dueAt := now.AddDate(0, 0, -3)
+	loanId := uuid.New()
+	query := regexp.QuoteMeta(`SELECT * FROM "loans" WHERE returned_at IS NULL AND due_at < $1 ORDER BY due_at FOR UPDATE SKIP LOCKED`)
+
+	tc := []struct {
+		name          string
+		mockFunction  func(mock sqlmock.Sqlmock)
+		expectedCount int
+		expectedError error
+	}{
+		{
+			name: "Find overdue loans successfully",
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(now).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "due_at"}).AddRow(loanId.String(), dueAt))
+			},
+			expectedCount: 1,
+			expectedError: nil,
+		},
+		{
+			name: "Find overdue loans with error",
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(now).
+					WillReturnError(sqlmock.ErrCancelled)
+			},
+			expectedCount: 0,
+			expectedError: sqlmock.ErrCancelled,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, fineRepository := createFineRepository()
+			tt.mockFunction(mock)
+			loans, err := fineRepository.FindOverdueLoansForUpdate(context.Background(), now)
+			if err != tt.expectedError {
+				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
+			}
+			if len(loans) != tt.expectedCount {
+				t.Errorf("Expected %d loans, got %d", tt.expectedCount, len(loans))
+			}
+		})
+	}
+}
+
+func TestFindItemById(t *testing.T) {
+
+	itemId := uuid.New()
+	query := regexp.QuoteMeta(`SELECT * FROM "items" WHERE "items"."id" = $1 ORDER BY "items"."id" LIMIT 1`)
+
+	tc := []struct {
+		name          string
+		mockFunction  func(mock sqlmock.Sqlmock)
+		expectedError error
+	}{
+		{
+			name: "Find item by id successfully",
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(itemId.String()).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(itemId.String()))
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Find item by id with error",
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(itemId.String()).
+					WillReturnError(sqlmock.ErrCancelled)
+			},
+			expectedError: sqlmock.ErrCancelled,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, fineRepository := createFineRepository()
+			tt.mockFunction(mock)
+			_, err := fineRepository.FindItemById(context.Background(), itemId)
+			if err != tt.expectedError {
+				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/database"
+	"github.com/minand-mohan/library-app-api/database/models"
+	"gorm.io/gorm"
+)
+
+type FineRepository interface {
+	// WithTransaction runs fn against a repository bound to a single
+	// database transaction. The transaction is rolled back if fn returns an
+	// error and committed otherwise.
+	WithTransaction(fn func(repo FineRepository) error) error
+	CreateFineEntry(entry *models.FineEntry) error
+	FindAllFineEntriesByUserId(ctx context.Context, userId uuid.UUID) ([]models.FineEntry, error)
+	LockUserForUpdate(ctx context.Context, userId uuid.UUID) error
+	SumByUserId(ctx context.Context, userId uuid.UUID) (int64, error)
+	SumOverdueByLoanId(ctx context.Context, loanId uuid.UUID) (int64, error)
+	FindOverdueLoansForUpdate(ctx context.Context, now time.Time) ([]models.Loan, error)
+	FindItemById(ctx context.Context, id uuid.UUID) (*models.Item, error)
+}
+
+type FineRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewFineRepository(db *gorm.DB) FineRepository {
+	return &FineRepositoryImpl{db}
+}
+
+// errors are translated as described at database.Transaction
+func (repo *FineRepositoryImpl) WithTransaction(fn func(repo FineRepository) error) error {
+	return database.Transaction(repo.db, func(tx *gorm.DB) error {
+		return fn(&FineRepositoryImpl{tx})
+	})
+}
@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/database/models"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+var fineEntryColumns = []string{"id", "user_id", "loan_id", "item_id", "kind", "amount_cents", "note", "created_at"}
+
+func createFineRepository() (sqlmock.Sqlmock, FineRepository) {
+	var (
+		db   *sql.DB
+		mock sqlmock.Sqlmock
+	)
+
+	db, mock, _ = sqlmock.New()
+	dialector := postgres.New(postgres.Config{
+		DSN:                  "sqlmock_db_0",
+		DriverName:           "postgres",
+		Conn:                 db,
+		PreferSimpleProtocol: true,
+	})
+	sDb, _ := gorm.Open(dialector, &gorm.Config{})
+
+	fineRepository := NewFineRepository(sDb)
+
+	return mock, fineRepository
+}
+
+func generateRandomFineEntry01() models.FineEntry {
+	//initialize variables
+	test_kind := models.FineKindLost
+	test_amount_cents := int64(2500)
+	test_created_at := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	test_id := uuid.New()
+	test_user_id := uuid.New()
+	test_item_id := uuid.New()
+	return models.FineEntry{
+		ID:          &test_id,
+		UserID:      &test_user_id,
+		ItemID:      &test_item_id,
+		Kind:        &test_kind,
+		AmountCents: &test_amount_cents,
+		CreatedAt:   &test_created_at,
+	}
+}
+
+func fineEntryRow(rows *sqlmock.Rows, entry models.FineEntry) *sqlmock.Rows {
+	return rows.AddRow(entry.ID, entry.UserID, entry.LoanID, entry.ItemID, entry.Kind, entry.AmountCents, entry.Note, entry.CreatedAt)
+}
+
+func TestWithTransaction(t *testing.T) {
+	entry := generateRandomFineEntry01()
+	fnError := errors.New("amount exceeds balance")
+
+	tc := []struct {
+		name          string
+		fn            func(repo FineRepository) error
+		mockFunction  func(mock sqlmock.Sqlmock)
+		expectedError error
+	}{
+		{
+			name: "Transaction committed",
+			fn: func(repo FineRepository) error {
+				_, err := repo.SumByUserId(context.Background(), *entry.UserID)
+				return err
+			},
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(`SELECT COALESCE(SUM(amount_cents), 0) FROM "fine_entries" WHERE user_id = $1`)).
+					WithArgs(*entry.UserID).
+					WillReturnRows(sqlmock.NewRows([]string{"coalesce"}).AddRow(0))
+				mock.ExpectCommit()
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Transaction rolled back",
+			fn: func(repo FineRepository) error {
+				return fnError
+			},
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectRollback()
+			},
+			expectedError: fnError,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, fineRepository := createFineRepository()
+			tt.mockFunction(mock)
+			err := fineRepository.WithTransaction(tt.fn)
+			if err != tt.expectedError {
+				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Unmet expectations: %v", err)
+			}
+		})
+	}
+}
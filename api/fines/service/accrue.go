@@ -0,0 +1,37 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/minand-mohan/library-app-api/api/fines/repository"
+)
+
+func (service *FineServiceImpl) AccrueOverdueFines(ctx context.Context) (int, error) {
+	service.logger.Info("Fine Service: Accrue overdue fines")
+
+	posted := 0
+	err := service.repo.WithTransaction(func(repo repository.FineRepository) error {
+		now := time.Now().UTC()
+		loans, err := repo.FindOverdueLoansForUpdate(ctx, now)
+		if err != nil {
+			return err
+		}
+		for i := range loans {
+			_, charged, err := SettleOverdueFine(ctx, repo, service.config, &loans[i], now)
+			if err != nil {
+				return err
+			}
+			if charged {
+				posted++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		service.logger.Error(fmt.Sprintf("FineService: Error while accruing overdue fines: %s", err))
+		return 0, err
+	}
+	return posted, nil
+}
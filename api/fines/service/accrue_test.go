@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	repomocks "github.com/minand-mohan/library-app-api/api/fines/repository/mocks"
+	userrepomocks "github.com/minand-mohan/library-app-api/api/users/repository/mocks"
+	"github.com/minand-mohan/library-app-api/database/models"
+	"github.com/minand-mohan/library-app-api/utils"
+)
+
+func TestAccrueOverdueFines(t *testing.T) {
+
+	internalServerError := errors.New("Internal Server Error")
+
+	// three days late with one day of grace owes 50 cents
+	overdue_loan := generateOverdueLoan01(3)
+	// still within the grace period, so nothing is owed yet
+	grace_loan := generateOverdueLoan01(0)
+
+	tc := []struct {
+		name                string
+		expectedPosted      int
+		expectedError       error
+		mockFindLoansReturn []models.Loan
+		mockFindLoansError  error
+		mockAccrued         int64
+		expectedAmount      int64
+	}{
+		{
+			name:                "Accrue overdue fines sucessfully",
+			expectedPosted:      1,
+			mockFindLoansReturn: []models.Loan{overdue_loan, grace_loan},
+			expectedAmount:      50,
+		},
+		{
+			name:                "Accrue overdue fines tops up earlier charges",
+			expectedPosted:      1,
+			mockFindLoansReturn: []models.Loan{overdue_loan},
+			mockAccrued:         25,
+			expectedAmount:      25,
+		},
+		{
+			name:                "Accrue overdue fines already up to date",
+			expectedPosted:      0,
+			mockFindLoansReturn: []models.Loan{overdue_loan},
+			mockAccrued:         50,
+		},
+		{
+			name:               "Accrue overdue fines with error",
+			expectedPosted:     0,
+			expectedError:      internalServerError,
+			mockFindLoansError: internalServerError,
+		},
+	}
+
+	for _, tt := range tc {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := repomocks.NewMockFineRepository(mockCtrl)
+			expectTransaction(mockRepo)
+			mockRepo.EXPECT().FindOverdueLoansForUpdate(gomock.Any(), gomock.Any()).Return(tt.mockFindLoansReturn, tt.mockFindLoansError)
+			if tt.mockFindLoansError == nil {
+				mockRepo.EXPECT().SumOverdueByLoanId(gomock.Any(), *overdue_loan.ID).Return(tt.mockAccrued, nil)
+			}
+			if tt.expectedAmount != 0 {
+				mockRepo.EXPECT().CreateFineEntry(gomock.Any()).DoAndReturn(func(entry *models.FineEntry) error {
+					if *entry.LoanID != *overdue_loan.ID || *entry.Kind != models.FineKindOverdue {
+						t.Errorf("Expected overdue entry for loan %s, got %s for %v", overdue_loan.ID, *entry.Kind, entry.LoanID)
+					}
+					if *entry.AmountCents != tt.expectedAmount {
+						t.Errorf("Expected amount %d, got %d", tt.expectedAmount, *entry.AmountCents)
+					}
+					return nil
+				})
+			}
+
+			service := NewFineService(mockRepo, userrepomocks.NewMockUserRepository(mockCtrl), testCirculationConfig, *utils.NewLogger())
+			posted, err := service.AccrueOverdueFines(context.Background())
+
+			if err != tt.expectedError {
+				t.Errorf("Expected error %v, got %v", tt.expectedError, err)
+			}
+			if posted != tt.expectedPosted {
+				t.Errorf("Expected %d entries posted, got %d", tt.expectedPosted, posted)
+			}
+		})
+	}
+}
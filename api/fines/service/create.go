@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/api/fines/dto"
+	"github.com/minand-mohan/library-app-api/api/fines/repository"
+	"github.com/minand-mohan/library-app-api/api/response"
+	"github.com/minand-mohan/library-app-api/database/models"
+)
+
+// RecordFineEntry records a manual fee, a payment or a waiver. Fees are
+// charged to the user while payments and waivers are credited against their
+// outstanding balance.
+func (service *FineServiceImpl) RecordFineEntry(ctx context.Context, userId uuid.UUID, fineReq *dto.FineEntryRequestBody) (*response.HTTPResponse, error) {
+	service.logger.Info("Fine Service: Record fine entry")
+	_, err := service.userRepo.FindByUserId(ctx, userId)
+	if err != nil {
+		service.logger.Error(fmt.Sprintf("FineService: Error while finding user by id: %s", err))
+		return response.GetRepositoryErrorHTTPResponseBody(err, "User not found."), err
+	}
+	entry := &models.FineEntry{}
+	if fineReq.ItemID != "" {
+		itemId, _ := uuid.Parse(fineReq.ItemID)
+		_, err = service.repo.FindItemById(ctx, itemId)
+		if err != nil {
+			service.logger.Error(fmt.Sprintf("FineService: Error while finding item by id: %s", err))
+			return response.GetRepositoryErrorHTTPResponseBody(err, "Item not found."), err
+		}
+		entry.ItemID = &itemId
+	}
+
+	var responseBody *response.HTTPResponse
+	var balance int64
+	err = service.repo.WithTransaction(func(repo repository.FineRepository) error {
+		// concurrent payments and waivers would both be checked against the
+		// same balance and could credit more than is owed
+		err := repo.LockUserForUpdate(ctx, userId)
+		if err != nil {
+			return err
+		}
+		balance, err = repo.SumByUserId(ctx, userId)
+		if err != nil {
+			return err
+		}
+		amount := fineReq.AmountCents
+		if fineReq.Kind == models.FineKindPayment || fineReq.Kind == models.FineKindWaiver {
+			if amount > balance {
+				service.logger.Error(fmt.Sprintf("FineService: Credit of %d exceeds balance of %d", amount, balance))
+				responseBody = &response.HTTPResponse{
+					Code:    400,
+					Message: "Bad request, amount exceeds balance",
+					Content: map[string]interface{}{
+						"balance_cents": balance,
+					},
+				}
+				return errors.New("amount exceeds balance")
+			}
+			amount = -amount
+		}
+
+		createdAt := time.Now().UTC()
+		entry.UserID = &userId
+		entry.Kind = &fineReq.Kind
+		entry.AmountCents = &amount
+		if fineReq.Note != "" {
+			entry.Note = &fineReq.Note
+		}
+		entry.CreatedAt = &createdAt
+		err = repo.CreateFineEntry(entry)
+		if err != nil {
+			return err
+		}
+		balance += amount
+		return nil
+	})
+	if err != nil {
+		if responseBody != nil {
+			return responseBody, err
+		}
+		service.logger.Error(fmt.Sprintf("FineService: Error while recording fine entry: %s", err))
+		return response.GetRepositoryErrorHTTPResponseBody(err, "User not found."), err
+	}
+
+	responseContent := fineEntryResponseContent(entry)
+	responseContent["balance_cents"] = balance
+	responseBody = &response.HTTPResponse{
+		Code:    200,
+		Message: "Fine entry recorded successfully",
+		Content: responseContent,
+	}
+	return responseBody, nil
+}
@@ -0,0 +1,216 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/minand-mohan/library-app-api/api/fines/dto"
+	repomocks "github.com/minand-mohan/library-app-api/api/fines/repository/mocks"
+	"github.com/minand-mohan/library-app-api/api/response"
+	userrepomocks "github.com/minand-mohan/library-app-api/api/users/repository/mocks"
+	"github.com/minand-mohan/library-app-api/database/dberrors"
+	"github.com/minand-mohan/library-app-api/database/models"
+	"github.com/minand-mohan/library-app-api/utils"
+)
+
+func TestRecordFineEntry(t *testing.T) {
+
+	internalServerError := errors.New("Internal Server Error")
+	recordNotFoundError := dberrors.ErrNotFound
+
+	test_user := generateRandomUser01()
+	test_item := generateRandomItem01()
+
+	test_cases_that_require_transaction := map[string]bool{
+		"Record fee sucessfully":              true,
+		"Record payment sucessfully":          true,
+		"Record payment exceeding balance":    true,
+		"Record fine entry with lock error":   true,
+		"Record fine entry with sum error":    true,
+		"Record fine entry with create error": true,
+	}
+	test_cases_that_require_create := map[string]bool{
+		"Record fee sucessfully":              true,
+		"Record payment sucessfully":          true,
+		"Record fine entry with create error": true,
+	}
+
+	tc := []struct {
+		name                 string
+		requestBody          *dto.FineEntryRequestBody
+		expectedResponse     *response.HTTPResponse
+		expectedError        error
+		expectedAmount       int64
+		expectedBalance      int64
+		mockFindUserError    error
+		mockFindItemError    error
+		mockLockError        error
+		mockBalance          int64
+		mockSumError         error
+		mockCreateEntryError error
+	}{
+		{
+			name: "Record fee sucessfully",
+			requestBody: &dto.FineEntryRequestBody{
+				Kind:        models.FineKindLost,
+				AmountCents: 2500,
+				ItemID:      test_item.ID.String(),
+			},
+			expectedResponse: &response.HTTPResponse{
+				Code:    200,
+				Message: "Fine entry recorded successfully",
+			},
+			expectedAmount:  2500,
+			expectedBalance: 2600,
+			mockBalance:     100,
+		},
+		{
+			name: "Record payment sucessfully",
+			requestBody: &dto.FineEntryRequestBody{
+				Kind:        models.FineKindPayment,
+				AmountCents: 300,
+			},
+			expectedResponse: &response.HTTPResponse{
+				Code:    200,
+				Message: "Fine entry recorded successfully",
+			},
+			expectedAmount:  -300,
+			expectedBalance: 200,
+			mockBalance:     500,
+		},
+		{
+			name: "Record fine entry for missing user",
+			requestBody: &dto.FineEntryRequestBody{
+				Kind:        models.FineKindPayment,
+				AmountCents: 300,
+			},
+			expectedResponse: &response.HTTPResponse{
+				Code:    404,
+				Message: "User not found.",
+			},
+			expectedError:     recordNotFoundError,
+			mockFindUserError: recordNotFoundError,
+		},
+		{
+			name: "Record fee for missing item",
+			requestBody: &dto.FineEntryRequestBody{
+				Kind:        models.FineKindDamaged,
+				AmountCents: 500,
+				ItemID:      test_item.ID.String(),
+			},
+			expectedResponse: &response.HTTPResponse{
+				Code:    404,
+				Message: "Item not found.",
+			},
+			expectedError:     recordNotFoundError,
+			mockFindItemError: recordNotFoundError,
+		},
+		{
+			name: "Record payment exceeding balance",
+			requestBody: &dto.FineEntryRequestBody{
+				Kind:        models.FineKindWaiver,
+				AmountCents: 300,
+			},
+			expectedResponse: &response.HTTPResponse{
+				Code:    400,
+				Message: "Bad request, amount exceeds balance",
+			},
+			expectedError: errors.New("amount exceeds balance"),
+			mockBalance:   200,
+		},
+		{
+			name: "Record fine entry with lock error",
+			requestBody: &dto.FineEntryRequestBody{
+				Kind:        models.FineKindPayment,
+				AmountCents: 300,
+			},
+			expectedResponse: &response.HTTPResponse{
+				Code:    500,
+				Message: "Internal Server Error",
+			},
+			expectedError: internalServerError,
+			mockLockError: internalServerError,
+		},
+		{
+			name: "Record fine entry with sum error",
+			requestBody: &dto.FineEntryRequestBody{
+				Kind:        models.FineKindPayment,
+				AmountCents: 300,
+			},
+			expectedResponse: &response.HTTPResponse{
+				Code:    500,
+				Message: "Internal Server Error",
+			},
+			expectedError: internalServerError,
+			mockSumError:  internalServerError,
+		},
+		{
+			name: "Record fine entry with create error",
+			requestBody: &dto.FineEntryRequestBody{
+				Kind:        models.FineKindPayment,
+				AmountCents: 300,
+			},
+			expectedResponse: &response.HTTPResponse{
+				Code:    500,
+				Message: "Internal Server Error",
+			},
+			expectedError:        internalServerError,
+			expectedAmount:       -300,
+			mockBalance:          500,
+			mockCreateEntryError: internalServerError,
+		},
+	}
+
+	for _, tt := range tc {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := repomocks.NewMockFineRepository(mockCtrl)
+			mockUserRepo := userrepomocks.NewMockUserRepository(mockCtrl)
+			mockUserRepo.EXPECT().FindByUserId(gomock.Any(), *test_user.ID).Return(&test_user, tt.mockFindUserError)
+			if tt.mockFindUserError == nil && tt.requestBody.ItemID != "" {
+				mockRepo.EXPECT().FindItemById(gomock.Any(), *test_item.ID).Return(&test_item, tt.mockFindItemError)
+			}
+			if test_cases_that_require_transaction[tt.name] {
+				expectTransaction(mockRepo)
+				lock := mockRepo.EXPECT().LockUserForUpdate(gomock.Any(), *test_user.ID).Return(tt.mockLockError)
+				if tt.mockLockError == nil {
+					// the user is locked before the balance is read
+					mockRepo.EXPECT().SumByUserId(gomock.Any(), *test_user.ID).Return(tt.mockBalance, tt.mockSumError).After(lock)
+				}
+			}
+			if test_cases_that_require_create[tt.name] {
+				mockRepo.EXPECT().CreateFineEntry(gomock.Any()).DoAndReturn(func(entry *models.FineEntry) error {
+					if *entry.AmountCents != tt.expectedAmount {
+						t.Errorf("Expected amount %d, got %d", tt.expectedAmount, *entry.AmountCents)
+					}
+					return tt.mockCreateEntryError
+				})
+			}
+
+			service := NewFineService(mockRepo, mockUserRepo, testCirculationConfig, *utils.NewLogger())
+			response, err := service.RecordFineEntry(context.Background(), *test_user.ID, tt.requestBody)
+
+			if err != nil && tt.expectedError != nil {
+				if err.Error() != tt.expectedError.Error() {
+					t.Errorf("Expected error %v, got %v", tt.expectedError, err)
+				}
+			}
+			if response.Code != tt.expectedResponse.Code {
+				t.Errorf("Expected code %d, got %d", tt.expectedResponse.Code, response.Code)
+			}
+			if response.Message != tt.expectedResponse.Message {
+				t.Errorf("Expected message %s, got %s", tt.expectedResponse.Message, response.Message)
+			}
+			if response.Code == 200 {
+				content := response.Content.(map[string]interface{})
+				if content["balance_cents"].(int64) != tt.expectedBalance {
+					t.Errorf("Expected balance %d, got %d", tt.expectedBalance, content["balance_cents"].(int64))
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package mocks
+
+import (
+	"context"
+	"reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/api/fines/dto"
+	"github.com/minand-mohan/library-app-api/api/response"
+)
+
+// MockFineService is a mock of FineService interface.
+type MockFineService struct {
+	ctrl     *gomock.Controller
+	recorder *MockFineServiceMockRecorder
+}
+
+// MockFineServiceMockRecorder is the mock recorder for MockFineService.
+type MockFineServiceMockRecorder struct {
+	mock *MockFineService
+}
+
+// NewMockFineService creates a new mock instance.
+func NewMockFineService(ctrl *gomock.Controller) *MockFineService {
+	mock := &MockFineService{ctrl: ctrl}
+	mock.recorder = &MockFineServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFineService) EXPECT() *MockFineServiceMockRecorder {
+	return m.recorder
+}
+
+// RecordFineEntry mocks base method.
+func (m *MockFineService) RecordFineEntry(arg0 context.Context, arg1 uuid.UUID, arg2 *dto.FineEntryRequestBody) (*response.HTTPResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordFineEntry", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*response.HTTPResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordFineEntry indicates an expected call of RecordFineEntry.
+func (mr *MockFineServiceMockRecorder) RecordFineEntry(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordFineEntry", reflect.TypeOf((*MockFineService)(nil).RecordFineEntry), arg0, arg1, arg2)
+}
+
+// FindAllFineEntriesByUserId mocks base method.
+func (m *MockFineService) FindAllFineEntriesByUserId(arg0 context.Context, arg1 uuid.UUID) (*response.HTTPResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllFineEntriesByUserId", arg0, arg1)
+	ret0, _ := ret[0].(*response.HTTPResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllFineEntriesByUserId indicates an expected call of FindAllFineEntriesByUserId.
+func (mr *MockFineServiceMockRecorder) FindAllFineEntriesByUserId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllFineEntriesByUserId", reflect.TypeOf((*MockFineService)(nil).FindAllFineEntriesByUserId), arg0, arg1)
+}
+
+// AccrueOverdueFines mocks base method.
+func (m *MockFineService) AccrueOverdueFines(arg0 context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AccrueOverdueFines", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AccrueOverdueFines indicates an expected call of AccrueOverdueFines.
+func (mr *MockFineServiceMockRecorder) AccrueOverdueFines(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccrueOverdueFines", reflect.TypeOf((*MockFineService)(nil).AccrueOverdueFines), arg0)
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/api/response"
+)
+
+func (service *FineServiceImpl) FindAllFineEntriesByUserId(ctx context.Context, userId uuid.UUID) (*response.HTTPResponse, error) {
+	service.logger.Info("Fine Service: Find all fine entries by user id")
+	_, err := service.userRepo.FindByUserId(ctx, userId)
+	if err != nil {
+		service.logger.Error(fmt.Sprintf("FineService: Error while finding user by id: %s", err))
+		return response.GetRepositoryErrorHTTPResponseBody(err, "User not found."), err
+	}
+
+	entries, err := service.repo.FindAllFineEntriesByUserId(ctx, userId)
+	if err != nil {
+		service.logger.Error(fmt.Sprintf("FineService: Error while finding all fine entries: %s", err))
+		return response.GetRepositoryErrorHTTPResponseBody(err, "User not found."), err
+	}
+	if len(entries) == 0 {
+		service.logger.Error("FineService: No fine entries found")
+		responseBody := response.HTTPResponse{
+			Code:    404,
+			Message: "No fine entries found",
+			Content: map[string]interface{}{},
+		}
+		return &responseBody, nil
+	}
+	var balance int64
+	var entriesMap []map[string]interface{}
+	for i := range entries {
+		balance += *entries[i].AmountCents
+		entriesMap = append(entriesMap, fineEntryResponseContent(&entries[i]))
+	}
+	responseBody := response.HTTPResponse{
+		Code:    200,
+		Message: "Fine entries found successfully",
+		Content: map[string]interface{}{
+			"balance_cents": balance,
+			"count":         len(entries),
+			"results":       entriesMap,
+		},
+	}
+	return &responseBody, nil
+}
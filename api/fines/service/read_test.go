@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	repomocks "github.com/minand-mohan/library-app-api/api/fines/repository/mocks"
+	"github.com/minand-mohan/library-app-api/api/response"
+	userrepomocks "github.com/minand-mohan/library-app-api/api/users/repository/mocks"
+	"github.com/minand-mohan/library-app-api/database/dberrors"
+	"github.com/minand-mohan/library-app-api/database/models"
+	"github.com/minand-mohan/library-app-api/utils"
+)
+
+func TestFindAllFineEntriesByUserId(t *testing.T) {
+
+	internalServerError := errors.New("Internal Server Error")
+	recordNotFoundError := dberrors.ErrNotFound
+
+	test_user := generateRandomUser01()
+	fee_entry := generateRandomFineEntry01()
+	payment_entry := generateRandomFineEntry01()
+	payment_kind := models.FineKindPayment
+	payment_amount := int64(-1000)
+	payment_entry.Kind = &payment_kind
+	payment_entry.AmountCents = &payment_amount
+
+	tc := []struct {
+		name                  string
+		expectedResponse      *response.HTTPResponse
+		expectedError         error
+		mockFindUserError     error
+		mockFindEntriesReturn []models.FineEntry
+		mockFindEntriesError  error
+	}{
+		{
+			name: "Find all fine entries sucessfully",
+			expectedResponse: &response.HTTPResponse{
+				Code:    200,
+				Message: "Fine entries found successfully",
+			},
+			expectedError:         nil,
+			mockFindEntriesReturn: []models.FineEntry{fee_entry, payment_entry},
+		},
+		{
+			name: "Find all fine entries for missing user",
+			expectedResponse: &response.HTTPResponse{
+				Code:    404,
+				Message: "User not found.",
+			},
+			expectedError:     recordNotFoundError,
+			mockFindUserError: recordNotFoundError,
+		},
+		{
+			name: "Find all fine entries with no entries",
+			expectedResponse: &response.HTTPResponse{
+				Code:    404,
+				Message: "No fine entries found",
+			},
+			expectedError:         nil,
+			mockFindEntriesReturn: []models.FineEntry{},
+		},
+		{
+			name: "Find all fine entries with error",
+			expectedResponse: &response.HTTPResponse{
+				Code:    500,
+				Message: "Internal Server Error",
+			},
+			expectedError:        internalServerError,
+			mockFindEntriesError: internalServerError,
+		},
+	}
+
+	for _, tt := range tc {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := repomocks.NewMockFineRepository(mockCtrl)
+			mockUserRepo := userrepomocks.NewMockUserRepository(mockCtrl)
+			mockUserRepo.EXPECT().FindByUserId(gomock.Any(), *test_user.ID).Return(&test_user, tt.mockFindUserError)
+			if tt.mockFindUserError == nil {
+				mockRepo.EXPECT().FindAllFineEntriesByUserId(gomock.Any(), *test_user.ID).Return(tt.mockFindEntriesReturn, tt.mockFindEntriesError)
+			}
+
+			service := NewFineService(mockRepo, mockUserRepo, testCirculationConfig, *utils.NewLogger())
+			responseBody, err := service.FindAllFineEntriesByUserId(context.Background(), *test_user.ID)
+
+			if err != nil && tt.expectedError != nil {
+				if err.Error() != tt.expectedError.Error() {
+					t.Errorf("Expected error %v, got %v", tt.expectedError, err)
+				}
+			}
+			if responseBody.Code != tt.expectedResponse.Code {
+				t.Errorf("Expected code %d, got %d", tt.expectedResponse.Code, responseBody.Code)
+			}
+			if responseBody.Message != tt.expectedResponse.Message {
+				t.Errorf("Expected message %s, got %s", tt.expectedResponse.Message, responseBody.Message)
+			}
+			if tt.name == "Find all fine entries sucessfully" {
+				content := responseBody.Content.(map[string]interface{})
+				if content["balance_cents"].(int64) != 1500 {
+					t.Errorf("Expected balance 1500, got %d", content["balance_cents"].(int64))
+				}
+			}
+		})
+	}
+}
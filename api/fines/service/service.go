@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/api/fines/dto"
+	"github.com/minand-mohan/library-app-api/api/fines/repository"
+	"github.com/minand-mohan/library-app-api/api/response"
+	userRepository "github.com/minand-mohan/library-app-api/api/users/repository"
+	"github.com/minand-mohan/library-app-api/database/models"
+	"github.com/minand-mohan/library-app-api/system"
+	"github.com/minand-mohan/library-app-api/utils"
+)
+
+type FineService interface {
+	RecordFineEntry(ctx context.Context, userId uuid.UUID, fineReqBody *dto.FineEntryRequestBody) (*response.HTTPResponse, error)
+	FindAllFineEntriesByUserId(ctx context.Context, userId uuid.UUID) (*response.HTTPResponse, error)
+	// AccrueOverdueFines charges loans that are out past their due date for
+	// the days late so far. It returns the number of ledger entries posted.
+	AccrueOverdueFines(ctx context.Context) (int, error)
+}
+
+type FineServiceImpl struct {
+	repo     repository.FineRepository
+	userRepo userRepository.UserRepository
+	config   *system.CirculationConfig
+	logger   *utils.AppLogger
+}
+
+func NewFineService(repo repository.FineRepository, userRepo userRepository.UserRepository, config *system.CirculationConfig, logger utils.AppLogger) FineService {
+	return &FineServiceImpl{
+		repo:     repo,
+		userRepo: userRepo,
+		config:   config,
+		logger:   &logger,
+	}
+}
+
+// SettleOverdueFine posts whatever part of a loan's overdue fine at now has
+// not been charged yet. It returns the loan's overdue fine so far and
+// whether an entry was posted. Both the accrual job and loan returns call
+// it, holding the loan's row lock, so the two never charge the same days.
+func SettleOverdueFine(ctx context.Context, repo repository.FineRepository, config *system.CirculationConfig, loan *models.Loan, now time.Time) (int64, bool, error) {
+	owed := config.OverdueFineCents(*loan.DueAt, now)
+	if owed == 0 {
+		return 0, false, nil
+	}
+	accrued, err := repo.SumOverdueByLoanId(ctx, *loan.ID)
+	if err != nil {
+		return 0, false, err
+	}
+	if owed <= accrued {
+		return accrued, false, nil
+	}
+	kind := models.FineKindOverdue
+	amount := owed - accrued
+	err = repo.CreateFineEntry(&models.FineEntry{
+		UserID:      loan.UserID,
+		LoanID:      loan.ID,
+		ItemID:      loan.ItemID,
+		Kind:        &kind,
+		AmountCents: &amount,
+		CreatedAt:   &now,
+	})
+	if err != nil {
+		return 0, false, err
+	}
+	return owed, true, nil
+}
+
+func fineEntryResponseContent(entry *models.FineEntry) map[string]interface{} {
+	return map[string]interface{}{
+		"id":           entry.ID,
+		"user_id":      entry.UserID,
+		"loan_id":      entry.LoanID,
+		"item_id":      entry.ItemID,
+		"kind":         entry.Kind,
+		"amount_cents": entry.AmountCents,
+		"note":         entry.Note,
+		"created_at":   entry.CreatedAt,
+	}
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/api/fines/repository"
+	repomocks "github.com/minand-mohan/library-app-api/api/fines/repository/mocks"
+	"github.com/minand-mohan/library-app-api/database/models"
+	"github.com/minand-mohan/library-app-api/system"
+)
+
+var testCirculationConfig = &system.CirculationConfig{
+	LoanPeriodDays:          14,
+	MaxRenewals:             2,
+	HoldPickupDays:          3,
+	FineDailyCents:          25,
+	FineGraceDays:           1,
+	FineMaxPerItemCents:     1000,
+	FineBlockThresholdCents: 500,
+}
+
+// expectTransaction makes the mock repository run the transaction callback
+// against itself
+func expectTransaction(mockRepo *repomocks.MockFineRepository) {
+	mockRepo.EXPECT().WithTransaction(gomock.Any()).DoAndReturn(func(fn func(repo repository.FineRepository) error) error {
+		return fn(mockRepo)
+	})
+}
+
+func generateRandomUser01() models.User {
+	//initialize variables
+	test_email := "test1@example.com"
+	test_username := "test1"
+	test_phone := "1234567890"
+	test_id := uuid.New()
+	return models.User{
+		ID:       &test_id,
+		Email:    &test_email,
+		Username: &test_username,
+		Phone:    &test_phone,
+	}
+}
+
+func generateRandomItem01() models.Item {
+	//initialize variables
+	test_barcode := "LIB-000001"
+	test_status := models.ItemStatusAvailable
+	test_id := uuid.New()
+	test_book_id := uuid.New()
+	return models.Item{
+		ID:      &test_id,
+		BookID:  &test_book_id,
+		Barcode: &test_barcode,
+		Status:  &test_status,
+	}
+}
+
+func generateRandomFineEntry01() models.FineEntry {
+	//initialize variables
+	test_kind := models.FineKindLost
+	test_amount_cents := int64(2500)
+	test_created_at := time.Now().UTC().AddDate(0, 0, -3)
+	test_id := uuid.New()
+	test_user_id := uuid.New()
+	test_item_id := uuid.New()
+	return models.FineEntry{
+		ID:          &test_id,
+		UserID:      &test_user_id,
+		ItemID:      &test_item_id,
+		Kind:        &test_kind,
+		AmountCents: &test_amount_cents,
+		CreatedAt:   &test_created_at,
+	}
+}
+
+// generateOverdueLoan01 returns an open loan that fell due daysLate days ago
+func generateOverdueLoan01(daysLate int) models.Loan {
+	test_due_at := time.Now().UTC().AddDate(0, 0, -daysLate).Add(-time.Hour)
+	test_checked_out_at := test_due_at.AddDate(0, 0, -14)
+	test_renewal_count := 0
+	test_id := uuid.New()
+	test_user_id := uuid.New()
+	test_item_id := uuid.New()
+	return models.Loan{
+		ID:           &test_id,
+		UserID:       &test_user_id,
+		ItemID:       &test_item_id,
+		CheckedOutAt: &test_checked_out_at,
+		DueAt:        &test_due_at,
+		RenewalCount: &test_renewal_count,
+	}
+}
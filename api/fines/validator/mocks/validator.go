@@ -0,0 +1,40 @@
+package mocks
+
+import (
+	"reflect"
+
+	"github.com/golang/mock/gomock"
+	"github.com/minand-mohan/library-app-api/api/fines/dto"
+)
+
+// MockFineValidator is a mock type for the FineValidator type
+type MockFineValidator struct {
+	ctrl     *gomock.Controller
+	recorder *MockFineValidatorMockRecorder
+}
+
+type MockFineValidatorMockRecorder struct {
+	mock *MockFineValidator
+}
+
+func NewMockFineValidator(ctrl *gomock.Controller) *MockFineValidator {
+	mock := &MockFineValidator{ctrl: ctrl}
+	mock.recorder = &MockFineValidatorMockRecorder{mock}
+	return mock
+}
+
+func (m *MockFineValidator) EXPECT() *MockFineValidatorMockRecorder {
+	return m.recorder
+}
+
+func (m *MockFineValidator) ValidateFineEntry(arg0 *dto.FineEntryRequestBody) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateFineEntry", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockFineValidatorMockRecorder) ValidateFineEntry(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateFineEntry", reflect.TypeOf((*MockFineValidator)(nil).ValidateFineEntry), arg0)
+}
@@ -0,0 +1,63 @@
+package validator
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/api/fines/dto"
+	"github.com/minand-mohan/library-app-api/database/models"
+	"github.com/minand-mohan/library-app-api/utils"
+)
+
+// Kinds of ledger entries that can be recorded by staff. Overdue fines are
+// only ever accrued by the system.
+var validKinds = map[string]bool{
+	models.FineKindLost:    true,
+	models.FineKindDamaged: true,
+	models.FineKindPayment: true,
+	models.FineKindWaiver:  true,
+}
+
+// Kinds of fees that are charged for a specific copy
+var itemKinds = map[string]bool{
+	models.FineKindLost:    true,
+	models.FineKindDamaged: true,
+}
+
+type FineValidator interface {
+	ValidateFineEntry(requestBody *dto.FineEntryRequestBody) error
+}
+
+type FineValidatorImpl struct {
+	logger *utils.AppLogger
+}
+
+func NewFineValidator(logger utils.AppLogger) FineValidator {
+	return &FineValidatorImpl{
+		logger: &logger,
+	}
+}
+
+func (validator *FineValidatorImpl) ValidateFineEntry(fineReq *dto.FineEntryRequestBody) error {
+	validator.logger.Info("Validate fine entry")
+	if !validKinds[fineReq.Kind] {
+		validator.logger.Error("Kind is invalid")
+		return errors.New("Kind is invalid")
+	}
+	if fineReq.AmountCents <= 0 {
+		validator.logger.Error("Amount is not positive")
+		return errors.New("Amount is not positive")
+	}
+	if itemKinds[fineReq.Kind] && fineReq.ItemID == "" {
+		validator.logger.Error("Item id is empty")
+		return errors.New("Item id is empty")
+	}
+	if fineReq.ItemID != "" {
+		if _, err := uuid.Parse(fineReq.ItemID); err != nil {
+			validator.logger.Error("Item id is invalid")
+			return errors.New("Item id is invalid")
+		}
+	}
+
+	return nil
+}
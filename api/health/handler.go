@@ -0,0 +1,143 @@
+// Package health answers the liveness and readiness probes. Neither needs
+// authentication.
+package health
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/minand-mohan/library-app-api/api/response"
+	"github.com/minand-mohan/library-app-api/database"
+	"github.com/minand-mohan/library-app-api/utils"
+	"gorm.io/gorm"
+)
+
+// Statuses of the server and of each component
+const (
+	StatusUp   = "up"
+	StatusDown = "down"
+)
+
+// A readiness probe gives up on a component after this long
+const checkTimeout = 2 * time.Second
+
+type ComponentStatus struct {
+	Status string `json:"status"`
+	// Why the component is down
+	Detail string `json:"detail,omitempty"`
+}
+
+// Report is the content of a probe response. The server is up only when
+// every component is.
+type Report struct {
+	Status     string                     `json:"status"`
+	Components map[string]ComponentStatus `json:"components,omitempty"`
+}
+
+type HealthHandler struct {
+	db       *gorm.DB
+	migrator *database.Migrator
+	logger   *utils.AppLogger
+	// Set once the server starts shutting down
+	draining atomic.Bool
+}
+
+func NewHealthHandler(db *gorm.DB, migrator *database.Migrator, logger utils.AppLogger) *HealthHandler {
+	return &HealthHandler{
+		db:       db,
+		migrator: migrator,
+		logger:   &logger,
+	}
+}
+
+// Live answers as long as the server can handle requests at all
+func (handler *HealthHandler) Live(ctx *fiber.Ctx) error {
+	body := &response.HTTPResponse{
+		Code:    http.StatusOK,
+		Message: "Alive",
+		Content: Report{Status: StatusUp},
+	}
+	return response.WriteHTTPResponse(ctx, http.StatusOK, body)
+}
+
+// Drain makes the readiness probe fail from now on, so that load balancers
+// stop sending requests before the server stops accepting them
+func (handler *HealthHandler) Drain() {
+	handler.draining.Store(true)
+}
+
+// Ready answers 200 when the database can be reached and every migration is
+// applied, and 503 with the components that are down otherwise
+func (handler *HealthHandler) Ready(ctx *fiber.Ctx) error {
+	if handler.draining.Load() {
+		body := &response.HTTPResponse{
+			Code:    http.StatusServiceUnavailable,
+			Message: "Shutting down",
+			Content: Report{Status: StatusDown},
+		}
+		return response.WriteHTTPResponse(ctx, http.StatusServiceUnavailable, body)
+	}
+	report := Report{
+		Status: StatusUp,
+		Components: map[string]ComponentStatus{
+			"database":   handler.checkDatabase(),
+			"migrations": handler.checkMigrations(),
+		},
+	}
+	for name, component := range report.Components {
+		if component.Status != StatusUp {
+			handler.logger.Error(fmt.Sprintf("Readiness check %s failed: %s", name, component.Detail))
+			report.Status = StatusDown
+		}
+	}
+
+	if report.Status != StatusUp {
+		body := &response.HTTPResponse{
+			Code:    http.StatusServiceUnavailable,
+			Message: "Not ready",
+			Content: report,
+		}
+		return response.WriteHTTPResponse(ctx, http.StatusServiceUnavailable, body)
+	}
+	body := &response.HTTPResponse{
+		Code:    http.StatusOK,
+		Message: "Ready",
+		Content: report,
+	}
+	return response.WriteHTTPResponse(ctx, http.StatusOK, body)
+}
+
+func (handler *HealthHandler) checkDatabase() ComponentStatus {
+	sqlDB, err := handler.db.DB()
+	if err != nil {
+		return ComponentStatus{Status: StatusDown, Detail: err.Error()}
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), checkTimeout)
+	defer cancel()
+	if err := sqlDB.PingContext(ctx); err != nil {
+		return ComponentStatus{Status: StatusDown, Detail: err.Error()}
+	}
+	return ComponentStatus{Status: StatusUp}
+}
+
+func (handler *HealthHandler) checkMigrations() ComponentStatus {
+	ctx, cancel := context.WithTimeout(context.Background(), checkTimeout)
+	defer cancel()
+	pending, err := handler.migrator.Pending(ctx)
+	if err != nil {
+		return ComponentStatus{Status: StatusDown, Detail: err.Error()}
+	}
+	if len(pending) > 0 {
+		names := make([]string, 0, len(pending))
+		for _, migration := range pending {
+			names = append(names, fmt.Sprintf("%04d_%s", migration.Version, migration.Name))
+		}
+		return ComponentStatus{Status: StatusDown, Detail: "pending migrations: " + strings.Join(names, ", ")}
+	}
+	return ComponentStatus{Status: StatusUp}
+}
@@ -0,0 +1,152 @@
+package health
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/minand-mohan/library-app-api/database"
+	"github.com/minand-mohan/library-app-api/utils"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+var testMigrations = []database.Migration{
+	{Version: 1, Name: "create_a"},
+	{Version: 2, Name: "create_b"},
+}
+
+func TestLive(t *testing.T) {
+	app := fiber.New()
+	handler := NewHealthHandler(nil, nil, *utils.NewLogger())
+	app.Get("/health/live", handler.Live)
+
+	res, err := app.Test(httptest.NewRequest("GET", "/health/live", nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode != 200 {
+		t.Errorf("Expected status code 200, got %d", res.StatusCode)
+	}
+}
+
+func TestReady(t *testing.T) {
+
+	tc := []struct {
+		name               string
+		appliedVersions    []int64
+		migrationsError    error
+		closeDB            bool
+		expectedStatus     int
+		expectedComponents map[string]ComponentStatus
+	}{
+		{
+			name:            "Ready",
+			appliedVersions: []int64{1, 2},
+			expectedStatus:  200,
+			expectedComponents: map[string]ComponentStatus{
+				"database":   {Status: StatusUp},
+				"migrations": {Status: StatusUp},
+			},
+		},
+		{
+			name:            "Pending migrations",
+			appliedVersions: []int64{1},
+			expectedStatus:  503,
+			expectedComponents: map[string]ComponentStatus{
+				"database":   {Status: StatusUp},
+				"migrations": {Status: StatusDown, Detail: "pending migrations: 0002_create_b"},
+			},
+		},
+		{
+			name:            "Migration state unreadable",
+			migrationsError: errors.New("relation \"schema_migrations\" does not exist"),
+			expectedStatus:  503,
+			expectedComponents: map[string]ComponentStatus{
+				"database":   {Status: StatusUp},
+				"migrations": {Status: StatusDown, Detail: "relation \"schema_migrations\" does not exist"},
+			},
+		},
+		{
+			name:           "Database unreachable",
+			closeDB:        true,
+			expectedStatus: 503,
+			expectedComponents: map[string]ComponentStatus{
+				"database":   {Status: StatusDown, Detail: "sql: database is closed"},
+				"migrations": {Status: StatusDown, Detail: "sql: database is closed"},
+			},
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			sqlDB, mock, _ := sqlmock.New()
+			db, _ := gorm.Open(postgres.New(postgres.Config{
+				DSN:                  "sqlmock_db_0",
+				DriverName:           "postgres",
+				Conn:                 sqlDB,
+				PreferSimpleProtocol: true,
+			}), &gorm.Config{})
+			query := mock.ExpectQuery(regexp.QuoteMeta(`SELECT version, applied_at FROM schema_migrations`))
+			if tt.migrationsError != nil {
+				query.WillReturnError(tt.migrationsError)
+			} else {
+				rows := sqlmock.NewRows([]string{"version", "applied_at"})
+				for _, version := range tt.appliedVersions {
+					rows.AddRow(version, time.Now())
+				}
+				query.WillReturnRows(rows)
+			}
+			if tt.closeDB {
+				mock.ExpectClose()
+				sqlDB.Close()
+			}
+
+			logger := utils.NewLogger()
+			handler := NewHealthHandler(db, database.NewMigrator(db, testMigrations, *logger), *logger)
+			app := fiber.New()
+			app.Get("/health/ready", handler.Ready)
+
+			res, err := app.Test(httptest.NewRequest("GET", "/health/ready", nil))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.StatusCode != tt.expectedStatus {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatus, res.StatusCode)
+			}
+			body, _ := io.ReadAll(res.Body)
+			var responseBody struct {
+				Content Report `json:"content"`
+			}
+			if err := json.Unmarshal(body, &responseBody); err != nil {
+				t.Fatalf("Expected a JSON body, got %s", body)
+			}
+			for name, expected := range tt.expectedComponents {
+				if responseBody.Content.Components[name] != expected {
+					t.Errorf("Expected %s to be %+v, got %+v", name, expected, responseBody.Content.Components[name])
+				}
+			}
+		})
+	}
+}
+
+func TestReadyWhileDraining(t *testing.T) {
+	app := fiber.New()
+	handler := NewHealthHandler(nil, nil, *utils.NewLogger())
+	handler.Drain()
+	app.Get("/health/ready", handler.Ready)
+
+	res, err := app.Test(httptest.NewRequest("GET", "/health/ready", nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode != 503 {
+		t.Errorf("Expected status code 503, got %d", res.StatusCode)
+	}
+}
@@ -0,0 +1,5 @@
+package dto
+
+type HoldRequestBody struct {
+	BookID string `json:"book_id"`
+}
@@ -0,0 +1,119 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/minand-mohan/library-app-api/api/holds/service/mocks"
+	"github.com/minand-mohan/library-app-api/api/holds/validator"
+	"github.com/minand-mohan/library-app-api/api/response"
+	"github.com/minand-mohan/library-app-api/utils"
+)
+
+func TestCancelHold(t *testing.T) {
+	testCases := []struct {
+		name                      string
+		id                        string
+		holdId                    string
+		mockServiceExpectResponse *response.HTTPResponse
+		mockServiceExpectError    error
+		expectedStatus            int
+		expectedMessage           string
+	}{
+		{
+			name:   "Cancel hold with valid ids",
+			id:     "d3b3b3b3-3b3b-3b3b-3b3b-3b3b3b3b3b3b",
+			holdId: "a1b1b1b1-3b3b-3b3b-3b3b-3b3b3b3b3b3b",
+			mockServiceExpectResponse: &response.HTTPResponse{
+				Code:    200,
+				Message: "Hold cancelled successfully",
+				Content: map[string]interface{}{},
+			},
+			mockServiceExpectError: nil,
+			expectedStatus:         200,
+			expectedMessage:        "Hold cancelled successfully",
+		},
+		{
+			name:                      "Cancel hold with invalid user id",
+			id:                        "invalid-id",
+			holdId:                    "a1b1b1b1-3b3b-3b3b-3b3b-3b3b3b3b3b3b",
+			mockServiceExpectResponse: nil,
+			mockServiceExpectError:    nil,
+			expectedStatus:            400,
+			expectedMessage:           "Bad request, invalid id",
+		},
+		{
+			name:                      "Cancel hold with invalid hold id",
+			id:                        "d3b3b3b3-3b3b-3b3b-3b3b-3b3b3b3b3b3b",
+			holdId:                    "invalid-id",
+			mockServiceExpectResponse: nil,
+			mockServiceExpectError:    nil,
+			expectedStatus:            400,
+			expectedMessage:           "Bad request, invalid hold id",
+		},
+		{
+			name:   "Cancel hold with error",
+			id:     "d3b3b3b3-3b3b-3b3b-3b3b-3b3b3b3b3b3b",
+			holdId: "a1b1b1b1-3b3b-3b3b-3b3b-3b3b3b3b3b3b",
+			mockServiceExpectResponse: &response.HTTPResponse{
+				Code:    500,
+				Message: "Internal server error",
+				Content: map[string]interface{}{},
+			},
+			mockServiceExpectError: errors.New("Internal server error"),
+			expectedStatus:         500,
+			expectedMessage:        "Internal server error",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			app := setupApp()
+			app.Delete("/users/:id/holds/:holdId", func(c *fiber.Ctx) error {
+				logger := utils.NewLogger()
+				service := mocks.NewMockHoldService(mockCtrl)
+				validator := validator.NewHoldValidator(*logger)
+				if tc.mockServiceExpectResponse != nil {
+					service.EXPECT().CancelHold(gomock.Any(), gomock.Any()).Return(tc.mockServiceExpectResponse, tc.mockServiceExpectError)
+				}
+				handler := NewHoldHandler(service, validator)
+				return handler.CancelHold(c)
+			})
+			request := httptest.NewRequest("DELETE", "/users/"+tc.id+"/holds/"+tc.holdId, nil)
+
+			response, err := app.Test(request)
+			if err != nil {
+				t.Errorf("Error while making request %v", err)
+			}
+
+			if response.StatusCode != tc.expectedStatus {
+				t.Errorf("Expected status code %d, got %d", tc.expectedStatus, response.StatusCode)
+			}
+
+			bodyBytes, err := ioutil.ReadAll(response.Body)
+			if err != nil {
+				t.Errorf("Error while reading response body: %v", err)
+			}
+
+			var responseBody map[string]interface{}
+
+			err = json.Unmarshal(bodyBytes, &responseBody)
+			if err != nil {
+				t.Errorf("Error while parsing response body: %v", err)
+			}
+
+			message := responseBody["message"].(string)
+			if message != tc.expectedMessage {
+				t.Errorf("Expected message %s, got %s", tc.expectedMessage, message)
+			}
+		})
+	}
+}
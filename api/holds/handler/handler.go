@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"github.com/minand-mohan/library-app-api/api/holds/service"
+	"github.com/minand-mohan/library-app-api/api/holds/validator"
+)
+
+type HoldHandler struct {
+	service   service.HoldService
+	validator validator.HoldValidator
+}
+
+func NewHoldHandler(service service.HoldService, validator validator.HoldValidator) *HoldHandler {
+	return &HoldHandler{
+		service:   service,
+		validator: validator,
+	}
+}
@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"fmt"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/api/holds/dto"
+	"github.com/minand-mohan/library-app-api/api/response"
+	"github.com/minand-mohan/library-app-api/utils"
+)
+
+func (handler *HoldHandler) PlaceHold(ctx *fiber.Ctx) error {
+	log := utils.ContextLogger(ctx)
+	log.Info("Place hold")
+	id := ctx.Params("id")
+	userId, err := uuid.Parse(id)
+	if err != nil {
+		log.Error(fmt.Sprintf("Error while parsing uuid %v", err))
+		responseBody := response.HTTPResponse{
+			Code:    400,
+			Message: "Bad request, invalid id",
+			Content: map[string]interface{}{},
+		}
+		err = response.WriteHTTPResponse(ctx, 400, &responseBody)
+		if err != nil {
+			log.Error(fmt.Sprintf("Error while writing response %v", err))
+			return err
+		}
+		return nil
+	}
+	holdReq := &dto.HoldRequestBody{}
+	err = response.DecodeJSONObject(ctx.Request().Body(), holdReq)
+	if err != nil {
+		log.Error(fmt.Sprintf("Error while unmarshalling request body %v", err))
+		responseBody := response.GetValidationErrorHTTPResponseBody(err)
+		err := response.WriteHTTPResponse(ctx, 400, responseBody)
+		if err != nil {
+			log.Error(fmt.Sprintf("Error while writing response %v", err))
+			return err
+		}
+		return nil
+	}
+	err = handler.validator.ValidateHold(holdReq)
+	if err != nil {
+		log.Error(fmt.Sprintf("Error while validating request body %v", err))
+		responseBody := response.HTTPResponse{
+			Code:    400,
+			Message: "Bad request, invalid request body",
+			Content: map[string]interface{}{},
+		}
+		err = response.WriteHTTPResponse(ctx, 400, &responseBody)
+		if err != nil {
+			log.Error(fmt.Sprintf("Error while writing response %v", err))
+			return err
+		}
+		return nil
+	}
+
+	responseBody, err := handler.service.PlaceHold(ctx.UserContext(), userId, holdReq)
+	if err != nil {
+		log.Error(fmt.Sprintf("HoldHandler: Error while placing hold %v", err))
+		err = response.WriteHTTPResponse(ctx, responseBody.Code, responseBody)
+		if err != nil {
+			log.Error(fmt.Sprintf("Error while writing response %v", err))
+			return err
+		}
+		return nil
+	}
+
+	err = response.WriteHTTPResponse(ctx, 200, responseBody)
+	if err != nil {
+		log.Error(fmt.Sprintf("Error while writing response %v", err))
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	gomock "github.com/golang/mock/gomock"
+	servicemocks "github.com/minand-mohan/library-app-api/api/holds/service/mocks"
+	validatormocks "github.com/minand-mohan/library-app-api/api/holds/validator/mocks"
+	"github.com/minand-mohan/library-app-api/api/response"
+)
+
+func TestPlaceHold(t *testing.T) {
+
+	testCases := []struct {
+		name                      string
+		userId                    string
+		requestBody               map[string]interface{}
+		mockServiceExpectResponse *response.HTTPResponse
+		mockServiceExpectError    error
+		mockValidatorExpectError  error
+		expectedStatus            int
+		expectedMessage           string
+	}{
+		{
+			name:   "Place hold with valid request body",
+			userId: "d3b3b3b3-3b3b-3b3b-3b3b-3b3b3b3b3b3b",
+			requestBody: map[string]interface{}{
+				"book_id": "a1b1b1b1-3b3b-3b3b-3b3b-3b3b3b3b3b3b",
+			},
+			mockServiceExpectResponse: &response.HTTPResponse{
+				Code:    200,
+				Message: "Hold placed successfully",
+				Content: map[string]interface{}{},
+			},
+			mockServiceExpectError:   nil,
+			mockValidatorExpectError: nil,
+			expectedStatus:           200,
+			expectedMessage:          "Hold placed successfully",
+		},
+		{
+			name:   "Place hold with invalid user id",
+			userId: "invalid-id",
+			requestBody: map[string]interface{}{
+				"book_id": "a1b1b1b1-3b3b-3b3b-3b3b-3b3b3b3b3b3b",
+			},
+			mockServiceExpectResponse: nil,
+			mockServiceExpectError:    nil,
+			mockValidatorExpectError:  nil,
+			expectedStatus:            400,
+			expectedMessage:           "Bad request, invalid id",
+		},
+		{
+			name:                      "Place hold with empty book id",
+			userId:                    "d3b3b3b3-3b3b-3b3b-3b3b-3b3b3b3b3b3b",
+			requestBody:               map[string]interface{}{},
+			mockServiceExpectResponse: nil,
+			mockServiceExpectError:    nil,
+			mockValidatorExpectError:  errors.New("Book id is empty"),
+			expectedStatus:            400,
+			expectedMessage:           "Bad request, invalid request body",
+		},
+		{
+			name:   "Place hold with available copies",
+			userId: "d3b3b3b3-3b3b-3b3b-3b3b-3b3b3b3b3b3b",
+			requestBody: map[string]interface{}{
+				"book_id": "a1b1b1b1-3b3b-3b3b-3b3b-3b3b3b3b3b3b",
+			},
+			mockServiceExpectResponse: &response.HTTPResponse{
+				Code:    400,
+				Message: "Bad request, book has available copies",
+				Content: map[string]interface{}{},
+			},
+			mockServiceExpectError:   errors.New("book has available copies"),
+			mockValidatorExpectError: nil,
+			expectedStatus:           400,
+			expectedMessage:          "Bad request, book has available copies",
+		},
+		{
+			name:            "Place hold with null request body",
+			userId:          "d3b3b3b3-3b3b-3b3b-3b3b-3b3b3b3b3b3b",
+			requestBody:     nil,
+			expectedStatus:  400,
+			expectedMessage: "Bad request, invalid request body",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			app := setupApp()
+			app.Post("/users/:id/holds", func(c *fiber.Ctx) error {
+				validator := validatormocks.NewMockHoldValidator(mockCtrl)
+				service := servicemocks.NewMockHoldService(mockCtrl)
+				if tc.mockServiceExpectResponse != nil {
+					service.EXPECT().PlaceHold(gomock.Any(), gomock.Any(), gomock.Any()).Return(tc.mockServiceExpectResponse, tc.mockServiceExpectError)
+				}
+				if tc.userId != "invalid-id" && tc.requestBody != nil {
+					validator.EXPECT().ValidateHold(gomock.Any()).Return(tc.mockValidatorExpectError)
+				}
+				handler := NewHoldHandler(service, validator)
+				return handler.PlaceHold(c)
+			})
+			requestBody, err := json.Marshal(tc.requestBody)
+			if err != nil {
+				t.Errorf("Error while marshalling request body: %v", err)
+			}
+			request := httptest.NewRequest("POST", "/users/"+tc.userId+"/holds", strings.NewReader(string(requestBody)))
+
+			response, err := app.Test(request)
+			if err != nil {
+				t.Errorf("Error while making request %v", err)
+			}
+
+			if response.StatusCode != tc.expectedStatus {
+				t.Errorf("Expected status code %d, got %d", tc.expectedStatus, response.StatusCode)
+			}
+
+			bodyBytes, err := ioutil.ReadAll(response.Body)
+			if err != nil {
+				t.Errorf("Error while reading response body: %v", err)
+			}
+
+			var responseBody map[string]interface{}
+
+			err = json.Unmarshal(bodyBytes, &responseBody)
+			if err != nil {
+				t.Errorf("Error while parsing response body: %v", err)
+			}
+
+			message := responseBody["message"].(string)
+			if message != tc.expectedMessage {
+				t.Errorf("Expected message %s, got %s", tc.expectedMessage, message)
+			}
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"github.com/minand-mohan/library-app-api/database/dberrors"
+	"github.com/minand-mohan/library-app-api/database/models"
+)
+
+// CreateHold places a new hold
+func (repo *HoldRepositoryImpl) CreateHold(holdObj *models.Hold) error {
+	result := repo.db.Create(&holdObj)
+	if result.Error != nil {
+		return dberrors.Translate(result.Error)
+	}
+	return nil
+}
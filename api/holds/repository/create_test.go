@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/minand-mohan/library-app-api/database/models"
+
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+func TestCreateHold(t *testing.T) {
+	test_id := "123e4567-e89b-12d3-a456-426614174000"
+	newHold := func() *models.Hold {
+		hold := generateRandomHold01()
+		hold.ID = nil
+		return &hold
+	}
+	query := regexp.QuoteMeta(`INSERT INTO "holds" ("user_id","book_id","item_id","status","placed_at","ready_at","expires_at","closed_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`)
+
+	tc := []struct {
+		name          string
+		hold          *models.Hold
+		mockFunction  func(mock sqlmock.Sqlmock, hold *models.Hold) error
+		expectedError error
+	}{
+		{
+			name: "Hold created successfully",
+			hold: newHold(),
+			mockFunction: func(mock sqlmock.Sqlmock, hold *models.Hold) error {
+				mock.ExpectBegin()
+				mock.ExpectQuery(query).
+					WithArgs(*hold.UserID, *hold.BookID, nil, *hold.Status, *hold.PlacedAt, nil, nil, nil).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(test_id))
+				mock.ExpectCommit()
+				return nil
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Hold creation failed",
+			hold: newHold(),
+			mockFunction: func(mock sqlmock.Sqlmock, hold *models.Hold) error {
+				err := sqlmock.ErrCancelled
+				mock.ExpectBegin()
+				mock.ExpectQuery(query).
+					WillReturnError(err)
+				mock.ExpectRollback()
+				return err
+			},
+			expectedError: sqlmock.ErrCancelled,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, holdRepository := createHoldRepository()
+			tt.mockFunction(mock, tt.hold)
+			err := holdRepository.CreateHold(tt.hold)
+			if err != tt.expectedError {
+				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
+			}
+		})
+	}
+}
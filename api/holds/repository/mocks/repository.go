@@ -0,0 +1,173 @@
+package mocks
+
+import (
+	"reflect"
+	"time"
+
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/api/holds/repository"
+	"github.com/minand-mohan/library-app-api/database/models"
+)
+
+// MockHoldRepository is a mock of HoldRepository interface.
+type MockHoldRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockHoldRepositoryMockRecorder
+}
+
+// MockHoldRepositoryMockRecorder is the mock recorder for MockHoldRepository.
+type MockHoldRepositoryMockRecorder struct {
+	mock *MockHoldRepository
+}
+
+// NewMockHoldRepository creates a new mock instance.
+func NewMockHoldRepository(ctrl *gomock.Controller) *MockHoldRepository {
+	mock := &MockHoldRepository{ctrl: ctrl}
+	mock.recorder = &MockHoldRepositoryMockRecorder{mock}
+	return mock
+}
+
+func (m *MockHoldRepository) EXPECT() *MockHoldRepositoryMockRecorder {
+	return m.recorder
+}
+
+func (m *MockHoldRepository) WithTransaction(arg0 func(repository.HoldRepository) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTransaction", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockHoldRepositoryMockRecorder) WithTransaction(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTransaction", reflect.TypeOf((*MockHoldRepository)(nil).WithTransaction), arg0)
+}
+
+func (m *MockHoldRepository) CreateHold(arg0 *models.Hold) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateHold", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockHoldRepositoryMockRecorder) CreateHold(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHold", reflect.TypeOf((*MockHoldRepository)(nil).CreateHold), arg0)
+}
+
+func (m *MockHoldRepository) FindByHoldIdForUpdate(arg0 uuid.UUID) (*models.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByHoldIdForUpdate", arg0)
+	ret0, _ := ret[0].(*models.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockHoldRepositoryMockRecorder) FindByHoldIdForUpdate(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHoldIdForUpdate", reflect.TypeOf((*MockHoldRepository)(nil).FindByHoldIdForUpdate), arg0)
+}
+
+func (m *MockHoldRepository) FindActiveHoldByUserAndBook(arg0 uuid.UUID, arg1 uuid.UUID) (*models.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActiveHoldByUserAndBook", arg0, arg1)
+	ret0, _ := ret[0].(*models.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockHoldRepositoryMockRecorder) FindActiveHoldByUserAndBook(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActiveHoldByUserAndBook", reflect.TypeOf((*MockHoldRepository)(nil).FindActiveHoldByUserAndBook), arg0, arg1)
+}
+
+func (m *MockHoldRepository) FindActiveHoldsByUserId(arg0 uuid.UUID) ([]models.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActiveHoldsByUserId", arg0)
+	ret0, _ := ret[0].([]models.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockHoldRepositoryMockRecorder) FindActiveHoldsByUserId(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActiveHoldsByUserId", reflect.TypeOf((*MockHoldRepository)(nil).FindActiveHoldsByUserId), arg0)
+}
+
+func (m *MockHoldRepository) FindNextPendingHoldForUpdate(arg0 uuid.UUID) (*models.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindNextPendingHoldForUpdate", arg0)
+	ret0, _ := ret[0].(*models.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockHoldRepositoryMockRecorder) FindNextPendingHoldForUpdate(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindNextPendingHoldForUpdate", reflect.TypeOf((*MockHoldRepository)(nil).FindNextPendingHoldForUpdate), arg0)
+}
+
+func (m *MockHoldRepository) FindExpiredHoldsForUpdate(arg0 time.Time) ([]models.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindExpiredHoldsForUpdate", arg0)
+	ret0, _ := ret[0].([]models.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockHoldRepositoryMockRecorder) FindExpiredHoldsForUpdate(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindExpiredHoldsForUpdate", reflect.TypeOf((*MockHoldRepository)(nil).FindExpiredHoldsForUpdate), arg0)
+}
+
+func (m *MockHoldRepository) CountHoldsAhead(arg0 *models.Hold) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountHoldsAhead", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockHoldRepositoryMockRecorder) CountHoldsAhead(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountHoldsAhead", reflect.TypeOf((*MockHoldRepository)(nil).CountHoldsAhead), arg0)
+}
+
+func (m *MockHoldRepository) CountAvailableItemsByBookId(arg0 uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAvailableItemsByBookId", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockHoldRepositoryMockRecorder) CountAvailableItemsByBookId(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAvailableItemsByBookId", reflect.TypeOf((*MockHoldRepository)(nil).CountAvailableItemsByBookId), arg0)
+}
+
+func (m *MockHoldRepository) UpdateByHoldId(arg0 uuid.UUID, arg1 *models.Hold) (*models.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateByHoldId", arg0, arg1)
+	ret0, _ := ret[0].(*models.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockHoldRepositoryMockRecorder) UpdateByHoldId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateByHoldId", reflect.TypeOf((*MockHoldRepository)(nil).UpdateByHoldId), arg0, arg1)
+}
+
+func (m *MockHoldRepository) UpdateItemStatus(arg0 uuid.UUID, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateItemStatus", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockHoldRepositoryMockRecorder) UpdateItemStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateItemStatus", reflect.TypeOf((*MockHoldRepository)(nil).UpdateItemStatus), arg0, arg1)
+}
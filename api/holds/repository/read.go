@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/database/dberrors"
+	"github.com/minand-mohan/library-app-api/database/models"
+	"gorm.io/gorm/clause"
+)
+
+// Retrieve a hold by its ID, locking the row until the transaction ends
+func (repo *HoldRepositoryImpl) FindByHoldIdForUpdate(id uuid.UUID) (*models.Hold, error) {
+	var hold models.Hold
+	result := repo.db.Clauses(clause.Locking{Strength: "UPDATE"}).First(&hold, id)
+	if result.Error != nil {
+		return nil, dberrors.Translate(result.Error)
+	}
+	return &hold, nil
+}
+
+// Retrieve the hold a user still has queued or waiting on a book
+func (repo *HoldRepositoryImpl) FindActiveHoldByUserAndBook(userId uuid.UUID, bookId uuid.UUID) (*models.Hold, error) {
+	var hold models.Hold
+	result := repo.db.First(&hold, "user_id = ? AND book_id = ? AND status IN ?", userId, bookId, models.HoldActiveStatuses)
+	if result.Error != nil {
+		return nil, dberrors.Translate(result.Error)
+	}
+	return &hold, nil
+}
+
+// List the holds a user still has queued or waiting, oldest first
+func (repo *HoldRepositoryImpl) FindActiveHoldsByUserId(userId uuid.UUID) ([]models.Hold, error) {
+	var holds []models.Hold
+	result := repo.db.Where("user_id = ? AND status IN ?", userId, models.HoldActiveStatuses).
+		Order("placed_at, id").
+		Find(&holds)
+	if result.Error != nil {
+		return nil, dberrors.Translate(result.Error)
+	}
+	return holds, nil
+}
+
+// Retrieve the hold at the head of a book's queue, locking the row until the
+// transaction ends. Returns nil when nobody is waiting for the book.
+func (repo *HoldRepositoryImpl) FindNextPendingHoldForUpdate(bookId uuid.UUID) (*models.Hold, error) {
+	var holds []models.Hold
+	result := repo.db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("book_id = ? AND status = ?", bookId, models.HoldStatusPending).
+		Order("placed_at, id").
+		Limit(1).
+		Find(&holds)
+	if result.Error != nil {
+		return nil, dberrors.Translate(result.Error)
+	}
+	if len(holds) == 0 {
+		return nil, nil
+	}
+	return &holds[0], nil
+}
+
+// List the ready holds whose pickup window closed before now, locking the
+// rows until the transaction ends
+func (repo *HoldRepositoryImpl) FindExpiredHoldsForUpdate(now time.Time) ([]models.Hold, error) {
+	var holds []models.Hold
+	result := repo.db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("status = ? AND expires_at < ?", models.HoldStatusReady, now).
+		Order("expires_at").
+		Find(&holds)
+	if result.Error != nil {
+		return nil, dberrors.Translate(result.Error)
+	}
+	return holds, nil
+}
+
+// Count the pending holds queued ahead of hold on the same book
+func (repo *HoldRepositoryImpl) CountHoldsAhead(hold *models.Hold) (int64, error) {
+	var count int64
+	result := repo.db.Model(&models.Hold{}).
+		Where("book_id = ? AND status = ?", hold.BookID, models.HoldStatusPending).
+		Where("placed_at < ? OR (placed_at = ? AND id < ?)", hold.PlacedAt, hold.PlacedAt, hold.ID).
+		Count(&count)
+	if result.Error != nil {
+		return 0, dberrors.Translate(result.Error)
+	}
+	return count, nil
+}
+
+// Count the copies of a book that can be checked out right now
+func (repo *HoldRepositoryImpl) CountAvailableItemsByBookId(bookId uuid.UUID) (int64, error) {
+	var count int64
+	result := repo.db.Model(&models.Item{}).
+		Where("book_id = ? AND status = ?", bookId, models.ItemStatusAvailable).
+		Count(&count)
+	if result.Error != nil {
+		return 0, dberrors.Translate(result.Error)
+	}
+	return count, nil
+}
@@ -0,0 +1,340 @@
+package repository
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/database/models"
+
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+func TestFindByHoldIdForUpdate(t *testing.T) {
+
+	hold := generateRandomHold01()
+	query := regexp.QuoteMeta(`SELECT * FROM "holds" WHERE "holds"."id" = $1 ORDER BY "holds"."id" LIMIT 1 FOR UPDATE`)
+
+	tc := []struct {
+		name          string
+		mockFunction  func(mock sqlmock.Sqlmock)
+		expectedError error
+	}{
+		{
+			name: "Find hold by id successfully",
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(hold.ID.String()).
+					WillReturnRows(holdRow(sqlmock.NewRows(holdColumns), hold))
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Find hold by id with error",
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(hold.ID.String()).
+					WillReturnError(sqlmock.ErrCancelled)
+			},
+			expectedError: sqlmock.ErrCancelled,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, holdRepository := createHoldRepository()
+			tt.mockFunction(mock)
+			_, err := holdRepository.FindByHoldIdForUpdate(*hold.ID)
+			if err != tt.expectedError {
+				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestFindActiveHoldByUserAndBook(t *testing.T) {
+
+	hold := generateRandomHold01()
+	query := regexp.QuoteMeta(`SELECT * FROM "holds" WHERE user_id = $1 AND book_id = $2 AND status IN ($3,$4) ORDER BY "holds"."id" LIMIT 1`)
+
+	tc := []struct {
+		name          string
+		mockFunction  func(mock sqlmock.Sqlmock)
+		expectedError error
+	}{
+		{
+			name: "Find active hold successfully",
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(*hold.UserID, *hold.BookID, models.HoldStatusPending, models.HoldStatusReady).
+					WillReturnRows(holdRow(sqlmock.NewRows(holdColumns), hold))
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Find active hold with error",
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(*hold.UserID, *hold.BookID, models.HoldStatusPending, models.HoldStatusReady).
+					WillReturnError(sqlmock.ErrCancelled)
+			},
+			expectedError: sqlmock.ErrCancelled,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, holdRepository := createHoldRepository()
+			tt.mockFunction(mock)
+			_, err := holdRepository.FindActiveHoldByUserAndBook(*hold.UserID, *hold.BookID)
+			if err != tt.expectedError {
+				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestFindActiveHoldsByUserId(t *testing.T) {
+
+	hold := generateRandomHold01()
+	query := regexp.QuoteMeta(`SELECT * FROM "holds" WHERE user_id = $1 AND status IN ($2,$3) ORDER BY placed_at, id`)
+
+	tc := []struct {
+		name          string
+		mockFunction  func(mock sqlmock.Sqlmock)
+		expectedCount int
+		expectedError error
+	}{
+		{
+			name: "Find active holds successfully",
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(*hold.UserID, models.HoldStatusPending, models.HoldStatusReady).
+					WillReturnRows(holdRow(sqlmock.NewRows(holdColumns), hold))
+			},
+			expectedCount: 1,
+			expectedError: nil,
+		},
+		{
+			name: "Find active holds with error",
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(*hold.UserID, models.HoldStatusPending, models.HoldStatusReady).
+					WillReturnError(sqlmock.ErrCancelled)
+			},
+			expectedCount: 0,
+			expectedError: sqlmock.ErrCancelled,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, holdRepository := createHoldRepository()
+			tt.mockFunction(mock)
+			holds, err := holdRepository.FindActiveHoldsByUserId(*hold.UserID)
+			if err != tt.expectedError {
+				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
+			}
+			if len(holds) != tt.expectedCount {
+				t.Errorf("Expected %d holds, got %d", tt.expectedCount, len(holds))
+			}
+		})
+	}
+}
+
+func TestFindNextPendingHoldForUpdate(t *testing.T) {
+
+	hold := generateRandomHold01()
+	query := regexp.QuoteMeta(`SELECT * FROM "holds" WHERE book_id = $1 AND status = $2 ORDER BY placed_at, id LIMIT 1 FOR UPDATE`)
+
+	tc := []struct {
+		name          string
+		mockFunction  func(mock sqlmock.Sqlmock)
+		expectedHold  bool
+		expectedError error
+	}{
+		{
+			name: "Find next hold successfully",
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(*hold.BookID, models.HoldStatusPending).
+					WillReturnRows(holdRow(sqlmock.NewRows(holdColumns), hold))
+			},
+			expectedHold:  true,
+			expectedError: nil,
+		},
+		{
+			name: "Find next hold with empty queue",
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(*hold.BookID, models.HoldStatusPending).
+					WillReturnRows(sqlmock.NewRows(holdColumns))
+			},
+			expectedHold:  false,
+			expectedError: nil,
+		},
+		{
+			name: "Find next hold with error",
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(*hold.BookID, models.HoldStatusPending).
+					WillReturnError(sqlmock.ErrCancelled)
+			},
+			expectedHold:  false,
+			expectedError: sqlmock.ErrCancelled,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, holdRepository := createHoldRepository()
+			tt.mockFunction(mock)
+			next, err := holdRepository.FindNextPendingHoldForUpdate(*hold.BookID)
+			if err != tt.expectedError {
+				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
+			}
+			if (next != nil) != tt.expectedHold {
+				t.Errorf("Expected hold found: %v, got: %v", tt.expectedHold, next)
+			}
+		})
+	}
+}
+
+func TestFindExpiredHoldsForUpdate(t *testing.T) {
+
+	now := time.Now().UTC()
+	query := regexp.QuoteMeta(`SELECT * FROM "holds" WHERE status = $1 AND expires_at < $2 ORDER BY expires_at FOR UPDATE`)
+
+	tc := []struct {
+		name          string
+		mockFunction  func(mock sqlmock.Sqlmock)
+		expectedError error
+	}{
+		{
+			name: "Find expired holds successfully",
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(models.HoldStatusReady, now).
+					WillReturnRows(sqlmock.NewRows(holdColumns))
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Find expired holds with error",
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(models.HoldStatusReady, now).
+					WillReturnError(sqlmock.ErrCancelled)
+			},
+			expectedError: sqlmock.ErrCancelled,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, holdRepository := createHoldRepository()
+			tt.mockFunction(mock)
+			_, err := holdRepository.FindExpiredHoldsForUpdate(now)
+			if err != tt.expectedError {
+				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestCountHoldsAhead(t *testing.T) {
+
+	hold := generateRandomHold01()
+	query := regexp.QuoteMeta(`SELECT count(*) FROM "holds" WHERE (book_id = $1 AND status = $2) AND (placed_at < $3 OR (placed_at = $4 AND id < $5))`)
+
+	tc := []struct {
+		name          string
+		mockFunction  func(mock sqlmock.Sqlmock)
+		expectedCount int64
+		expectedError error
+	}{
+		{
+			name: "Count holds ahead successfully",
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(*hold.BookID, models.HoldStatusPending, *hold.PlacedAt, *hold.PlacedAt, *hold.ID).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+			},
+			expectedCount: 3,
+			expectedError: nil,
+		},
+		{
+			name: "Count holds ahead with error",
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(*hold.BookID, models.HoldStatusPending, *hold.PlacedAt, *hold.PlacedAt, *hold.ID).
+					WillReturnError(sqlmock.ErrCancelled)
+			},
+			expectedCount: 0,
+			expectedError: sqlmock.ErrCancelled,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, holdRepository := createHoldRepository()
+			tt.mockFunction(mock)
+			count, err := holdRepository.CountHoldsAhead(&hold)
+			if err != tt.expectedError {
+				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
+			}
+			if count != tt.expectedCount {
+				t.Errorf("Expected count: %d, got: %d", tt.expectedCount, count)
+			}
+		})
+	}
+}
+
+func TestCountAvailableItemsByBookId(t *testing.T) {
+
+	test_book_id := uuid.New()
+	query := regexp.QuoteMeta(`SELECT count(*) FROM "items" WHERE book_id = $1 AND status = $2`)
+
+	tc := []struct {
+		name          string
+		mockFunction  func(mock sqlmock.Sqlmock)
+		expectedCount int64
+		expectedError error
+	}{
+		{
+			name: "Count available items successfully",
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(test_book_id, models.ItemStatusAvailable).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+			},
+			expectedCount: 0,
+			expectedError: nil,
+		},
+		{
+			name: "Count available items with error",
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(test_book_id, models.ItemStatusAvailable).
+					WillReturnError(sqlmock.ErrCancelled)
+			},
+			expectedCount: 0,
+			expectedError: sqlmock.ErrCancelled,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, holdRepository := createHoldRepository()
+			tt.mockFunction(mock)
+			count, err := holdRepository.CountAvailableItemsByBookId(test_book_id)
+			if err != tt.expectedError {
+				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
+			}
+			if count != tt.expectedCount {
+				t.Errorf("Expected count: %d, got: %d", tt.expectedCount, count)
+			}
+		})
+	}
+}
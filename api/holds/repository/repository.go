@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/database"
+	"github.com/minand-mohan/library-app-api/database/models"
+	"gorm.io/gorm"
+)
+
+type HoldRepository interface {
+	// WithTransaction runs fn against a repository bound to a single
+	// database transaction. The transaction is rolled back if fn returns an
+	// error and committed otherwise.
+	WithTransaction(fn func(repo HoldRepository) error) error
+	CreateHold(holdObj *models.Hold) error
+	FindByHoldIdForUpdate(id uuid.UUID) (*models.Hold, error)
+	FindActiveHoldByUserAndBook(userId uuid.UUID, bookId uuid.UUID) (*models.Hold, error)
+	FindActiveHoldsByUserId(userId uuid.UUID) ([]models.Hold, error)
+	FindNextPendingHoldForUpdate(bookId uuid.UUID) (*models.Hold, error)
+	FindExpiredHoldsForUpdate(now time.Time) ([]models.Hold, error)
+	CountHoldsAhead(hold *models.Hold) (int64, error)
+	CountAvailableItemsByBookId(bookId uuid.UUID) (int64, error)
+	UpdateByHoldId(id uuid.UUID, hold *models.Hold) (*models.Hold, error)
+	UpdateItemStatus(itemId uuid.UUID, status string) error
+}
+
+type HoldRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewHoldRepository(db *gorm.DB) HoldRepository {
+	return &HoldRepositoryImpl{db}
+}
+
+// errors are translated as described at database.Transaction
+func (repo *HoldRepositoryImpl) WithTransaction(fn func(repo HoldRepository) error) error {
+	return database.Transaction(repo.db, func(tx *gorm.DB) error {
+		return fn(&HoldRepositoryImpl{tx})
+	})
+}
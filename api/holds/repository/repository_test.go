@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/database/models"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+var holdColumns = []string{"id", "user_id", "book_id", "item_id", "status", "placed_at", "ready_at", "expires_at", "closed_at"}
+
+func createHoldRepository() (sqlmock.Sqlmock, HoldRepository) {
+	var (
+		db   *sql.DB
+		mock sqlmock.Sqlmock
+	)
+
+	db, mock, _ = sqlmock.New()
+	dialector := postgres.New(postgres.Config{
+		DSN:                  "sqlmock_db_0",
+		DriverName:           "postgres",
+		Conn:                 db,
+		PreferSimpleProtocol: true,
+	})
+	sDb, _ := gorm.Open(dialector, &gorm.Config{})
+
+	holdRepository := NewHoldRepository(sDb)
+
+	return mock, holdRepository
+}
+
+func generateRandomHold01() models.Hold {
+	//initialize variables
+	test_status := models.HoldStatusPending
+	test_placed_at := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	test_id := uuid.New()
+	test_user_id := uuid.New()
+	test_book_id := uuid.New()
+	return models.Hold{
+		ID:       &test_id,
+		UserID:   &test_user_id,
+		BookID:   &test_book_id,
+		Status:   &test_status,
+		PlacedAt: &test_placed_at,
+	}
+}
+
+func holdRow(rows *sqlmock.Rows, hold models.Hold) *sqlmock.Rows {
+	return rows.AddRow(hold.ID, hold.UserID, hold.BookID, hold.ItemID, hold.Status, hold.PlacedAt, hold.ReadyAt, hold.ExpiresAt, hold.ClosedAt)
+}
+
+func TestWithTransaction(t *testing.T) {
+	hold := generateRandomHold01()
+	fnError := errors.New("hold already exists")
+
+	tc := []struct {
+		name          string
+		fn            func(repo HoldRepository) error
+		mockFunction  func(mock sqlmock.Sqlmock)
+		expectedError error
+	}{
+		{
+			name: "Transaction committed",
+			fn: func(repo HoldRepository) error {
+				return repo.UpdateItemStatus(*hold.BookID, models.ItemStatusOnHold)
+			},
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "items" SET "status"=$1 WHERE id = $2`)).
+					WithArgs(models.ItemStatusOnHold, *hold.BookID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Transaction rolled back",
+			fn: func(repo HoldRepository) error {
+				return fnError
+			},
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectRollback()
+			},
+			expectedError: fnError,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, holdRepository := createHoldRepository()
+			tt.mockFunction(mock)
+			err := holdRepository.WithTransaction(tt.fn)
+			if err != tt.expectedError {
+				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Unmet expectations: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/database/dberrors"
+	"github.com/minand-mohan/library-app-api/database/models"
+)
+
+// Update/Partial update a hold by id
+func (repo *HoldRepositoryImpl) UpdateByHoldId(id uuid.UUID, hold *models.Hold) (*models.Hold, error) {
+	result := repo.db.Model(&hold).Where("id = ?", id).Updates(hold)
+	if result.Error != nil {
+		return nil, dberrors.Translate(result.Error)
+	}
+	return hold, nil
+}
+
+// Update the circulation status of an item
+func (repo *HoldRepositoryImpl) UpdateItemStatus(itemId uuid.UUID, status string) error {
+	result := repo.db.Model(&models.Item{}).Where("id = ?", itemId).Update("status", status)
+	if result.Error != nil {
+		return dberrors.Translate(result.Error)
+	}
+	return nil
+}
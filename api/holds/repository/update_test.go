@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/database/models"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+func TestUpdateHold(t *testing.T) {
+	//initialize variables
+	test_status := models.HoldStatusCancelled
+	test_closed_at := time.Now().UTC()
+	query := regexp.QuoteMeta(`UPDATE "holds" SET "status"=$1,"closed_at"=$2 WHERE id = $3`)
+
+	tc := []struct {
+		name          string
+		hold          *models.Hold
+		id            uuid.UUID
+		mockFunction  func(mock sqlmock.Sqlmock, id uuid.UUID, hold *models.Hold) error
+		expectedError error
+	}{
+		{
+			name: "Hold updated successfully",
+			hold: &models.Hold{
+				Status:   &test_status,
+				ClosedAt: &test_closed_at,
+			},
+			id: uuid.New(),
+			mockFunction: func(mock sqlmock.Sqlmock, id uuid.UUID, hold *models.Hold) error {
+				mock.ExpectBegin()
+				mock.ExpectExec(query).
+					WithArgs(*hold.Status, *hold.ClosedAt, id).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+				return nil
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Hold update failed",
+			hold: &models.Hold{
+				Status:   &test_status,
+				ClosedAt: &test_closed_at,
+			},
+			id: uuid.New(),
+			mockFunction: func(mock sqlmock.Sqlmock, id uuid.UUID, hold *models.Hold) error {
+				err := sqlmock.ErrCancelled
+				mock.ExpectBegin()
+				mock.ExpectExec(query).
+					WithArgs(*hold.Status, *hold.ClosedAt, id).
+					WillReturnError(err)
+				mock.ExpectRollback()
+				return err
+			},
+			expectedError: sqlmock.ErrCancelled,
+		},
+	}
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, holdRepository := createHoldRepository()
+			tt.mockFunction(mock, tt.id, tt.hold)
+			_, err := holdRepository.UpdateByHoldId(tt.id, tt.hold)
+			if err != tt.expectedError {
+				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestUpdateItemStatus(t *testing.T) {
+
+	query := regexp.QuoteMeta(`UPDATE "items" SET "status"=$1 WHERE id = $2`)
+
+	tc := []struct {
+		name          string
+		itemId        uuid.UUID
+		status        string
+		mockFunction  func(mock sqlmock.Sqlmock, itemId uuid.UUID, status string) error
+		expectedError error
+	}{
+		{
+			name:   "Item status updated successfully",
+			itemId: uuid.New(),
+			status: models.ItemStatusOnHold,
+			mockFunction: func(mock sqlmock.Sqlmock, itemId uuid.UUID, status string) error {
+				mock.ExpectBegin()
+				mock.ExpectExec(query).
+					WithArgs(status, itemId).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+				return nil
+			},
+			expectedError: nil,
+		},
+		{
+			name:   "Item status update failed",
+			itemId: uuid.New(),
+			status: models.ItemStatusAvailable,
+			mockFunction: func(mock sqlmock.Sqlmock, itemId uuid.UUID, status string) error {
+				err := sqlmock.ErrCancelled
+				mock.ExpectBegin()
+				mock.ExpectExec(query).
+					WithArgs(status, itemId).
+					WillReturnError(err)
+				mock.ExpectRollback()
+				return err
+			},
+			expectedError: sqlmock.ErrCancelled,
+		},
+	}
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, holdRepository := createHoldRepository()
+			tt.mockFunction(mock, tt.itemId, tt.status)
+			err := holdRepository.UpdateItemStatus(tt.itemId, tt.status)
+			if err != tt.expectedError {
+				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/api/holds/repository"
+	"github.com/minand-mohan/library-app-api/api/response"
+	"github.com/minand-mohan/library-app-api/database/dberrors"
+	"github.com/minand-mohan/library-app-api/database/models"
+)
+
+// CancelHold withdraws a user's hold. A copy already set aside for the hold
+// is passed on to the next user in the queue.
+func (service *HoldServiceImpl) CancelHold(userId uuid.UUID, id uuid.UUID) (*response.HTTPResponse, error) {
+	service.logger.Info("Hold Service: Cancel hold")
+
+	var responseBody *response.HTTPResponse
+	var hold *models.Hold
+	err := service.repo.WithTransaction(func(repo repository.HoldRepository) error {
+		var err error
+		hold, err = repo.FindByHoldIdForUpdate(id)
+		if err == nil && *hold.UserID != userId {
+			// someone else's hold is reported as missing
+			err = fmt.Errorf("%w: hold belongs to another user", dberrors.ErrNotFound)
+		}
+		if err != nil {
+			service.logger.Error(fmt.Sprintf("HoldService: Error while finding hold by id: %s", err))
+			responseBody = response.GetRepositoryErrorHTTPResponseBody(err, "Hold not found.")
+			return err
+		}
+		if hold.ClosedAt != nil {
+			service.logger.Error(fmt.Sprintf("HoldService: Hold %s is no longer active", id))
+			responseBody = &response.HTTPResponse{
+				Code:    400,
+				Message: "Bad request, hold not active",
+				Content: holdResponseContent(hold),
+			}
+			return errors.New("hold not active")
+		}
+
+		wasReady := *hold.Status == models.HoldStatusReady
+		status := models.HoldStatusCancelled
+		closedAt := time.Now().UTC()
+		_, err = repo.UpdateByHoldId(id, &models.Hold{Status: &status, ClosedAt: &closedAt})
+		if err != nil {
+			return err
+		}
+		hold.Status = &status
+		hold.ClosedAt = &closedAt
+		if wasReady && hold.ItemID != nil {
+			_, err = AssignItemToNextHold(repo, service.config, *hold.ItemID, *hold.BookID, closedAt)
+		}
+		return err
+	})
+	if err != nil {
+		if responseBody != nil {
+			return responseBody, err
+		}
+		service.logger.Error(fmt.Sprintf("HoldService: Error while cancelling hold: %s", err))
+		return response.GetRepositoryErrorHTTPResponseBody(err, "Hold not found."), err
+	}
+
+	responseBody = &response.HTTPResponse{
+		Code:    200,
+		Message: "Hold cancelled successfully",
+		Content: holdResponseContent(hold),
+	}
+	return responseBody, nil
+}
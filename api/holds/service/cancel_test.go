@@ -0,0 +1,173 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	bookrepomocks "github.com/minand-mohan/library-app-api/api/books/repository/mocks"
+	repomocks "github.com/minand-mohan/library-app-api/api/holds/repository/mocks"
+	"github.com/minand-mohan/library-app-api/api/response"
+	userrepomocks "github.com/minand-mohan/library-app-api/api/users/repository/mocks"
+	"github.com/minand-mohan/library-app-api/database/dberrors"
+	"github.com/minand-mohan/library-app-api/database/models"
+	"github.com/minand-mohan/library-app-api/utils"
+)
+
+func TestCancelHold(t *testing.T) {
+
+	internalServerError := errors.New("Internal Server Error")
+	recordNotFoundError := dberrors.ErrNotFound
+
+	pending_hold := generateRandomHold01()
+	ready_hold := generateReadyHold01()
+	closed_hold := generateRandomHold01()
+	closed_at := time.Now().UTC()
+	cancelled_status := models.HoldStatusCancelled
+	closed_hold.Status = &cancelled_status
+	closed_hold.ClosedAt = &closed_at
+	next_hold := generateRandomHold01()
+	next_hold.BookID = ready_hold.BookID
+
+	test_cases_that_require_update_hold := map[string]bool{
+		"Cancel pending Hold sucessfully":         true,
+		"Cancel ready Hold passes copy on":        true,
+		"Cancel ready Hold returns copy to shelf": true,
+		"Cancel Hold with error":                  true,
+	}
+
+	tc := []struct {
+		name                string
+		hold                models.Hold
+		expectedResponse    *response.HTTPResponse
+		expectedError       error
+		mockFindHoldReturn  *models.Hold
+		mockFindHoldError   error
+		mockUpdateHoldError error
+		mockNextHoldReturn  *models.Hold
+		expectedItemStatus  string
+	}{
+		{
+			name: "Cancel pending Hold sucessfully",
+			hold: pending_hold,
+			expectedResponse: &response.HTTPResponse{
+				Code:    200,
+				Message: "Hold cancelled successfully",
+			},
+			mockFindHoldReturn: &pending_hold,
+		},
+		{
+			name: "Cancel ready Hold passes copy on",
+			hold: ready_hold,
+			expectedResponse: &response.HTTPResponse{
+				Code:    200,
+				Message: "Hold cancelled successfully",
+			},
+			mockFindHoldReturn: &ready_hold,
+			mockNextHoldReturn: &next_hold,
+			expectedItemStatus: models.ItemStatusOnHold,
+		},
+		{
+			name: "Cancel ready Hold returns copy to shelf",
+			hold: ready_hold,
+			expectedResponse: &response.HTTPResponse{
+				Code:    200,
+				Message: "Hold cancelled successfully",
+			},
+			mockFindHoldReturn: &ready_hold,
+			expectedItemStatus: models.ItemStatusAvailable,
+		},
+		{
+			name: "Cancel Hold with error",
+			hold: pending_hold,
+			expectedResponse: &response.HTTPResponse{
+				Code:    500,
+				Message: "Internal Server Error",
+			},
+			expectedError:       internalServerError,
+			mockFindHoldReturn:  &pending_hold,
+			mockUpdateHoldError: internalServerError,
+		},
+		{
+			name: "Cancel Hold not active",
+			hold: closed_hold,
+			expectedResponse: &response.HTTPResponse{
+				Code:    400,
+				Message: "Bad request, hold not active",
+			},
+			expectedError:      errors.New("hold not active"),
+			mockFindHoldReturn: &closed_hold,
+		},
+		{
+			name: "Cancel Hold of another user",
+			hold: pending_hold,
+			expectedResponse: &response.HTTPResponse{
+				Code:    404,
+				Message: "Hold not found.",
+			},
+			expectedError:      errors.New("record not found: hold belongs to another user"),
+			mockFindHoldReturn: &pending_hold,
+		},
+		{
+			name: "Cannot find hold",
+			hold: pending_hold,
+			expectedResponse: &response.HTTPResponse{
+				Code:    404,
+				Message: "Hold not found.",
+			},
+			expectedError:     recordNotFoundError,
+			mockFindHoldError: recordNotFoundError,
+		},
+	}
+
+	for _, tt := range tc {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+
+		t.Run(tt.name, func(t *testing.T) {
+			hold := tt.hold
+			userId := *hold.UserID
+			if tt.name == "Cancel Hold of another user" {
+				userId = *next_hold.UserID
+			}
+			// the service updates the cancelled hold in place, so hand it a copy
+			var foundHold *models.Hold
+			if tt.mockFindHoldReturn != nil {
+				holdCopy := *tt.mockFindHoldReturn
+				foundHold = &holdCopy
+			}
+			mockRepo := repomocks.NewMockHoldRepository(mockCtrl)
+			expectTransaction(mockRepo)
+			mockRepo.EXPECT().FindByHoldIdForUpdate(*hold.ID).Return(foundHold, tt.mockFindHoldError)
+			if test_cases_that_require_update_hold[tt.name] {
+				mockRepo.EXPECT().UpdateByHoldId(*hold.ID, gomock.Any()).Return(nil, tt.mockUpdateHoldError)
+			}
+			if tt.expectedItemStatus != "" {
+				var nextHold *models.Hold
+				if tt.mockNextHoldReturn != nil {
+					holdCopy := *tt.mockNextHoldReturn
+					nextHold = &holdCopy
+					mockRepo.EXPECT().UpdateByHoldId(*next_hold.ID, gomock.Any()).Return(nil, nil)
+				}
+				mockRepo.EXPECT().FindNextPendingHoldForUpdate(*hold.BookID).Return(nextHold, nil)
+				mockRepo.EXPECT().UpdateItemStatus(*hold.ItemID, tt.expectedItemStatus).Return(nil)
+			}
+
+			service := NewHoldService(mockRepo, userrepomocks.NewMockUserRepository(mockCtrl), bookrepomocks.NewMockBookRepository(mockCtrl), testCirculationConfig, *utils.NewLogger())
+			response, err := service.CancelHold(userId, *hold.ID)
+
+			if err != nil && tt.expectedError != nil {
+				if err.Error() != tt.expectedError.Error() {
+					t.Errorf("Expected error %v, got %v", tt.expectedError, err)
+				}
+			}
+			if response.Code != tt.expectedResponse.Code {
+				t.Errorf("Expected code %d, got %d", tt.expectedResponse.Code, response.Code)
+			}
+			if response.Message != tt.expectedResponse.Message {
+				t.Errorf("Expected message %s, got %s", tt.expectedResponse.Message, response.Message)
+			}
+		})
+	}
+}
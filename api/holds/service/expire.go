@@ -0,0 +1,42 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/minand-mohan/library-app-api/api/holds/repository"
+	"github.com/minand-mohan/library-app-api/database/models"
+)
+
+func (service *HoldServiceImpl) ExpireHolds() (int, error) {
+	service.logger.Info("Hold Service: Expire holds")
+
+	expired := 0
+	err := service.repo.WithTransaction(func(repo repository.HoldRepository) error {
+		now := time.Now().UTC()
+		holds, err := repo.FindExpiredHoldsForUpdate(now)
+		if err != nil {
+			return err
+		}
+		for i := range holds {
+			status := models.HoldStatusExpired
+			_, err = repo.UpdateByHoldId(*holds[i].ID, &models.Hold{Status: &status, ClosedAt: &now})
+			if err != nil {
+				return err
+			}
+			if holds[i].ItemID != nil {
+				_, err = AssignItemToNextHold(repo, service.config, *holds[i].ItemID, *holds[i].BookID, now)
+				if err != nil {
+					return err
+				}
+			}
+		}
+		expired = len(holds)
+		return nil
+	})
+	if err != nil {
+		service.logger.Error(fmt.Sprintf("HoldService: Error while expiring holds: %s", err))
+		return 0, err
+	}
+	return expired, nil
+}
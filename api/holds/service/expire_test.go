@@ -0,0 +1,94 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	bookrepomocks "github.com/minand-mohan/library-app-api/api/books/repository/mocks"
+	repomocks "github.com/minand-mohan/library-app-api/api/holds/repository/mocks"
+	userrepomocks "github.com/minand-mohan/library-app-api/api/users/repository/mocks"
+	"github.com/minand-mohan/library-app-api/database/models"
+	"github.com/minand-mohan/library-app-api/utils"
+)
+
+func TestExpireHolds(t *testing.T) {
+
+	internalServerError := errors.New("Internal Server Error")
+
+	expired_hold := generateReadyHold01()
+	next_hold := generateRandomHold01()
+	next_hold.BookID = expired_hold.BookID
+
+	tc := []struct {
+		name                string
+		expectedExpired     int
+		expectedError       error
+		mockFindHoldsReturn []models.Hold
+		mockFindHoldsError  error
+		mockNextHoldReturn  *models.Hold
+		expectedItemStatus  string
+	}{
+		{
+			name:                "Expire Holds passes copy on",
+			expectedExpired:     1,
+			mockFindHoldsReturn: []models.Hold{expired_hold},
+			mockNextHoldReturn:  &next_hold,
+			expectedItemStatus:  models.ItemStatusOnHold,
+		},
+		{
+			name:                "Expire Holds returns copy to shelf",
+			expectedExpired:     1,
+			mockFindHoldsReturn: []models.Hold{expired_hold},
+			expectedItemStatus:  models.ItemStatusAvailable,
+		},
+		{
+			name:                "Expire Holds with nothing to expire",
+			expectedExpired:     0,
+			mockFindHoldsReturn: []models.Hold{},
+		},
+		{
+			name:               "Expire Holds with error",
+			expectedExpired:    0,
+			expectedError:      internalServerError,
+			mockFindHoldsError: internalServerError,
+		},
+	}
+
+	for _, tt := range tc {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := repomocks.NewMockHoldRepository(mockCtrl)
+			expectTransaction(mockRepo)
+			mockRepo.EXPECT().FindExpiredHoldsForUpdate(gomock.Any()).Return(tt.mockFindHoldsReturn, tt.mockFindHoldsError)
+			if tt.expectedItemStatus != "" {
+				mockRepo.EXPECT().UpdateByHoldId(*expired_hold.ID, gomock.Any()).DoAndReturn(func(id interface{}, hold *models.Hold) (*models.Hold, error) {
+					if *hold.Status != models.HoldStatusExpired {
+						t.Errorf("Expected hold status %s, got %s", models.HoldStatusExpired, *hold.Status)
+					}
+					return hold, nil
+				})
+				var nextHold *models.Hold
+				if tt.mockNextHoldReturn != nil {
+					holdCopy := *tt.mockNextHoldReturn
+					nextHold = &holdCopy
+					mockRepo.EXPECT().UpdateByHoldId(*next_hold.ID, gomock.Any()).Return(nil, nil)
+				}
+				mockRepo.EXPECT().FindNextPendingHoldForUpdate(*expired_hold.BookID).Return(nextHold, nil)
+				mockRepo.EXPECT().UpdateItemStatus(*expired_hold.ItemID, tt.expectedItemStatus).Return(nil)
+			}
+
+			service := NewHoldService(mockRepo, userrepomocks.NewMockUserRepository(mockCtrl), bookrepomocks.NewMockBookRepository(mockCtrl), testCirculationConfig, *utils.NewLogger())
+			expired, err := service.ExpireHolds()
+
+			if err != tt.expectedError {
+				t.Errorf("Expected error %v, got %v", tt.expectedError, err)
+			}
+			if expired != tt.expectedExpired {
+				t.Errorf("Expected %d holds expired, got %d", tt.expectedExpired, expired)
+			}
+		})
+	}
+}
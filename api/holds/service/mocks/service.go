@@ -0,0 +1,94 @@
+package mocks
+
+import (
+	"context"
+	"reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/api/holds/dto"
+	"github.com/minand-mohan/library-app-api/api/response"
+)
+
+// MockHoldService is a mock of HoldService interface.
+type MockHoldService struct {
+	ctrl     *gomock.Controller
+	recorder *MockHoldServiceMockRecorder
+}
+
+// MockHoldServiceMockRecorder is the mock recorder for MockHoldService.
+type MockHoldServiceMockRecorder struct {
+	mock *MockHoldService
+}
+
+// NewMockHoldService creates a new mock instance.
+func NewMockHoldService(ctrl *gomock.Controller) *MockHoldService {
+	mock := &MockHoldService{ctrl: ctrl}
+	mock.recorder = &MockHoldServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHoldService) EXPECT() *MockHoldServiceMockRecorder {
+	return m.recorder
+}
+
+// PlaceHold mocks base method.
+func (m *MockHoldService) PlaceHold(arg0 context.Context, arg1 uuid.UUID, arg2 *dto.HoldRequestBody) (*response.HTTPResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaceHold", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*response.HTTPResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlaceHold indicates an expected call of PlaceHold.
+func (mr *MockHoldServiceMockRecorder) PlaceHold(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaceHold", reflect.TypeOf((*MockHoldService)(nil).PlaceHold), arg0, arg1, arg2)
+}
+
+// FindAllHoldsByUserId mocks base method.
+func (m *MockHoldService) FindAllHoldsByUserId(arg0 context.Context, arg1 uuid.UUID) (*response.HTTPResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllHoldsByUserId", arg0, arg1)
+	ret0, _ := ret[0].(*response.HTTPResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllHoldsByUserId indicates an expected call of FindAllHoldsByUserId.
+func (mr *MockHoldServiceMockRecorder) FindAllHoldsByUserId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllHoldsByUserId", reflect.TypeOf((*MockHoldService)(nil).FindAllHoldsByUserId), arg0, arg1)
+}
+
+// CancelHold mocks base method.
+func (m *MockHoldService) CancelHold(arg0 uuid.UUID, arg1 uuid.UUID) (*response.HTTPResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelHold", arg0, arg1)
+	ret0, _ := ret[0].(*response.HTTPResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelHold indicates an expected call of CancelHold.
+func (mr *MockHoldServiceMockRecorder) CancelHold(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelHold", reflect.TypeOf((*MockHoldService)(nil).CancelHold), arg0, arg1)
+}
+
+// ExpireHolds mocks base method.
+func (m *MockHoldService) ExpireHolds() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireHolds")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireHolds indicates an expected call of ExpireHolds.
+func (mr *MockHoldServiceMockRecorder) ExpireHolds() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireHolds", reflect.TypeOf((*MockHoldService)(nil).ExpireHolds))
+}
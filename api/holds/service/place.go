@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/api/holds/dto"
+	"github.com/minand-mohan/library-app-api/api/holds/repository"
+	"github.com/minand-mohan/library-app-api/api/response"
+	"github.com/minand-mohan/library-app-api/database/models"
+)
+
+func (service *HoldServiceImpl) PlaceHold(ctx context.Context, userId uuid.UUID, holdReq *dto.HoldRequestBody) (*response.HTTPResponse, error) {
+	service.logger.Info("Hold Service: Place hold")
+	_, err := service.userRepo.FindByUserId(ctx, userId)
+	if err != nil {
+		service.logger.Error(fmt.Sprintf("HoldService: Error while finding user by id: %s", err))
+		return response.GetRepositoryErrorHTTPResponseBody(err, "User not found."), err
+	}
+	bookId, _ := uuid.Parse(holdReq.BookID)
+	_, err = service.bookRepo.FindByBookId(bookId)
+	if err != nil {
+		service.logger.Error(fmt.Sprintf("HoldService: Error while finding book by id: %s", err))
+		return response.GetRepositoryErrorHTTPResponseBody(err, "Book not found."), err
+	}
+
+	var responseBody *response.HTTPResponse
+	var position *int64
+	holdObj := &models.Hold{}
+	err = service.repo.WithTransaction(func(repo repository.HoldRepository) error {
+		_, err := repo.FindActiveHoldByUserAndBook(userId, bookId)
+		if err == nil {
+			service.logger.Error(fmt.Sprintf("HoldService: User %s already has a hold on book %s", userId, bookId))
+			responseBody = &response.HTTPResponse{
+				Code:    400,
+				Message: "Bad request, hold already exists",
+				Content: map[string]interface{}{},
+			}
+			return errors.New("hold already exists")
+		}
+		available, err := repo.CountAvailableItemsByBookId(bookId)
+		if err != nil {
+			return err
+		}
+		if available > 0 {
+			service.logger.Error(fmt.Sprintf("HoldService: Book %s has available copies", bookId))
+			responseBody = &response.HTTPResponse{
+				Code:    400,
+				Message: "Bad request, book has available copies",
+				Content: map[string]interface{}{},
+			}
+			return errors.New("book has available copies")
+		}
+
+		status := models.HoldStatusPending
+		placedAt := time.Now().UTC()
+		holdObj.UserID = &userId
+		holdObj.BookID = &bookId
+		holdObj.Status = &status
+		holdObj.PlacedAt = &placedAt
+		err = repo.CreateHold(holdObj)
+		if err != nil {
+			return err
+		}
+		position, err = service.queuePosition(repo, holdObj)
+		return err
+	})
+	if err != nil {
+		if responseBody != nil {
+			return responseBody, err
+		}
+		// a conflict means a concurrent request placed the same hold
+		service.logger.Error(fmt.Sprintf("HoldService: Error while placing hold: %s", err))
+		return response.GetRepositoryErrorHTTPResponseBody(err, "Book not found."), err
+	}
+
+	responseContent := holdResponseContent(holdObj)
+	responseContent["queue_position"] = position
+	responseBody = &response.HTTPResponse{
+		Code:    200,
+		Message: "Hold placed successfully",
+		Content: responseContent,
+	}
+	return responseBody, nil
+}
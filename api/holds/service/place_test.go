@@ -0,0 +1,172 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	bookrepomocks "github.com/minand-mohan/library-app-api/api/books/repository/mocks"
+	"github.com/minand-mohan/library-app-api/api/holds/dto"
+	repomocks "github.com/minand-mohan/library-app-api/api/holds/repository/mocks"
+	"github.com/minand-mohan/library-app-api/api/response"
+	userrepomocks "github.com/minand-mohan/library-app-api/api/users/repository/mocks"
+	"github.com/minand-mohan/library-app-api/database/dberrors"
+	"github.com/minand-mohan/library-app-api/database/models"
+	"github.com/minand-mohan/library-app-api/utils"
+)
+
+func TestPlaceHold(t *testing.T) {
+
+	internalServerError := errors.New("Internal Server Error")
+	recordNotFoundError := dberrors.ErrNotFound
+	duplicateKeyError := &dberrors.ConflictError{Constraint: "idx_holds_active_user_book", Err: errors.New("ERROR: duplicate key value violates unique constraint \"idx_holds_active_user_book\" (SQLSTATE 23505)")}
+
+	test_user := generateRandomUser01()
+	test_book := generateRandomBook01()
+	existing_hold := generateRandomHold01()
+
+	test_cases_that_require_transaction := map[string]bool{
+		"Place Hold sucessfully":               true,
+		"Place Hold already exists":            true,
+		"Place Hold with available copies":     true,
+		"Place Hold with concurrent duplicate": true,
+		"Place Hold with service error":        true,
+	}
+	test_cases_that_require_create_hold := map[string]bool{
+		"Place Hold sucessfully":               true,
+		"Place Hold with concurrent duplicate": true,
+		"Place Hold with service error":        true,
+	}
+
+	tc := []struct {
+		name                string
+		expectedResponse    *response.HTTPResponse
+		expectedError       error
+		mockFindUserError   error
+		mockFindBookError   error
+		mockFindHoldReturn  *models.Hold
+		mockFindHoldError   error
+		mockAvailableCount  int64
+		mockCreateHoldError error
+	}{
+		{
+			name: "Place Hold sucessfully",
+			expectedResponse: &response.HTTPResponse{
+				Code:    200,
+				Message: "Hold placed successfully",
+			},
+			expectedError:     nil,
+			mockFindHoldError: recordNotFoundError,
+		},
+		{
+			name: "Place Hold for missing user",
+			expectedResponse: &response.HTTPResponse{
+				Code:    404,
+				Message: "User not found.",
+			},
+			expectedError:     recordNotFoundError,
+			mockFindUserError: recordNotFoundError,
+		},
+		{
+			name: "Place Hold for missing book",
+			expectedResponse: &response.HTTPResponse{
+				Code:    404,
+				Message: "Book not found.",
+			},
+			expectedError:     recordNotFoundError,
+			mockFindBookError: recordNotFoundError,
+		},
+		{
+			name: "Place Hold already exists",
+			expectedResponse: &response.HTTPResponse{
+				Code:    400,
+				Message: "Bad request, hold already exists",
+			},
+			expectedError:      errors.New("hold already exists"),
+			mockFindHoldReturn: &existing_hold,
+		},
+		{
+			name: "Place Hold with available copies",
+			expectedResponse: &response.HTTPResponse{
+				Code:    400,
+				Message: "Bad request, book has available copies",
+			},
+			expectedError:      errors.New("book has available copies"),
+			mockFindHoldError:  recordNotFoundError,
+			mockAvailableCount: 1,
+		},
+		{
+			name: "Place Hold with concurrent duplicate",
+			expectedResponse: &response.HTTPResponse{
+				Code:    409,
+				Message: "Conflict, non-unique values",
+			},
+			expectedError:       duplicateKeyError,
+			mockFindHoldError:   recordNotFoundError,
+			mockCreateHoldError: duplicateKeyError,
+		},
+		{
+			name: "Place Hold with service error",
+			expectedResponse: &response.HTTPResponse{
+				Code:    500,
+				Message: "Internal Server Error",
+			},
+			expectedError:       internalServerError,
+			mockFindHoldError:   recordNotFoundError,
+			mockCreateHoldError: internalServerError,
+		},
+	}
+
+	for _, tt := range tc {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+
+		t.Run(tt.name, func(t *testing.T) {
+			requestBody := &dto.HoldRequestBody{
+				BookID: test_book.ID.String(),
+			}
+			mockRepo := repomocks.NewMockHoldRepository(mockCtrl)
+			mockUserRepo := userrepomocks.NewMockUserRepository(mockCtrl)
+			mockBookRepo := bookrepomocks.NewMockBookRepository(mockCtrl)
+			mockUserRepo.EXPECT().FindByUserId(gomock.Any(), *test_user.ID).Return(&test_user, tt.mockFindUserError)
+			if tt.mockFindUserError == nil {
+				mockBookRepo.EXPECT().FindByBookId(*test_book.ID).Return(&test_book, tt.mockFindBookError)
+			}
+			if test_cases_that_require_transaction[tt.name] {
+				expectTransaction(mockRepo)
+				mockRepo.EXPECT().FindActiveHoldByUserAndBook(*test_user.ID, *test_book.ID).Return(tt.mockFindHoldReturn, tt.mockFindHoldError)
+			}
+			if test_cases_that_require_transaction[tt.name] && tt.mockFindHoldReturn == nil {
+				mockRepo.EXPECT().CountAvailableItemsByBookId(*test_book.ID).Return(tt.mockAvailableCount, nil)
+			}
+			if test_cases_that_require_create_hold[tt.name] {
+				mockRepo.EXPECT().CreateHold(gomock.Any()).Return(tt.mockCreateHoldError)
+			}
+			if tt.name == "Place Hold sucessfully" {
+				mockRepo.EXPECT().CountHoldsAhead(gomock.Any()).Return(int64(2), nil)
+			}
+
+			service := NewHoldService(mockRepo, mockUserRepo, mockBookRepo, testCirculationConfig, *utils.NewLogger())
+			response, err := service.PlaceHold(context.Background(), *test_user.ID, requestBody)
+
+			if err != nil && tt.expectedError != nil {
+				if err.Error() != tt.expectedError.Error() {
+					t.Errorf("Expected error %v, got %v", tt.expectedError, err)
+				}
+			}
+			if response.Code != tt.expectedResponse.Code {
+				t.Errorf("Expected code %d, got %d", tt.expectedResponse.Code, response.Code)
+			}
+			if response.Message != tt.expectedResponse.Message {
+				t.Errorf("Expected message %s, got %s", tt.expectedResponse.Message, response.Message)
+			}
+			if tt.name == "Place Hold sucessfully" {
+				content := response.Content.(map[string]interface{})
+				if *content["queue_position"].(*int64) != 3 {
+					t.Errorf("Expected queue position 3, got %v", *content["queue_position"].(*int64))
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/api/response"
+)
+
+func (service *HoldServiceImpl) FindAllHoldsByUserId(ctx context.Context, userId uuid.UUID) (*response.HTTPResponse, error) {
+	service.logger.Info("Hold Service: Find all holds by user id")
+	_, err := service.userRepo.FindByUserId(ctx, userId)
+	if err != nil {
+		service.logger.Error(fmt.Sprintf("HoldService: Error while finding user by id: %s", err))
+		return response.GetRepositoryErrorHTTPResponseBody(err, "User not found."), err
+	}
+
+	holds, err := service.repo.FindActiveHoldsByUserId(userId)
+	if err != nil {
+		service.logger.Error(fmt.Sprintf("HoldService: Error while finding all holds: %s", err))
+		return response.GetRepositoryErrorHTTPResponseBody(err, "Hold not found."), err
+	}
+	if len(holds) == 0 {
+		service.logger.Error("HoldService: No holds found")
+		responseBody := response.HTTPResponse{
+			Code:    404,
+			Message: "No holds found",
+			Content: map[string]interface{}{},
+		}
+		return &responseBody, nil
+	}
+	var holdsMap []map[string]interface{}
+	for i := range holds {
+		position, err := service.queuePosition(service.repo, &holds[i])
+		if err != nil {
+			service.logger.Error(fmt.Sprintf("HoldService: Error while finding queue position: %s", err))
+			return response.GetRepositoryErrorHTTPResponseBody(err, "Hold not found."), err
+		}
+		holdMap := holdResponseContent(&holds[i])
+		holdMap["queue_position"] = position
+		holdsMap = append(holdsMap, holdMap)
+	}
+	responseContent := response.HTTPResponseContent{
+		Count:    len(holds),
+		Previous: nil,
+		Next:     nil,
+		Results:  holdsMap,
+	}
+	responseBody := response.HTTPResponse{
+		Code:    200,
+		Message: "Holds found successfully",
+		Content: responseContent,
+	}
+	return &responseBody, nil
+}
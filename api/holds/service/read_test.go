@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	bookrepomocks "github.com/minand-mohan/library-app-api/api/books/repository/mocks"
+	repomocks "github.com/minand-mohan/library-app-api/api/holds/repository/mocks"
+	"github.com/minand-mohan/library-app-api/api/response"
+	userrepomocks "github.com/minand-mohan/library-app-api/api/users/repository/mocks"
+	"github.com/minand-mohan/library-app-api/database/dberrors"
+	"github.com/minand-mohan/library-app-api/database/models"
+	"github.com/minand-mohan/library-app-api/utils"
+)
+
+func TestFindAllHoldsByUserId(t *testing.T) {
+
+	internalServerError := errors.New("Internal Server Error")
+	recordNotFoundError := dberrors.ErrNotFound
+
+	test_user := generateRandomUser01()
+	pending_hold := generateRandomHold01()
+	ready_hold := generateReadyHold01()
+
+	tc := []struct {
+		name                string
+		expectedResponse    *response.HTTPResponse
+		expectedError       error
+		mockFindUserError   error
+		mockFindHoldsReturn []models.Hold
+		mockFindHoldsError  error
+	}{
+		{
+			name: "Find all holds sucessfully",
+			expectedResponse: &response.HTTPResponse{
+				Code:    200,
+				Message: "Holds found successfully",
+			},
+			expectedError:       nil,
+			mockFindHoldsReturn: []models.Hold{pending_hold, ready_hold},
+		},
+		{
+			name: "Find all holds for missing user",
+			expectedResponse: &response.HTTPResponse{
+				Code:    404,
+				Message: "User not found.",
+			},
+			expectedError:     recordNotFoundError,
+			mockFindUserError: recordNotFoundError,
+		},
+		{
+			name: "Find all holds with no holds",
+			expectedResponse: &response.HTTPResponse{
+				Code:    404,
+				Message: "No holds found",
+			},
+			expectedError:       nil,
+			mockFindHoldsReturn: []models.Hold{},
+		},
+		{
+			name: "Find all holds with error",
+			expectedResponse: &response.HTTPResponse{
+				Code:    500,
+				Message: "Internal Server Error",
+			},
+			expectedError:      internalServerError,
+			mockFindHoldsError: internalServerError,
+		},
+	}
+
+	for _, tt := range tc {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := repomocks.NewMockHoldRepository(mockCtrl)
+			mockUserRepo := userrepomocks.NewMockUserRepository(mockCtrl)
+			mockUserRepo.EXPECT().FindByUserId(gomock.Any(), *test_user.ID).Return(&test_user, tt.mockFindUserError)
+			if tt.mockFindUserError == nil {
+				mockRepo.EXPECT().FindActiveHoldsByUserId(*test_user.ID).Return(tt.mockFindHoldsReturn, tt.mockFindHoldsError)
+			}
+			if tt.name == "Find all holds sucessfully" {
+				mockRepo.EXPECT().CountHoldsAhead(gomock.Any()).Return(int64(0), nil)
+			}
+
+			service := NewHoldService(mockRepo, mockUserRepo, bookrepomocks.NewMockBookRepository(mockCtrl), testCirculationConfig, *utils.NewLogger())
+			responseBody, err := service.FindAllHoldsByUserId(context.Background(), *test_user.ID)
+
+			if err != nil && tt.expectedError != nil {
+				if err.Error() != tt.expectedError.Error() {
+					t.Errorf("Expected error %v, got %v", tt.expectedError, err)
+				}
+			}
+			if responseBody.Code != tt.expectedResponse.Code {
+				t.Errorf("Expected code %d, got %d", tt.expectedResponse.Code, responseBody.Code)
+			}
+			if responseBody.Message != tt.expectedResponse.Message {
+				t.Errorf("Expected message %s, got %s", tt.expectedResponse.Message, responseBody.Message)
+			}
+			if tt.name == "Find all holds sucessfully" {
+				results := responseBody.Content.(response.HTTPResponseContent).Results.([]map[string]interface{})
+				if *results[0]["queue_position"].(*int64) != 1 {
+					t.Errorf("Expected queue position 1, got %v", *results[0]["queue_position"].(*int64))
+				}
+				if results[1]["queue_position"].(*int64) != nil {
+					t.Errorf("Expected no queue position for a ready hold, got %v", *results[1]["queue_position"].(*int64))
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	bookRepository "github.com/minand-mohan/library-app-api/api/books/repository"
+	"github.com/minand-mohan/library-app-api/api/holds/dto"
+	"github.com/minand-mohan/library-app-api/api/holds/repository"
+	"github.com/minand-mohan/library-app-api/api/response"
+	userRepository "github.com/minand-mohan/library-app-api/api/users/repository"
+	"github.com/minand-mohan/library-app-api/database/models"
+	"github.com/minand-mohan/library-app-api/system"
+	"github.com/minand-mohan/library-app-api/utils"
+)
+
+type HoldService interface {
+	PlaceHold(ctx context.Context, userId uuid.UUID, holdReqBody *dto.HoldRequestBody) (*response.HTTPResponse, error)
+	FindAllHoldsByUserId(ctx context.Context, userId uuid.UUID) (*response.HTTPResponse, error)
+	CancelHold(userId uuid.UUID, id uuid.UUID) (*response.HTTPResponse, error)
+	// ExpireHolds closes ready holds whose pickup window has lapsed and
+	// passes their copies on. It returns the number of holds expired.
+	ExpireHolds() (int, error)
+}
+
+type HoldServiceImpl struct {
+	repo     repository.HoldRepository
+	userRepo userRepository.UserRepository
+	bookRepo bookRepository.BookRepository
+	config   *system.CirculationConfig
+	logger   *utils.AppLogger
+}
+
+func NewHoldService(repo repository.HoldRepository, userRepo userRepository.UserRepository, bookRepo bookRepository.BookRepository, config *system.CirculationConfig, logger utils.AppLogger) HoldService {
+	return &HoldServiceImpl{
+		repo:     repo,
+		userRepo: userRepo,
+		bookRepo: bookRepo,
+		config:   config,
+		logger:   &logger,
+	}
+}
+
+// AssignItemToNextHold sets a copy aside for the head of its book's queue,
+// or puts it back on the shelf when nobody is waiting. It returns the hold
+// the copy was assigned to, if any. Loan returns call it too, with the holds
+// repository of their transaction.
+func AssignItemToNextHold(repo repository.HoldRepository, config *system.CirculationConfig, itemId uuid.UUID, bookId uuid.UUID, now time.Time) (*models.Hold, error) {
+	hold, err := repo.FindNextPendingHoldForUpdate(bookId)
+	if err != nil {
+		return nil, err
+	}
+	if hold == nil {
+		return nil, repo.UpdateItemStatus(itemId, models.ItemStatusAvailable)
+	}
+	status := models.HoldStatusReady
+	expiresAt := now.AddDate(0, 0, config.HoldPickupDays)
+	_, err = repo.UpdateByHoldId(*hold.ID, &models.Hold{
+		ItemID:    &itemId,
+		Status:    &status,
+		ReadyAt:   &now,
+		ExpiresAt: &expiresAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	hold.ItemID = &itemId
+	hold.Status = &status
+	hold.ReadyAt = &now
+	hold.ExpiresAt = &expiresAt
+	return hold, repo.UpdateItemStatus(itemId, models.ItemStatusOnHold)
+}
+
+// queuePosition returns the 1-based position of a pending hold in its
+// book's queue, or nil for holds that are no longer queued
+func (service *HoldServiceImpl) queuePosition(repo repository.HoldRepository, hold *models.Hold) (*int64, error) {
+	if hold.Status == nil || *hold.Status != models.HoldStatusPending {
+		return nil, nil
+	}
+	ahead, err := repo.CountHoldsAhead(hold)
+	if err != nil {
+		return nil, err
+	}
+	position := ahead + 1
+	return &position, nil
+}
+
+func holdResponseContent(hold *models.Hold) map[string]interface{} {
+	return map[string]interface{}{
+		"id":         hold.ID,
+		"user_id":    hold.UserID,
+		"book_id":    hold.BookID,
+		"item_id":    hold.ItemID,
+		"status":     hold.Status,
+		"placed_at":  hold.PlacedAt,
+		"ready_at":   hold.ReadyAt,
+		"expires_at": hold.ExpiresAt,
+		"closed_at":  hold.ClosedAt,
+	}
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/api/holds/repository"
+	repomocks "github.com/minand-mohan/library-app-api/api/holds/repository/mocks"
+	"github.com/minand-mohan/library-app-api/database/models"
+	"github.com/minand-mohan/library-app-api/system"
+)
+
+var testCirculationConfig = &system.CirculationConfig{
+	LoanPeriodDays: 14,
+	MaxRenewals:    2,
+	HoldPickupDays: 3,
+}
+
+// expectTransaction makes the mock repository run the transaction callback
+// against itself
+func expectTransaction(mockRepo *repomocks.MockHoldRepository) {
+	mockRepo.EXPECT().WithTransaction(gomock.Any()).DoAndReturn(func(fn func(repo repository.HoldRepository) error) error {
+		return fn(mockRepo)
+	})
+}
+
+func generateRandomUser01() models.User {
+	//initialize variables
+	test_email := "test1@example.com"
+	test_username := "test1"
+	test_phone := "1234567890"
+	test_id := uuid.New()
+	return models.User{
+		ID:       &test_id,
+		Email:    &test_email,
+		Username: &test_username,
+		Phone:    &test_phone,
+	}
+}
+
+func generateRandomBook01() models.Book {
+	//initialize variables
+	test_title := "The Go Programming Language"
+	test_id := uuid.New()
+	return models.Book{
+		ID:    &test_id,
+		Title: &test_title,
+	}
+}
+
+func generateRandomHold01() models.Hold {
+	//initialize variables
+	test_status := models.HoldStatusPending
+	test_placed_at := time.Now().UTC().AddDate(0, 0, -3)
+	test_id := uuid.New()
+	test_user_id := uuid.New()
+	test_book_id := uuid.New()
+	return models.Hold{
+		ID:       &test_id,
+		UserID:   &test_user_id,
+		BookID:   &test_book_id,
+		Status:   &test_status,
+		PlacedAt: &test_placed_at,
+	}
+}
+
+// generateReadyHold01 returns a hold with a copy set aside for pickup
+func generateReadyHold01() models.Hold {
+	hold := generateRandomHold01()
+	test_status := models.HoldStatusReady
+	test_item_id := uuid.New()
+	test_ready_at := time.Now().UTC().AddDate(0, 0, -1)
+	test_expires_at := test_ready_at.AddDate(0, 0, 3)
+	hold.Status = &test_status
+	hold.ItemID = &test_item_id
+	hold.ReadyAt = &test_ready_at
+	hold.ExpiresAt = &test_expires_at
+	return hold
+}
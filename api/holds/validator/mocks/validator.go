@@ -0,0 +1,40 @@
+package mocks
+
+import (
+	"reflect"
+
+	"github.com/golang/mock/gomock"
+	"github.com/minand-mohan/library-app-api/api/holds/dto"
+)
+
+// MockHoldValidator is a mock type for the HoldValidator type
+type MockHoldValidator struct {
+	ctrl     *gomock.Controller
+	recorder *MockHoldValidatorMockRecorder
+}
+
+type MockHoldValidatorMockRecorder struct {
+	mock *MockHoldValidator
+}
+
+func NewMockHoldValidator(ctrl *gomock.Controller) *MockHoldValidator {
+	mock := &MockHoldValidator{ctrl: ctrl}
+	mock.recorder = &MockHoldValidatorMockRecorder{mock}
+	return mock
+}
+
+func (m *MockHoldValidator) EXPECT() *MockHoldValidatorMockRecorder {
+	return m.recorder
+}
+
+func (m *MockHoldValidator) ValidateHold(arg0 *dto.HoldRequestBody) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateHold", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockHoldValidatorMockRecorder) ValidateHold(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateHold", reflect.TypeOf((*MockHoldValidator)(nil).ValidateHold), arg0)
+}
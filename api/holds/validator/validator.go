@@ -0,0 +1,37 @@
+package validator
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/api/holds/dto"
+	"github.com/minand-mohan/library-app-api/utils"
+)
+
+type HoldValidator interface {
+	ValidateHold(requestBody *dto.HoldRequestBody) error
+}
+
+type HoldValidatorImpl struct {
+	logger *utils.AppLogger
+}
+
+func NewHoldValidator(logger utils.AppLogger) HoldValidator {
+	return &HoldValidatorImpl{
+		logger: &logger,
+	}
+}
+
+func (validator *HoldValidatorImpl) ValidateHold(holdReq *dto.HoldRequestBody) error {
+	validator.logger.Info("Validate hold")
+	if holdReq.BookID == "" {
+		validator.logger.Error("Book id is empty")
+		return errors.New("Book id is empty")
+	}
+	if _, err := uuid.Parse(holdReq.BookID); err != nil {
+		validator.logger.Error("Book id is invalid")
+		return errors.New("Book id is invalid")
+	}
+
+	return nil
+}
@@ -0,0 +1,12 @@
+package dto
+
+type ItemRequestBody struct {
+	Barcode       string `json:"barcode"`
+	ShelfLocation string `json:"shelf_location"`
+	Condition     string `json:"condition"`
+	AcquiredAt    string `json:"acquired_at"`
+}
+
+type ItemQueryParams struct {
+	Status string `query:"status"`
+}
@@ -0,0 +1,148 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	gomock "github.com/golang/mock/gomock"
+	servicemocks "github.com/minand-mohan/library-app-api/api/items/service/mocks"
+	validatormocks "github.com/minand-mohan/library-app-api/api/items/validator/mocks"
+	"github.com/minand-mohan/library-app-api/api/response"
+)
+
+func TestCreateItem(t *testing.T) {
+
+	testCases := []struct {
+		name                      string
+		bookId                    string
+		requestBody               map[string]interface{}
+		mockServiceExpectResponse *response.HTTPResponse
+		mockServiceExpectError    error
+		mockValidatorExpectError  error
+		expectedStatus            int
+		expectedMessage           string
+	}{
+		{
+			name:   "Create item with valid request body",
+			bookId: "d3b3b3b3-3b3b-3b3b-3b3b-3b3b3b3b3b3b",
+			requestBody: map[string]interface{}{
+				"barcode":        "LIB-000001",
+				"shelf_location": "A-12",
+				"condition":      "new",
+			},
+			mockServiceExpectResponse: &response.HTTPResponse{
+				Code:    200,
+				Message: "Item created successfully",
+				Content: map[string]interface{}{},
+			},
+			mockServiceExpectError:   nil,
+			mockValidatorExpectError: nil,
+			expectedStatus:           200,
+			expectedMessage:          "Item created successfully",
+		},
+		{
+			name:   "Create item with invalid book id",
+			bookId: "invalid-id",
+			requestBody: map[string]interface{}{
+				"barcode": "LIB-000001",
+			},
+			mockServiceExpectResponse: nil,
+			mockServiceExpectError:    nil,
+			mockValidatorExpectError:  nil,
+			expectedStatus:            400,
+			expectedMessage:           "Bad request, invalid id",
+		},
+		{
+			name:   "Create item with empty barcode",
+			bookId: "d3b3b3b3-3b3b-3b3b-3b3b-3b3b3b3b3b3b",
+			requestBody: map[string]interface{}{
+				"shelf_location": "A-12",
+			},
+			mockServiceExpectResponse: nil,
+			mockServiceExpectError:    nil,
+			mockValidatorExpectError:  errors.New("Barcode is empty"),
+			expectedStatus:            400,
+			expectedMessage:           "Bad request, invalid request body",
+		},
+		{
+			name:   "Create item for missing book",
+			bookId: "d3b3b3b3-3b3b-3b3b-3b3b-3b3b3b3b3b3b",
+			requestBody: map[string]interface{}{
+				"barcode": "LIB-000001",
+			},
+			mockServiceExpectResponse: &response.HTTPResponse{
+				Code:    404,
+				Message: "Book not found.",
+				Content: map[string]interface{}{},
+			},
+			mockServiceExpectError:   errors.New("record not found"),
+			mockValidatorExpectError: nil,
+			expectedStatus:           404,
+			expectedMessage:          "Book not found.",
+		},
+		{
+			name:            "Create item with null request body",
+			bookId:          "d3b3b3b3-3b3b-3b3b-3b3b-3b3b3b3b3b3b",
+			requestBody:     nil,
+			expectedStatus:  400,
+			expectedMessage: "Bad request, invalid request body",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			app := setupApp()
+			app.Post("/books/:id/items", func(c *fiber.Ctx) error {
+				validator := validatormocks.NewMockItemValidator(mockCtrl)
+				service := servicemocks.NewMockItemService(mockCtrl)
+				if tc.mockServiceExpectResponse != nil {
+					service.EXPECT().CreateItem(gomock.Any(), gomock.Any()).Return(tc.mockServiceExpectResponse, tc.mockServiceExpectError)
+				}
+				if tc.bookId != "invalid-id" && tc.requestBody != nil {
+					validator.EXPECT().ValidateItem(gomock.Any()).Return(tc.mockValidatorExpectError)
+				}
+				handler := NewItemHandler(service, validator)
+				return handler.CreateItem(c)
+			})
+			requestBody, err := json.Marshal(tc.requestBody)
+			if err != nil {
+				t.Errorf("Error while marshalling request body: %v", err)
+			}
+			request := httptest.NewRequest("POST", "/books/"+tc.bookId+"/items", strings.NewReader(string(requestBody)))
+
+			response, err := app.Test(request)
+			if err != nil {
+				t.Errorf("Error while making request %v", err)
+			}
+
+			if response.StatusCode != tc.expectedStatus {
+				t.Errorf("Expected status code %d, got %d", tc.expectedStatus, response.StatusCode)
+			}
+
+			bodyBytes, err := ioutil.ReadAll(response.Body)
+			if err != nil {
+				t.Errorf("Error while reading response body: %v", err)
+			}
+
+			var responseBody map[string]interface{}
+
+			err = json.Unmarshal(bodyBytes, &responseBody)
+			if err != nil {
+				t.Errorf("Error while parsing response body: %v", err)
+			}
+
+			message := responseBody["message"].(string)
+			if message != tc.expectedMessage {
+				t.Errorf("Expected message %s, got %s", tc.expectedMessage, message)
+			}
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"github.com/minand-mohan/library-app-api/api/items/service"
+	"github.com/minand-mohan/library-app-api/api/items/validator"
+)
+
+type ItemHandler struct {
+	service   service.ItemService
+	validator validator.ItemValidator
+}
+
+func NewItemHandler(service service.ItemService, validator validator.ItemValidator) *ItemHandler {
+	return &ItemHandler{
+		service:   service,
+		validator: validator,
+	}
+}
@@ -0,0 +1,195 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	gomock "github.com/golang/mock/gomock"
+	servicemocks "github.com/minand-mohan/library-app-api/api/items/service/mocks"
+	"github.com/minand-mohan/library-app-api/api/items/validator"
+	validatormocks "github.com/minand-mohan/library-app-api/api/items/validator/mocks"
+	"github.com/minand-mohan/library-app-api/api/response"
+	"github.com/minand-mohan/library-app-api/utils"
+)
+
+func TestFindAllItemsByBookId(t *testing.T) {
+	testCases := []struct {
+		name                      string
+		bookId                    string
+		queryParams               string
+		mockServiceExpectResponse *response.HTTPResponse
+		mockServiceExpectError    error
+		mockValidatorExpectError  error
+		expectedStatus            int
+		expectedMessage           string
+	}{
+		{
+			name:        "Find all items with valid query params",
+			bookId:      "d3b3b3b3-3b3b-3b3b-3b3b-3b3b3b3b3b3b",
+			queryParams: "status=available",
+			mockServiceExpectResponse: &response.HTTPResponse{
+				Code:    200,
+				Message: "Items found successfully",
+				Content: map[string]interface{}{},
+			},
+			mockServiceExpectError:   nil,
+			mockValidatorExpectError: nil,
+			expectedStatus:           200,
+			expectedMessage:          "Items found successfully",
+		},
+		{
+			name:        "Find items of a book that does not exist",
+			bookId:      "d3b3b3b3-3b3b-3b3b-3b3b-3b3b3b3b3b3b",
+			queryParams: "",
+			mockServiceExpectResponse: &response.HTTPResponse{
+				Code:    404,
+				Message: "Book not found.",
+				Content: map[string]interface{}{},
+			},
+			mockServiceExpectError:   errors.New("record not found"),
+			mockValidatorExpectError: nil,
+			expectedStatus:           404,
+			expectedMessage:          "Book not found.",
+		},
+		{
+			name:                      "Find all items with invalid query params",
+			bookId:                    "d3b3b3b3-3b3b-3b3b-3b3b-3b3b3b3b3b3b",
+			queryParams:               "status=lost",
+			mockServiceExpectResponse: nil,
+			mockServiceExpectError:    nil,
+			mockValidatorExpectError:  errors.New("Status is invalid"),
+			expectedStatus:            400,
+			expectedMessage:           "Bad request, invalid query params",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			app := setupApp()
+			app.Get("/books/:id/items", func(c *fiber.Ctx) error {
+				validator := validatormocks.NewMockItemValidator(mockCtrl)
+				service := servicemocks.NewMockItemService(mockCtrl)
+				if tc.mockServiceExpectResponse != nil {
+					service.EXPECT().FindAllItemsByBookId(gomock.Any(), gomock.Any()).Return(tc.mockServiceExpectResponse, tc.mockServiceExpectError)
+				}
+				validator.EXPECT().ValidateItemQueryParams(gomock.Any()).Return(tc.mockValidatorExpectError)
+				handler := NewItemHandler(service, validator)
+				return handler.FindAllItemsByBookId(c)
+			})
+			request := httptest.NewRequest("GET", "/books/"+tc.bookId+"/items?"+tc.queryParams, nil)
+
+			response, err := app.Test(request)
+			if err != nil {
+				t.Errorf("Error while making request %v", err)
+			}
+
+			if response.StatusCode != tc.expectedStatus {
+				t.Errorf("Expected status code %d, got %d", tc.expectedStatus, response.StatusCode)
+			}
+
+			bodyBytes, err := ioutil.ReadAll(response.Body)
+			if err != nil {
+				t.Errorf("Error while reading response body: %v", err)
+			}
+
+			var responseBody map[string]interface{}
+
+			err = json.Unmarshal(bodyBytes, &responseBody)
+			if err != nil {
+				t.Errorf("Error while parsing response body: %v", err)
+			}
+
+			message := responseBody["message"].(string)
+			if message != tc.expectedMessage {
+				t.Errorf("Expected message %s, got %s", tc.expectedMessage, message)
+			}
+		})
+	}
+}
+
+func TestFindByBarcode(t *testing.T) {
+	testCases := []struct {
+		name                      string
+		barcode                   string
+		mockServiceExpectResponse *response.HTTPResponse
+		mockServiceExpectError    error
+		expectedStatus            int
+		expectedMessage           string
+	}{
+		{
+			name:    "Find item by barcode",
+			barcode: "LIB-000001",
+			mockServiceExpectResponse: &response.HTTPResponse{
+				Code:    200,
+				Message: "Item found",
+				Content: map[string]interface{}{},
+			},
+			mockServiceExpectError: nil,
+			expectedStatus:         200,
+			expectedMessage:        "Item found",
+		},
+		{
+			name:    "Find item by barcode that does not exist",
+			barcode: "LIB-999999",
+			mockServiceExpectResponse: &response.HTTPResponse{
+				Code:    404,
+				Message: "Item not found.",
+				Content: map[string]interface{}{},
+			},
+			mockServiceExpectError: errors.New("record not found"),
+			expectedStatus:         404,
+			expectedMessage:        "Item not found.",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			app := setupApp()
+			app.Get("/items/barcode/:barcode", func(c *fiber.Ctx) error {
+				logger := utils.NewLogger()
+				service := servicemocks.NewMockItemService(mockCtrl)
+				validator := validator.NewItemValidator(*logger)
+				service.EXPECT().FindByBarcode(tc.barcode).Return(tc.mockServiceExpectResponse, tc.mockServiceExpectError)
+				handler := NewItemHandler(service, validator)
+				return handler.FindByBarcode(c)
+			})
+			request := httptest.NewRequest("GET", "/items/barcode/"+tc.barcode, nil)
+
+			response, err := app.Test(request)
+			if err != nil {
+				t.Errorf("Error while making request %v", err)
+			}
+
+			if response.StatusCode != tc.expectedStatus {
+				t.Errorf("Expected status code %d, got %d", tc.expectedStatus, response.StatusCode)
+			}
+
+			bodyBytes, err := ioutil.ReadAll(response.Body)
+			if err != nil {
+				t.Errorf("Error while reading response body: %v", err)
+			}
+
+			var responseBody map[string]interface{}
+
+			err = json.Unmarshal(bodyBytes, &responseBody)
+			if err != nil {
+				t.Errorf("Error while parsing response body: %v", err)
+			}
+
+			message := responseBody["message"].(string)
+			if message != tc.expectedMessage {
+				t.Errorf("Expected message %s, got %s", tc.expectedMessage, message)
+			}
+		})
+	}
+}
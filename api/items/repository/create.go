@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"github.com/minand-mohan/library-app-api/database/dberrors"
+	"github.com/minand-mohan/library-app-api/database/models"
+)
+
+// CreateItem creates a new copy of a book
+func (repo *ItemRepositoryImpl) CreateItem(itemObj *models.Item) error {
+	result := repo.db.Create(&itemObj)
+	if result.Error != nil {
+		return dberrors.Translate(result.Error)
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/minand-mohan/library-app-api/database/models"
+
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+func TestCreateItem(t *testing.T) {
+	test_id := "123e4567-e89b-12d3-a456-426614174000"
+	newItem := func() *models.Item {
+		item := generateRandomItem01()
+		item.ID = nil
+		return &item
+	}
+
+	tc := []struct {
+		name          string
+		item          *models.Item
+		mockFunction  func(mock sqlmock.Sqlmock, item *models.Item) error
+		expectedError error
+	}{
+		{
+			name: "Item created successfully",
+			item: newItem(),
+			mockFunction: func(mock sqlmock.Sqlmock, item *models.Item) error {
+				query := regexp.QuoteMeta(`INSERT INTO "items" ("book_id","barcode","shelf_location","condition","status","acquired_at","retired_at") VALUES ($1,$2,$3,$4,$5,$6,$7) RETURNING "id"`)
+				mock.ExpectBegin()
+				mock.ExpectQuery(query).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(test_id))
+				mock.ExpectCommit()
+				return nil
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Item creation failed",
+			item: newItem(),
+			mockFunction: func(mock sqlmock.Sqlmock, item *models.Item) error {
+				err := sqlmock.ErrCancelled
+				query := regexp.QuoteMeta(`INSERT INTO "items" ("book_id","barcode","shelf_location","condition","status","acquired_at","retired_at") VALUES ($1,$2,$3,$4,$5,$6,$7) RETURNING "id"`)
+				mock.ExpectBegin()
+				mock.ExpectQuery(query).
+					WillReturnError(err)
+				mock.ExpectRollback()
+				return err
+			},
+			expectedError: sqlmock.ErrCancelled,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, itemRepository := createItemRepository()
+			tt.mockFunction(mock, tt.item)
+			err := itemRepository.CreateItem(tt.item)
+			if err != tt.expectedError {
+				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
+			}
+		})
+	}
+}
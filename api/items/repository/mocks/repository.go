@@ -0,0 +1,97 @@
+package mocks
+
+import (
+	"reflect"
+	"time"
+
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/api/items/dto"
+	"github.com/minand-mohan/library-app-api/database/models"
+)
+
+// MockItemRepository is a mock of ItemRepository interface.
+type MockItemRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockItemRepositoryMockRecorder
+}
+
+// MockItemRepositoryMockRecorder is the mock recorder for MockItemRepository.
+type MockItemRepositoryMockRecorder struct {
+	mock *MockItemRepository
+}
+
+// NewMockItemRepository creates a new mock instance.
+func NewMockItemRepository(ctrl *gomock.Controller) *MockItemRepository {
+	mock := &MockItemRepository{ctrl: ctrl}
+	mock.recorder = &MockItemRepositoryMockRecorder{mock}
+	return mock
+}
+
+func (m *MockItemRepository) EXPECT() *MockItemRepositoryMockRecorder {
+	return m.recorder
+}
+
+func (m *MockItemRepository) CreateItem(arg0 *models.Item) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateItem", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockItemRepositoryMockRecorder) CreateItem(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateItem", reflect.TypeOf((*MockItemRepository)(nil).CreateItem), arg0)
+}
+
+func (m *MockItemRepository) FindByBarcode(arg0 string) (*models.Item, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByBarcode", arg0)
+	ret0, _ := ret[0].(*models.Item)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockItemRepositoryMockRecorder) FindByBarcode(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByBarcode", reflect.TypeOf((*MockItemRepository)(nil).FindByBarcode), arg0)
+}
+
+func (m *MockItemRepository) FindAllItemsByBookId(arg0 uuid.UUID, arg1 *dto.ItemQueryParams) ([]models.Item, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllItemsByBookId", arg0, arg1)
+	ret0, _ := ret[0].([]models.Item)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockItemRepositoryMockRecorder) FindAllItemsByBookId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllItemsByBookId", reflect.TypeOf((*MockItemRepository)(nil).FindAllItemsByBookId), arg0, arg1)
+}
+
+func (m *MockItemRepository) FindByItemId(arg0 uuid.UUID) (*models.Item, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByItemId", arg0)
+	ret0, _ := ret[0].(*models.Item)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockItemRepositoryMockRecorder) FindByItemId(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByItemId", reflect.TypeOf((*MockItemRepository)(nil).FindByItemId), arg0)
+}
+
+func (m *MockItemRepository) RetireByItemId(arg0 uuid.UUID, arg1 time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetireByItemId", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockItemRepositoryMockRecorder) RetireByItemId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetireByItemId", reflect.TypeOf((*MockItemRepository)(nil).RetireByItemId), arg0, arg1)
+}
@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/api/items/dto"
+	"github.com/minand-mohan/library-app-api/database/dberrors"
+	"github.com/minand-mohan/library-app-api/database/models"
+)
+
+// List all copies of a book
+func (repo *ItemRepositoryImpl) FindAllItemsByBookId(bookId uuid.UUID, queryParams *dto.ItemQueryParams) ([]models.Item, error) {
+	var items []models.Item
+	dbQuery := repo.db.Where("book_id = ?", bookId)
+	if queryParams != nil && queryParams.Status != "" {
+		dbQuery = dbQuery.Where("status = ?", queryParams.Status)
+	}
+	result := dbQuery.Order("barcode").Find(&items)
+	if result.Error != nil {
+		return nil, dberrors.Translate(result.Error)
+	}
+	return items, nil
+}
+
+// Retrieve a copy by its ID
+func (repo *ItemRepositoryImpl) FindByItemId(id uuid.UUID) (*models.Item, error) {
+	var item models.Item
+	result := repo.db.First(&item, id)
+	if result.Error != nil {
+		return nil, dberrors.Translate(result.Error)
+	}
+	return &item, nil
+}
+
+// Retrieve a copy by its barcode
+func (repo *ItemRepositoryImpl) FindByBarcode(barcode string) (*models.Item, error) {
+	var item models.Item
+	result := repo.db.First(&item, "barcode = ?", barcode)
+	if result.Error != nil {
+		return nil, dberrors.Translate(result.Error)
+	}
+	return &item, nil
+}
@@ -0,0 +1,193 @@
+package repository
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/api/items/dto"
+	"github.com/minand-mohan/library-app-api/database/models"
+
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+func TestFindAllItemsByBookId(t *testing.T) {
+
+	item1 := generateRandomItem01()
+	item2 := generateRandomItem02()
+	bookId := uuid.New()
+
+	tc := []struct {
+		name          string
+		params        *dto.ItemQueryParams
+		mockFunction  func(mock sqlmock.Sqlmock, params dto.ItemQueryParams) error
+		expectedError error
+		expectedList  []models.Item
+	}{
+		{
+			name:   "Find all items successfully",
+			params: &dto.ItemQueryParams{},
+			mockFunction: func(mock sqlmock.Sqlmock, params dto.ItemQueryParams) error {
+				query := regexp.QuoteMeta(`SELECT * FROM "items" WHERE book_id = $1 ORDER BY barcode`)
+				rows := sqlmock.NewRows(itemColumns)
+				mock.ExpectQuery(query).
+					WithArgs(bookId).
+					WillReturnRows(itemRow(itemRow(rows, item1), item2))
+				return nil
+			},
+			expectedError: nil,
+			expectedList:  []models.Item{item1, item2},
+		},
+		{
+			name:   "Find no available items",
+			params: &dto.ItemQueryParams{Status: models.ItemStatusAvailable},
+			mockFunction: func(mock sqlmock.Sqlmock, params dto.ItemQueryParams) error {
+				query := regexp.QuoteMeta(`SELECT * FROM "items" WHERE book_id = $1 AND status = $2 ORDER BY barcode`)
+				mock.ExpectQuery(query).
+					WithArgs(bookId, params.Status).
+					WillReturnRows(sqlmock.NewRows(itemColumns))
+				return nil
+			},
+			expectedError: nil,
+			expectedList:  []models.Item{},
+		},
+		{
+			name:   "Find all items with error",
+			params: &dto.ItemQueryParams{},
+			mockFunction: func(mock sqlmock.Sqlmock, params dto.ItemQueryParams) error {
+				err := sqlmock.ErrCancelled
+				query := regexp.QuoteMeta(`SELECT * FROM "items" WHERE book_id = $1 ORDER BY barcode`)
+				mock.ExpectQuery(query).
+					WithArgs(bookId).
+					WillReturnError(err)
+				return err
+			},
+			expectedError: sqlmock.ErrCancelled,
+			expectedList:  []models.Item{},
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, itemRepository := createItemRepository()
+			tt.mockFunction(mock, *tt.params)
+			items, err := itemRepository.FindAllItemsByBookId(bookId, tt.params)
+			if err != tt.expectedError {
+				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
+			}
+			if len(items) != len(tt.expectedList) {
+				t.Errorf("Expected list length: %v, got: %v", len(tt.expectedList), len(items))
+			}
+		})
+	}
+}
+
+func TestFindItemByID(t *testing.T) {
+
+	item := generateRandomItem01()
+
+	tc := []struct {
+		name          string
+		id            uuid.UUID
+		mockFunction  func(mock sqlmock.Sqlmock, id string) error
+		expectedError error
+		expectedItem  *models.Item
+	}{
+		{
+			name: "Find item by id successfully",
+			id:   *item.ID,
+			mockFunction: func(mock sqlmock.Sqlmock, id string) error {
+				query := regexp.QuoteMeta(`SELECT * FROM "items" WHERE "items"."id" = $1 ORDER BY "items"."id" LIMIT 1`)
+				mock.ExpectQuery(query).
+					WithArgs(id).
+					WillReturnRows(itemRow(sqlmock.NewRows(itemColumns), item))
+				return nil
+			},
+			expectedError: nil,
+			expectedItem:  &item,
+		},
+		{
+			name: "Find item by id with error",
+			id:   *item.ID,
+			mockFunction: func(mock sqlmock.Sqlmock, id string) error {
+				err := sqlmock.ErrCancelled
+				query := regexp.QuoteMeta(`SELECT * FROM "items" WHERE "items"."id" = $1 ORDER BY "items"."id" LIMIT 1`)
+				mock.ExpectQuery(query).
+					WithArgs(id).
+					WillReturnError(err)
+				return err
+			},
+			expectedError: sqlmock.ErrCancelled,
+			expectedItem:  nil,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, itemRepository := createItemRepository()
+			tt.mockFunction(mock, tt.id.String())
+			item, err := itemRepository.FindByItemId(tt.id)
+			if err != tt.expectedError {
+				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
+			}
+			if item != nil && *item.Barcode != *tt.expectedItem.Barcode {
+				t.Errorf("Expected item: %v, got: %v", tt.expectedItem, item)
+			}
+		})
+	}
+}
+
+func TestFindByBarcode(t *testing.T) {
+
+	item := generateRandomItem01()
+
+	tc := []struct {
+		name          string
+		barcode       string
+		mockFunction  func(mock sqlmock.Sqlmock, barcode string) error
+		expectedError error
+		expectedItem  *models.Item
+	}{
+		{
+			name:    "Find item by barcode successfully",
+			barcode: *item.Barcode,
+			mockFunction: func(mock sqlmock.Sqlmock, barcode string) error {
+				query := regexp.QuoteMeta(`SELECT * FROM "items" WHERE barcode = $1 ORDER BY "items"."id" LIMIT 1`)
+				mock.ExpectQuery(query).
+					WithArgs(barcode).
+					WillReturnRows(itemRow(sqlmock.NewRows(itemColumns), item))
+				return nil
+			},
+			expectedError: nil,
+			expectedItem:  &item,
+		},
+		{
+			name:    "Find item by barcode with error",
+			barcode: "LIB-999999",
+			mockFunction: func(mock sqlmock.Sqlmock, barcode string) error {
+				err := sqlmock.ErrCancelled
+				query := regexp.QuoteMeta(`SELECT * FROM "items" WHERE barcode = $1 ORDER BY "items"."id" LIMIT 1`)
+				mock.ExpectQuery(query).
+					WithArgs(barcode).
+					WillReturnError(err)
+				return err
+			},
+			expectedError: sqlmock.ErrCancelled,
+			expectedItem:  nil,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, itemRepository := createItemRepository()
+			tt.mockFunction(mock, tt.barcode)
+			item, err := itemRepository.FindByBarcode(tt.barcode)
+			if err != tt.expectedError {
+				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
+			}
+			if item != nil && *item.Barcode != *tt.expectedItem.Barcode {
+				t.Errorf("Expected item: %v, got: %v", tt.expectedItem, item)
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/api/items/dto"
+	"github.com/minand-mohan/library-app-api/database/models"
+	"gorm.io/gorm"
+)
+
+type ItemRepository interface {
+	CreateItem(itemObj *models.Item) error
+	FindByBarcode(barcode string) (*models.Item, error)
+	FindAllItemsByBookId(bookId uuid.UUID, queryParams *dto.ItemQueryParams) ([]models.Item, error)
+	FindByItemId(id uuid.UUID) (*models.Item, error)
+	RetireByItemId(id uuid.UUID, retiredAt time.Time) (bool, error)
+}
+
+type ItemRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewItemRepository(db *gorm.DB) ItemRepository {
+	return &ItemRepositoryImpl{db}
+}
@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/database/models"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+var itemColumns = []string{"id", "book_id", "barcode", "shelf_location", "condition", "status", "acquired_at", "retired_at"}
+
+func createItemRepository() (sqlmock.Sqlmock, ItemRepository) {
+	var (
+		db   *sql.DB
+		mock sqlmock.Sqlmock
+	)
+
+	db, mock, _ = sqlmock.New()
+	dialector := postgres.New(postgres.Config{
+		DSN:                  "sqlmock_db_0",
+		DriverName:           "postgres",
+		Conn:                 db,
+		PreferSimpleProtocol: true,
+	})
+	sDb, _ := gorm.Open(dialector, &gorm.Config{})
+
+	itemRepository := NewItemRepository(sDb)
+
+	return mock, itemRepository
+}
+
+func generateRandomItem01() models.Item {
+	//initialize variables
+	test_barcode := "LIB-000001"
+	test_shelf := "A-12"
+	test_condition := "good"
+	test_status := models.ItemStatusAvailable
+	test_acquired_at := time.Date(2023, 1, 15, 0, 0, 0, 0, time.UTC)
+	test_id := uuid.New()
+	test_book_id := uuid.New()
+	return models.Item{
+		ID:            &test_id,
+		BookID:        &test_book_id,
+		Barcode:       &test_barcode,
+		ShelfLocation: &test_shelf,
+		Condition:     &test_condition,
+		Status:        &test_status,
+		AcquiredAt:    &test_acquired_at,
+	}
+}
+
+func generateRandomItem02() models.Item {
+	//initialize variables
+	test_barcode := "LIB-000002"
+	test_status := models.ItemStatusRetired
+	test_acquired_at := time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)
+	test_retired_at := time.Date(2023, 3, 1, 10, 0, 0, 0, time.UTC)
+	test_id := uuid.New()
+	test_book_id := uuid.New()
+	return models.Item{
+		ID:         &test_id,
+		BookID:     &test_book_id,
+		Barcode:    &test_barcode,
+		Status:     &test_status,
+		AcquiredAt: &test_acquired_at,
+		RetiredAt:  &test_retired_at,
+	}
+}
+
+func itemRow(rows *sqlmock.Rows, item models.Item) *sqlmock.Rows {
+	return rows.AddRow(item.ID, item.BookID, item.Barcode, item.ShelfLocation, item.Condition, item.Status, item.AcquiredAt, item.RetiredAt)
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/database/dberrors"
+	"github.com/minand-mohan/library-app-api/database/models"
+)
+
+// Retire a copy by id, only while it is available. The status is checked in
+// the same statement so that a copy checked out or assigned to a hold since
+// it was read is left as it is, reported by returning false.
+func (repo *ItemRepositoryImpl) RetireByItemId(id uuid.UUID, retiredAt time.Time) (bool, error) {
+	result := repo.db.Model(&models.Item{}).
+		Where("id = ? AND status = ?", id, models.ItemStatusAvailable).
+		Updates(map[string]interface{}{"status": models.ItemStatusRetired, "retired_at": retiredAt})
+	if result.Error != nil {
+		return false, dberrors.Translate(result.Error)
+	}
+	return result.RowsAffected == 1, nil
+}
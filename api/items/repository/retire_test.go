@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/database/models"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+func TestRetireItem(t *testing.T) {
+	//initialize variables
+	test_retired_at := time.Now().UTC()
+	query := regexp.QuoteMeta(`UPDATE "items" SET "retired_at"=$1,"status"=$2 WHERE id = $3 AND status = $4`)
+
+	tc := []struct {
+		name            string
+		id              uuid.UUID
+		mockFunction    func(mock sqlmock.Sqlmock, id uuid.UUID) error
+		expectedRetired bool
+		expectedError   error
+	}{
+		{
+			name: "Item retired successfully",
+			id:   uuid.New(),
+			mockFunction: func(mock sqlmock.Sqlmock, id uuid.UUID) error {
+				mock.ExpectBegin()
+				mock.ExpectExec(query).
+					WithArgs(test_retired_at, models.ItemStatusRetired, id, models.ItemStatusAvailable).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+				return nil
+			},
+			expectedRetired: true,
+			expectedError:   nil,
+		},
+		{
+			name: "Item no longer available",
+			id:   uuid.New(),
+			mockFunction: func(mock sqlmock.Sqlmock, id uuid.UUID) error {
+				mock.ExpectBegin()
+				mock.ExpectExec(query).
+					WithArgs(test_retired_at, models.ItemStatusRetired, id, models.ItemStatusAvailable).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectCommit()
+				return nil
+			},
+			expectedRetired: false,
+			expectedError:   nil,
+		},
+		{
+			name: "Item retire failed",
+			id:   uuid.New(),
+			mockFunction: func(mock sqlmock.Sqlmock, id uuid.UUID) error {
+				err := sqlmock.ErrCancelled
+				mock.ExpectBegin()
+				mock.ExpectExec(query).
+					WithArgs(test_retired_at, models.ItemStatusRetired, id, models.ItemStatusAvailable).
+					WillReturnError(err)
+				mock.ExpectRollback()
+				return err
+			},
+			expectedRetired: false,
+			expectedError:   sqlmock.ErrCancelled,
+		},
+	}
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, itemRepository := createItemRepository()
+			tt.mockFunction(mock, tt.id)
+			retired, err := itemRepository.RetireByItemId(tt.id, test_retired_at)
+			if err != tt.expectedError {
+				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
+			}
+			if retired != tt.expectedRetired {
+				t.Errorf("Expected retired: %v, got: %v", tt.expectedRetired, retired)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Unmet expectations: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/api/items/dto"
+	"github.com/minand-mohan/library-app-api/api/items/validator"
+	"github.com/minand-mohan/library-app-api/api/response"
+	"github.com/minand-mohan/library-app-api/database/dberrors"
+	"github.com/minand-mohan/library-app-api/database/models"
+)
+
+func (service *ItemServiceImpl) CreateItem(bookId uuid.UUID, itemReq *dto.ItemRequestBody) (*response.HTTPResponse, error) {
+	service.logger.Info("Item Service: Create item")
+	_, err := service.bookRepo.FindByBookId(bookId)
+	if err != nil {
+		service.logger.Error(fmt.Sprintf("ItemService: Error while finding book by id: %s", err))
+		return response.GetRepositoryErrorHTTPResponseBody(err, "Book not found."), err
+	}
+
+	existingItem, err := service.repo.FindByBarcode(itemReq.Barcode)
+	if err == nil {
+		service.logger.Error(fmt.Sprintf("ItemService: Item with barcode %s already exists", itemReq.Barcode))
+		responseBody := response.HTTPResponse{
+			Code:    400,
+			Message: "Bad request, item already exists",
+			Content: itemResponseContent(existingItem),
+		}
+		return &responseBody, errors.New("item already exists")
+	}
+	if !errors.Is(err, dberrors.ErrNotFound) {
+		service.logger.Error(fmt.Sprintf("ItemService: Error while finding existing item: %s", err))
+		return response.GetRepositoryErrorHTTPResponseBody(err, "Item not found."), err
+	}
+
+	status := models.ItemStatusAvailable
+	acquiredAt := time.Now().UTC().Truncate(24 * time.Hour)
+	if itemReq.AcquiredAt != "" {
+		acquiredAt, _ = time.Parse(validator.DateFormat, itemReq.AcquiredAt)
+	}
+	itemObj := &models.Item{
+		BookID:     &bookId,
+		Barcode:    &itemReq.Barcode,
+		Status:     &status,
+		AcquiredAt: &acquiredAt,
+	}
+	if itemReq.ShelfLocation != "" {
+		itemObj.ShelfLocation = &itemReq.ShelfLocation
+	}
+	if itemReq.Condition != "" {
+		itemObj.Condition = &itemReq.Condition
+	}
+
+	err = service.repo.CreateItem(itemObj)
+	if err != nil {
+		service.logger.Error(fmt.Sprintf("ItemService: Error while creating item: %s", err))
+		return response.GetRepositoryErrorHTTPResponseBody(err, "Book not found."), err
+	}
+	responseBody := response.HTTPResponse{
+		Code:    200,
+		Message: "Item created successfully",
+		Content: itemResponseContent(itemObj),
+	}
+	return &responseBody, nil
+}
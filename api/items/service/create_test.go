@@ -0,0 +1,141 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	bookrepomocks "github.com/minand-mohan/library-app-api/api/books/repository/mocks"
+	"github.com/minand-mohan/library-app-api/api/items/dto"
+	repomocks "github.com/minand-mohan/library-app-api/api/items/repository/mocks"
+	"github.com/minand-mohan/library-app-api/api/response"
+	"github.com/minand-mohan/library-app-api/database/dberrors"
+	"github.com/minand-mohan/library-app-api/database/models"
+	"github.com/minand-mohan/library-app-api/utils"
+)
+
+func TestCreateItem(t *testing.T) {
+	test_book := generateRandomBook01()
+	existingItem := generateRandomItem01()
+
+	test_cases_that_require_find_item := map[string]bool{
+		"Create Item sucessfully":        true,
+		"Create Item with existing item": true,
+		"Create Item with service error": true,
+	}
+	test_cases_that_require_create_item := map[string]bool{
+		"Create Item sucessfully":        true,
+		"Create Item with service error": true,
+	}
+
+	tc := []struct {
+		name                string
+		requestbody         *dto.ItemRequestBody
+		expectedResponse    *response.HTTPResponse
+		expectedError       error
+		mockFindBookReturn  *models.Book
+		mockFindBookError   error
+		mockFindItemReturn  *models.Item
+		mockFindItemError   error
+		mockCreateItemError error
+	}{
+		{
+			name: "Create Item sucessfully",
+			requestbody: &dto.ItemRequestBody{
+				Barcode:    "LIB-000001",
+				Condition:  "new",
+				AcquiredAt: "2023-01-15",
+			},
+			expectedResponse: &response.HTTPResponse{
+				Code:    200,
+				Message: "Item created successfully",
+			},
+			expectedError:       nil,
+			mockFindBookReturn:  &test_book,
+			mockFindBookError:   nil,
+			mockFindItemReturn:  nil,
+			mockFindItemError:   dberrors.ErrNotFound,
+			mockCreateItemError: nil,
+		},
+		{
+			name: "Create Item for missing book",
+			requestbody: &dto.ItemRequestBody{
+				Barcode: "LIB-000001",
+			},
+			expectedResponse: &response.HTTPResponse{
+				Code:    404,
+				Message: "Book not found.",
+			},
+			expectedError:       dberrors.ErrNotFound,
+			mockFindBookReturn:  nil,
+			mockFindBookError:   dberrors.ErrNotFound,
+			mockFindItemReturn:  nil,
+			mockFindItemError:   nil,
+			mockCreateItemError: nil,
+		},
+		{
+			name: "Create Item with existing item",
+			requestbody: &dto.ItemRequestBody{
+				Barcode: "LIB-000001",
+			},
+			expectedResponse: &response.HTTPResponse{
+				Code:    400,
+				Message: "Bad request, item already exists",
+			},
+			expectedError:       errors.New("item already exists"),
+			mockFindBookReturn:  &test_book,
+			mockFindBookError:   nil,
+			mockFindItemReturn:  &existingItem,
+			mockFindItemError:   nil,
+			mockCreateItemError: nil,
+		},
+		{
+			name: "Create Item with service error",
+			requestbody: &dto.ItemRequestBody{
+				Barcode: "LIB-000001",
+			},
+			expectedResponse: &response.HTTPResponse{
+				Code:    500,
+				Message: "Internal Server Error",
+			},
+			expectedError:       errors.New("Internal Server Error"),
+			mockFindBookReturn:  &test_book,
+			mockFindBookError:   nil,
+			mockFindItemReturn:  nil,
+			mockFindItemError:   dberrors.ErrNotFound,
+			mockCreateItemError: errors.New("Internal Server Error"),
+		},
+	}
+
+	for _, tt := range tc {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := repomocks.NewMockItemRepository(mockCtrl)
+			mockBookRepo := bookrepomocks.NewMockBookRepository(mockCtrl)
+			mockBookRepo.EXPECT().FindByBookId(*test_book.ID).Return(tt.mockFindBookReturn, tt.mockFindBookError)
+			if test_cases_that_require_find_item[tt.name] {
+				mockRepo.EXPECT().FindByBarcode(tt.requestbody.Barcode).Return(tt.mockFindItemReturn, tt.mockFindItemError)
+			}
+			if test_cases_that_require_create_item[tt.name] {
+				mockRepo.EXPECT().CreateItem(gomock.Any()).Return(tt.mockCreateItemError)
+			}
+			service := NewItemService(mockRepo, mockBookRepo, *utils.NewLogger())
+
+			response, err := service.CreateItem(*test_book.ID, tt.requestbody)
+
+			if err != nil && tt.expectedError != nil {
+				if err.Error() != tt.expectedError.Error() {
+					t.Errorf("Expected error %v, got %v", tt.expectedError, err)
+				}
+			}
+			if response.Code != tt.expectedResponse.Code {
+				t.Errorf("Expected code %d, got %d", tt.expectedResponse.Code, response.Code)
+			}
+			if response.Message != tt.expectedResponse.Message {
+				t.Errorf("Expected message %s, got %s", tt.expectedResponse.Message, response.Message)
+			}
+		})
+	}
+}
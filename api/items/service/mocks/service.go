@@ -0,0 +1,93 @@
+package mocks
+
+import (
+	"reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/api/items/dto"
+	"github.com/minand-mohan/library-app-api/api/response"
+)
+
+// MockItemService is a mock of ItemService interface.
+type MockItemService struct {
+	ctrl     *gomock.Controller
+	recorder *MockItemServiceMockRecorder
+}
+
+// MockItemServiceMockRecorder is the mock recorder for MockItemService.
+type MockItemServiceMockRecorder struct {
+	mock *MockItemService
+}
+
+// NewMockItemService creates a new mock instance.
+func NewMockItemService(ctrl *gomock.Controller) *MockItemService {
+	mock := &MockItemService{ctrl: ctrl}
+	mock.recorder = &MockItemServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockItemService) EXPECT() *MockItemServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateItem mocks base method.
+func (m *MockItemService) CreateItem(arg0 uuid.UUID, arg1 *dto.ItemRequestBody) (*response.HTTPResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateItem", arg0, arg1)
+	ret0, _ := ret[0].(*response.HTTPResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateItem indicates an expected call of CreateItem.
+func (mr *MockItemServiceMockRecorder) CreateItem(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateItem", reflect.TypeOf((*MockItemService)(nil).CreateItem), arg0, arg1)
+}
+
+// FindAllItemsByBookId mocks base method.
+func (m *MockItemService) FindAllItemsByBookId(arg0 uuid.UUID, arg1 *dto.ItemQueryParams) (*response.HTTPResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllItemsByBookId", arg0, arg1)
+	ret0, _ := ret[0].(*response.HTTPResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllItemsByBookId indicates an expected call of FindAllItemsByBookId.
+func (mr *MockItemServiceMockRecorder) FindAllItemsByBookId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllItemsByBookId", reflect.TypeOf((*MockItemService)(nil).FindAllItemsByBookId), arg0, arg1)
+}
+
+// FindByBarcode mocks base method.
+func (m *MockItemService) FindByBarcode(arg0 string) (*response.HTTPResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByBarcode", arg0)
+	ret0, _ := ret[0].(*response.HTTPResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByBarcode indicates an expected call of FindByBarcode.
+func (mr *MockItemServiceMockRecorder) FindByBarcode(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByBarcode", reflect.TypeOf((*MockItemService)(nil).FindByBarcode), arg0)
+}
+
+// RetireByItemId mocks base method.
+func (m *MockItemService) RetireByItemId(arg0 uuid.UUID) (*response.HTTPResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetireByItemId", arg0)
+	ret0, _ := ret[0].(*response.HTTPResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetireByItemId indicates an expected call of RetireByItemId.
+func (mr *MockItemServiceMockRecorder) RetireByItemId(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetireByItemId", reflect.TypeOf((*MockItemService)(nil).RetireByItemId), arg0)
+}
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/api/items/dto"
+	"github.com/minand-mohan/library-app-api/api/response"
+)
+
+func (service *ItemServiceImpl) FindAllItemsByBookId(bookId uuid.UUID, queryParams *dto.ItemQueryParams) (*response.HTTPResponse, error) {
+	service.logger.Info("Item Service: Find all items by book id")
+	_, err := service.bookRepo.FindByBookId(bookId)
+	if err != nil {
+		service.logger.Error(fmt.Sprintf("ItemService: Error while finding book by id: %s", err))
+		return response.GetRepositoryErrorHTTPResponseBody(err, "Book not found."), err
+	}
+
+	items, err := service.repo.FindAllItemsByBookId(bookId, queryParams)
+	if err != nil {
+		service.logger.Error(fmt.Sprintf("ItemService: Error while finding all items: %s", err))
+		return response.GetRepositoryErrorHTTPResponseBody(err, "Item not found."), err
+	}
+	if len(items) == 0 {
+		service.logger.Error("ItemService: No items found")
+		responseBody := response.HTTPResponse{
+			Code:    404,
+			Message: "No items found",
+			Content: map[string]interface{}{},
+		}
+		return &responseBody, nil
+	}
+	var itemsMap []map[string]interface{}
+	for i := range items {
+		itemsMap = append(itemsMap, itemResponseContent(&items[i]))
+	}
+	responseContent := response.HTTPResponseContent{
+		Count:    len(items),
+		Previous: nil,
+		Next:     nil,
+		Results:  itemsMap,
+	}
+	responseBody := response.HTTPResponse{
+		Code:    200,
+		Message: "Items found successfully",
+		Content: responseContent,
+	}
+	return &responseBody, nil
+}
+
+func (service *ItemServiceImpl) FindByBarcode(barcode string) (*response.HTTPResponse, error) {
+	service.logger.Info("Item Service: Find item by barcode")
+	item, err := service.repo.FindByBarcode(barcode)
+	if err != nil {
+		service.logger.Error(fmt.Sprintf("ItemService: Error while finding item by barcode: %s", err))
+		return response.GetRepositoryErrorHTTPResponseBody(err, "Item not found."), err
+	}
+	responseBody := response.HTTPResponse{
+		Code:    200,
+		Message: "Item found",
+		Content: itemResponseContent(item),
+	}
+	return &responseBody, nil
+}
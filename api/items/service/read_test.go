@@ -0,0 +1,153 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	bookrepomocks "github.com/minand-mohan/library-app-api/api/books/repository/mocks"
+	"github.com/minand-mohan/library-app-api/api/items/dto"
+	repomocks "github.com/minand-mohan/library-app-api/api/items/repository/mocks"
+	"github.com/minand-mohan/library-app-api/api/response"
+	"github.com/minand-mohan/library-app-api/database/dberrors"
+	"github.com/minand-mohan/library-app-api/database/models"
+	"github.com/minand-mohan/library-app-api/utils"
+)
+
+func TestListItems(t *testing.T) {
+
+	internalServerError := errors.New("Internal Server Error")
+	test_book := generateRandomBook01()
+
+	tc := []struct {
+		name                   string
+		queryParams            *dto.ItemQueryParams
+		expectedResponse       *response.HTTPResponse
+		expectedError          error
+		mockFindAllItemsReturn []models.Item
+		mockFindAllItemsError  error
+	}{
+		{
+			name:        "Find all items successfully",
+			queryParams: &dto.ItemQueryParams{},
+			expectedResponse: &response.HTTPResponse{
+				Code:    200,
+				Message: "Items found successfully",
+			},
+			expectedError: nil,
+			mockFindAllItemsReturn: []models.Item{
+				generateRandomItem01(),
+				generateRandomItem02(),
+			},
+			mockFindAllItemsError: nil,
+		},
+		{
+			name: "Find no items",
+			queryParams: &dto.ItemQueryParams{
+				Status: models.ItemStatusAvailable,
+			},
+			expectedResponse: &response.HTTPResponse{
+				Code:    404,
+				Message: "No items found",
+			},
+			expectedError:          nil,
+			mockFindAllItemsReturn: []models.Item{},
+			mockFindAllItemsError:  nil,
+		},
+		{
+			name:        "Find all items with error",
+			queryParams: &dto.ItemQueryParams{},
+			expectedResponse: &response.HTTPResponse{
+				Code:    500,
+				Message: "Internal Server Error",
+			},
+			expectedError:          internalServerError,
+			mockFindAllItemsReturn: nil,
+			mockFindAllItemsError:  internalServerError,
+		},
+	}
+
+	for _, tt := range tc {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+
+		t.Run(tt.name, func(t *testing.T) {
+			mockItemRepo := repomocks.NewMockItemRepository(mockCtrl)
+			mockBookRepo := bookrepomocks.NewMockBookRepository(mockCtrl)
+			mockBookRepo.EXPECT().FindByBookId(*test_book.ID).Return(&test_book, nil)
+			mockItemRepo.EXPECT().FindAllItemsByBookId(*test_book.ID, tt.queryParams).Return(tt.mockFindAllItemsReturn, tt.mockFindAllItemsError)
+
+			itemService := NewItemService(mockItemRepo, mockBookRepo, *utils.NewLogger())
+			response, err := itemService.FindAllItemsByBookId(*test_book.ID, tt.queryParams)
+			if err != tt.expectedError {
+				t.Errorf("Expected error to be %v, but got %v", tt.expectedError, err)
+			}
+			if response.Code != tt.expectedResponse.Code {
+				t.Errorf("Expected code to be %d, but got %d", tt.expectedResponse.Code, response.Code)
+			}
+			if response.Message != tt.expectedResponse.Message {
+				t.Errorf("Expected message to be %s, but got %s", tt.expectedResponse.Message, response.Message)
+			}
+		})
+	}
+}
+
+func TestFindItemByBarcode(t *testing.T) {
+
+	test_item := generateRandomItem01()
+	itemNotFoundError := dberrors.ErrNotFound
+
+	tc := []struct {
+		name               string
+		barcode            string
+		expectedResponse   *response.HTTPResponse
+		expectedError      error
+		mockFindItemReturn *models.Item
+		mockFindItemError  error
+	}{
+		{
+			name:    "Find item by barcode successfully",
+			barcode: *test_item.Barcode,
+			expectedResponse: &response.HTTPResponse{
+				Code:    200,
+				Message: "Item found",
+			},
+			expectedError:      nil,
+			mockFindItemReturn: &test_item,
+			mockFindItemError:  nil,
+		},
+		{
+			name:    "Cannot find item",
+			barcode: "LIB-999999",
+			expectedResponse: &response.HTTPResponse{
+				Code:    404,
+				Message: "Item not found.",
+			},
+			expectedError:      itemNotFoundError,
+			mockFindItemReturn: nil,
+			mockFindItemError:  itemNotFoundError,
+		},
+	}
+
+	for _, tt := range tc {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+
+		t.Run(tt.name, func(t *testing.T) {
+			mockItemRepo := repomocks.NewMockItemRepository(mockCtrl)
+			mockItemRepo.EXPECT().FindByBarcode(tt.barcode).Return(tt.mockFindItemReturn, tt.mockFindItemError)
+
+			itemService := NewItemService(mockItemRepo, bookrepomocks.NewMockBookRepository(mockCtrl), *utils.NewLogger())
+			response, err := itemService.FindByBarcode(tt.barcode)
+			if err != tt.expectedError {
+				t.Errorf("Expected error to be %v, but got %v", tt.expectedError, err)
+			}
+			if response.Code != tt.expectedResponse.Code {
+				t.Errorf("Expected code to be %d, but got %d", tt.expectedResponse.Code, response.Code)
+			}
+			if response.Message != tt.expectedResponse.Message {
+				t.Errorf("Expected message to be %s, but got %s", tt.expectedResponse.Message, response.Message)
+			}
+		})
+	}
+}
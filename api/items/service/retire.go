@@ -0,0 +1,74 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/api/response"
+	"github.com/minand-mohan/library-app-api/database/models"
+)
+
+// RetireByItemId withdraws a copy from circulation. The row is kept so that
+// the circulation history of the copy is preserved.
+func (service *ItemServiceImpl) RetireByItemId(id uuid.UUID) (*response.HTTPResponse, error) {
+	service.logger.Info("Item Service: Retire item by id")
+	item, err := service.repo.FindByItemId(id)
+	if err != nil {
+		service.logger.Error(fmt.Sprintf("ItemService: Error while finding item by id: %s", err))
+		return response.GetRepositoryErrorHTTPResponseBody(err, "Item not found."), err
+	}
+	if item.Status != nil && *item.Status == models.ItemStatusRetired {
+		service.logger.Error(fmt.Sprintf("ItemService: Item %s is already retired", id))
+		responseBody := response.HTTPResponse{
+			Code:    400,
+			Message: "Bad request, item already retired",
+			Content: itemResponseContent(item),
+		}
+		return &responseBody, errors.New("item already retired")
+	}
+	if item.Status != nil && *item.Status == models.ItemStatusOnLoan {
+		service.logger.Error(fmt.Sprintf("ItemService: Item %s is on loan", id))
+		responseBody := response.HTTPResponse{
+			Code:    400,
+			Message: "Bad request, item is on loan",
+			Content: itemResponseContent(item),
+		}
+		return &responseBody, errors.New("item is on loan")
+	}
+	if item.Status != nil && *item.Status == models.ItemStatusOnHold {
+		service.logger.Error(fmt.Sprintf("ItemService: Item %s is on hold", id))
+		responseBody := response.HTTPResponse{
+			Code:    400,
+			Message: "Bad request, item is on hold",
+			Content: itemResponseContent(item),
+		}
+		return &responseBody, errors.New("item is on hold")
+	}
+
+	retiredAt := time.Now().UTC()
+	retired, err := service.repo.RetireByItemId(id, retiredAt)
+	if err != nil {
+		service.logger.Error(fmt.Sprintf("ItemService: Error while retiring item: %s", err))
+		return response.GetRepositoryErrorHTTPResponseBody(err, "Item not found."), err
+	}
+	if !retired {
+		// checked out or assigned to a hold since it was read
+		service.logger.Error(fmt.Sprintf("ItemService: Item %s is no longer available", id))
+		responseBody := response.HTTPResponse{
+			Code:    409,
+			Message: "Conflict, item is no longer available",
+		}
+		return &responseBody, errors.New("item is no longer available")
+	}
+	status := models.ItemStatusRetired
+	item.Status = &status
+	item.RetiredAt = &retiredAt
+	responseBody := response.HTTPResponse{
+		Code:    200,
+		Message: "Item retired successfully",
+		Content: itemResponseContent(item),
+	}
+	return &responseBody, nil
+}
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	bookrepomocks "github.com/minand-mohan/library-app-api/api/books/repository/mocks"
+	repomocks "github.com/minand-mohan/library-app-api/api/items/repository/mocks"
+	"github.com/minand-mohan/library-app-api/api/response"
+	"github.com/minand-mohan/library-app-api/database/dberrors"
+	"github.com/minand-mohan/library-app-api/database/models"
+	"github.com/minand-mohan/library-app-api/utils"
+)
+
+func TestRetireItem(t *testing.T) {
+
+	internalServerError := errors.New("Internal Server Error")
+	itemNotFoundError := dberrors.ErrNotFound
+
+	available_item := generateRandomItem01()
+	retired_item := generateRandomItem02()
+	on_loan_item := generateRandomItem01()
+	on_loan_status := models.ItemStatusOnLoan
+	on_loan_item.Status = &on_loan_status
+	on_hold_item := generateRandomItem01()
+	on_hold_status := models.ItemStatusOnHold
+	on_hold_item.Status = &on_hold_status
+
+	test_cases_that_require_update_item := map[string]bool{
+		"Retire Item sucessfully":              true,
+		"Retire Item with error":               true,
+		"Retire Item checked out concurrently": true,
+	}
+
+	tc := []struct {
+		name                 string
+		item                 models.Item
+		expectedResponse     *response.HTTPResponse
+		expectedError        error
+		mockFindItemReturn   *models.Item
+		mockFindItemError    error
+		mockRetireItemReturn bool
+		mockRetireItemError  error
+	}{
+		{
+			name: "Retire Item sucessfully",
+			item: available_item,
+			expectedResponse: &response.HTTPResponse{
+				Code:    200,
+				Message: "Item retired successfully",
+			},
+			expectedError:        nil,
+			mockFindItemReturn:   &available_item,
+			mockFindItemError:    nil,
+			mockRetireItemReturn: true,
+			mockRetireItemError:  nil,
+		},
+		{
+			name: "Retire Item with error",
+			item: available_item,
+			expectedResponse: &response.HTTPResponse{
+				Code:    500,
+				Message: "Internal Server Error",
+			},
+			expectedError:        internalServerError,
+			mockFindItemReturn:   &available_item,
+			mockFindItemError:    nil,
+			mockRetireItemReturn: false,
+			mockRetireItemError:  internalServerError,
+		},
+		{
+			name: "Retire Item checked out concurrently",
+			item: available_item,
+			expectedResponse: &response.HTTPResponse{
+				Code:    409,
+				Message: "Conflict, item is no longer available",
+			},
+			expectedError:        errors.New("item is no longer available"),
+			mockFindItemReturn:   &available_item,
+			mockFindItemError:    nil,
+			mockRetireItemReturn: false,
+			mockRetireItemError:  nil,
+		},
+		{
+			name: "Retire Item already retired",
+			item: retired_item,
+			expectedResponse: &response.HTTPResponse{
+				Code:    400,
+				Message: "Bad request, item already retired",
+			},
+			expectedError:      errors.New("item already retired"),
+			mockFindItemReturn: &retired_item,
+			mockFindItemError:  nil,
+		},
+		{
+			name: "Retire Item on loan",
+			item: on_loan_item,
+			expectedResponse: &response.HTTPResponse{
+				Code:    400,
+				Message: "Bad request, item is on loan",
+			},
+			expectedError:      errors.New("item is on loan"),
+			mockFindItemReturn: &on_loan_item,
+			mockFindItemError:  nil,
+		},
+		{
+			name: "Retire Item on hold",
+			item: on_hold_item,
+			expectedResponse: &response.HTTPResponse{
+				Code:    400,
+				Message: "Bad request, item is on hold",
+			},
+			expectedError:      errors.New("item is on hold"),
+			mockFindItemReturn: &on_hold_item,
+			mockFindItemError:  nil,
+		},
+		{
+			name: "Cannot find item",
+			item: available_item,
+			expectedResponse: &response.HTTPResponse{
+				Code:    404,
+				Message: "Item not found.",
+			},
+			expectedError:      itemNotFoundError,
+			mockFindItemReturn: nil,
+			mockFindItemError:  itemNotFoundError,
+		},
+	}
+
+	for _, tt := range tc {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+
+		t.Run(tt.name, func(t *testing.T) {
+			item := tt.item
+			// the service updates the returned item in place, so hand it a copy
+			var foundItem *models.Item
+			if tt.mockFindItemReturn != nil {
+				itemCopy := *tt.mockFindItemReturn
+				foundItem = &itemCopy
+			}
+			mockItemRepo := repomocks.NewMockItemRepository(mockCtrl)
+			mockItemRepo.EXPECT().FindByItemId(*item.ID).Return(foundItem, tt.mockFindItemError)
+			if test_cases_that_require_update_item[tt.name] {
+				mockItemRepo.EXPECT().RetireByItemId(*item.ID, gomock.Any()).Return(tt.mockRetireItemReturn, tt.mockRetireItemError)
+			}
+
+			itemService := NewItemService(mockItemRepo, bookrepomocks.NewMockBookRepository(mockCtrl), *utils.NewLogger())
+			response, err := itemService.RetireByItemId(*item.ID)
+			if err != nil && tt.expectedError != nil {
+				if err.Error() != tt.expectedError.Error() {
+					t.Errorf("Expected error %v, got %v", tt.expectedError, err)
+				}
+			}
+			if response.Code != tt.expectedResponse.Code {
+				t.Errorf("Expected code to be %d, but got %d", tt.expectedResponse.Code, response.Code)
+			}
+			if response.Message != tt.expectedResponse.Message {
+				t.Errorf("Expected message to be %s, but got %s", tt.expectedResponse.Message, response.Message)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"github.com/google/uuid"
+	bookRepository "github.com/minand-mohan/library-app-api/api/books/repository"
+	"github.com/minand-mohan/library-app-api/api/items/dto"
+	"github.com/minand-mohan/library-app-api/api/items/repository"
+	"github.com/minand-mohan/library-app-api/api/response"
+	"github.com/minand-mohan/library-app-api/database/models"
+	"github.com/minand-mohan/library-app-api/utils"
+)
+
+type ItemService interface {
+	CreateItem(bookId uuid.UUID, itemReqBody *dto.ItemRequestBody) (*response.HTTPResponse, error)
+	FindAllItemsByBookId(bookId uuid.UUID, queryParams *dto.ItemQueryParams) (*response.HTTPResponse, error)
+	FindByBarcode(barcode string) (*response.HTTPResponse, error)
+	RetireByItemId(id uuid.UUID) (*response.HTTPResponse, error)
+}
+
+type ItemServiceImpl struct {
+	repo     repository.ItemRepository
+	bookRepo bookRepository.BookRepository
+	logger   *utils.AppLogger
+}
+
+func NewItemService(repo repository.ItemRepository, bookRepo bookRepository.BookRepository, logger utils.AppLogger) ItemService {
+	return &ItemServiceImpl{
+		repo:     repo,
+		bookRepo: bookRepo,
+		logger:   &logger,
+	}
+}
+
+func itemResponseContent(item *models.Item) map[string]interface{} {
+	return map[string]interface{}{
+		"id":             item.ID,
+		"book_id":        item.BookID,
+		"barcode":        item.Barcode,
+		"shelf_location": item.ShelfLocation,
+		"condition":      item.Condition,
+		"status":         item.Status,
+		"acquired_at":    item.AcquiredAt,
+		"retired_at":     item.RetiredAt,
+	}
+}
@@ -0,0 +1,52 @@
+package service
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/database/models"
+)
+
+func generateRandomBook01() models.Book {
+	//initialize variables
+	test_title := "The Go Programming Language"
+	test_isbn13 := "9780134190440"
+	test_id := uuid.New()
+	return models.Book{
+		ID:     &test_id,
+		Title:  &test_title,
+		ISBN13: &test_isbn13,
+	}
+}
+
+func generateRandomItem01() models.Item {
+	//initialize variables
+	test_barcode := "LIB-000001"
+	test_status := models.ItemStatusAvailable
+	test_acquired_at := time.Date(2023, 1, 15, 0, 0, 0, 0, time.UTC)
+	test_id := uuid.New()
+	test_book_id := uuid.New()
+	return models.Item{
+		ID:         &test_id,
+		BookID:     &test_book_id,
+		Barcode:    &test_barcode,
+		Status:     &test_status,
+		AcquiredAt: &test_acquired_at,
+	}
+}
+
+func generateRandomItem02() models.Item {
+	//initialize variables
+	test_barcode := "LIB-000002"
+	test_status := models.ItemStatusRetired
+	test_retired_at := time.Date(2023, 3, 1, 10, 0, 0, 0, time.UTC)
+	test_id := uuid.New()
+	test_book_id := uuid.New()
+	return models.Item{
+		ID:        &test_id,
+		BookID:    &test_book_id,
+		Barcode:   &test_barcode,
+		Status:    &test_status,
+		RetiredAt: &test_retired_at,
+	}
+}
@@ -0,0 +1,52 @@
+package mocks
+
+import (
+	"reflect"
+
+	"github.com/golang/mock/gomock"
+	"github.com/minand-mohan/library-app-api/api/items/dto"
+)
+
+// MockItemValidator is a mock type for the ItemValidator type
+type MockItemValidator struct {
+	ctrl     *gomock.Controller
+	recorder *MockItemValidatorMockRecorder
+}
+
+type MockItemValidatorMockRecorder struct {
+	mock *MockItemValidator
+}
+
+func NewMockItemValidator(ctrl *gomock.Controller) *MockItemValidator {
+	mock := &MockItemValidator{ctrl: ctrl}
+	mock.recorder = &MockItemValidatorMockRecorder{mock}
+	return mock
+}
+
+func (m *MockItemValidator) EXPECT() *MockItemValidatorMockRecorder {
+	return m.recorder
+}
+
+func (m *MockItemValidator) ValidateItem(arg0 *dto.ItemRequestBody) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateItem", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockItemValidatorMockRecorder) ValidateItem(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateItem", reflect.TypeOf((*MockItemValidator)(nil).ValidateItem), arg0)
+}
+
+func (m *MockItemValidator) ValidateItemQueryParams(arg0 *dto.ItemQueryParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateItemQueryParams", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockItemValidatorMockRecorder) ValidateItemQueryParams(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateItemQueryParams", reflect.TypeOf((*MockItemValidator)(nil).ValidateItemQueryParams), arg0)
+}
@@ -0,0 +1,73 @@
+package validator
+
+import (
+	"errors"
+	"time"
+
+	"github.com/minand-mohan/library-app-api/api/items/dto"
+	"github.com/minand-mohan/library-app-api/database/models"
+	"github.com/minand-mohan/library-app-api/utils"
+)
+
+// Date format used for acquired_at in request bodies
+const DateFormat = "2006-01-02"
+
+var validConditions = map[string]bool{
+	"new":     true,
+	"good":    true,
+	"fair":    true,
+	"poor":    true,
+	"damaged": true,
+}
+
+var validStatuses = map[string]bool{
+	models.ItemStatusAvailable: true,
+	models.ItemStatusOnLoan:    true,
+	models.ItemStatusOnHold:    true,
+	models.ItemStatusRetired:   true,
+}
+
+type ItemValidator interface {
+	ValidateItem(requestBody *dto.ItemRequestBody) error
+	ValidateItemQueryParams(queryParams *dto.ItemQueryParams) error
+}
+
+type ItemValidatorImpl struct {
+	logger *utils.AppLogger
+}
+
+func NewItemValidator(logger utils.AppLogger) ItemValidator {
+	return &ItemValidatorImpl{
+		logger: &logger,
+	}
+}
+
+func (validator *ItemValidatorImpl) ValidateItem(itemReq *dto.ItemRequestBody) error {
+	validator.logger.Info("Validate item")
+	if itemReq.Barcode == "" {
+		validator.logger.Error("Barcode is empty")
+		return errors.New("Barcode is empty")
+	}
+	if itemReq.Condition != "" && !validConditions[itemReq.Condition] {
+		validator.logger.Error("Condition is invalid")
+		return errors.New("Condition is invalid")
+	}
+	if itemReq.AcquiredAt != "" {
+		acquiredAt, err := time.Parse(DateFormat, itemReq.AcquiredAt)
+		if err != nil || acquiredAt.After(time.Now()) {
+			validator.logger.Error("Acquired at is invalid")
+			return errors.New("Acquired at is invalid")
+		}
+	}
+
+	return nil
+}
+
+func (validator *ItemValidatorImpl) ValidateItemQueryParams(queryParams *dto.ItemQueryParams) error {
+	if queryParams.Status != "" && !validStatuses[queryParams.Status] {
+		validator.logger.Error("Status is invalid")
+		return errors.New("Status is invalid")
+	}
+
+	return nil
+}
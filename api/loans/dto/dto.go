@@ -0,0 +1,6 @@
+package dto
+
+type LoanRequestBody struct {
+	UserID  string `json:"user_id"`
+	Barcode string `json:"barcode"`
+}
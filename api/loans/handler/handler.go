@@ -0,0 +1,18 @@
+package handler
+
+import (
+	"github.com/minand-mohan/library-app-api/api/loans/service"
+	"github.com/minand-mohan/library-app-api/api/loans/validator"
+)
+
+type LoanHandler struct {
+	service   service.LoanService
+	validator validator.LoanValidator
+}
+
+func NewLoanHandler(service service.LoanService, validator validator.LoanValidator) *LoanHandler {
+	return &LoanHandler{
+		service:   service,
+		validator: validator,
+	}
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"github.com/minand-mohan/library-app-api/database/dberrors"
+	"github.com/minand-mohan/library-app-api/database/models"
+)
+
+// CreateLoan creates a new loan
+func (repo *LoanRepositoryImpl) CreateLoan(loanObj *models.Loan) error {
+	result := repo.db.Create(&loanObj)
+	if result.Error != nil {
+		return dberrors.Translate(result.Error)
+	}
+	return nil
+}
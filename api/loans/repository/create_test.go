@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/minand-mohan/library-app-api/database/models"
+
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+func TestCreateLoan(t *testing.T) {
+	test_id := "123e4567-e89b-12d3-a456-426614174000"
+	newLoan := func() *models.Loan {
+		loan := generateRandomLoan01()
+		loan.ID = nil
+		return &loan
+	}
+
+	tc := []struct {
+		name          string
+		loan          *models.Loan
+		mockFunction  func(mock sqlmock.Sqlmock, loan *models.Loan) error
+		expectedError error
+	}{
+		{
+			name: "Loan created successfully",
+			loan: newLoan(),
+			mockFunction: func(mock sqlmock.Sqlmock, loan *models.Loan) error {
+				query := regexp.QuoteMeta(`INSERT INTO "loans" ("user_id","item_id","checked_out_at","due_at","returned_at","renewal_count") VALUES ($1,$2,$3,$4,$5,$6) RETURNING "id"`)
+				mock.ExpectBegin()
+				mock.ExpectQuery(query).
+					WithArgs(*loan.UserID, *loan.ItemID, *loan.CheckedOutAt, *loan.DueAt, nil, *loan.RenewalCount).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(test_id))
+				mock.ExpectCommit()
+				return nil
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Loan creation failed",
+			loan: newLoan(),
+			mockFunction: func(mock sqlmock.Sqlmock, loan *models.Loan) error {
+				err := sqlmock.ErrCancelled
+				query := regexp.QuoteMeta(`INSERT INTO "loans" ("user_id","item_id","checked_out_at","due_at","returned_at","renewal_count") VALUES ($1,$2,$3,$4,$5,$6) RETURNING "id"`)
+				mock.ExpectBegin()
+				mock.ExpectQuery(query).
+					WillReturnError(err)
+				mock.ExpectRollback()
+				return err
+			},
+			expectedError: sqlmock.ErrCancelled,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, loanRepository := createLoanRepository()
+			tt.mockFunction(mock, tt.loan)
+			err := loanRepository.CreateLoan(tt.loan)
+			if err != tt.expectedError {
+				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package mocks
+
+import (
+	"reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	fineRepository "github.com/minand-mohan/library-app-api/api/fines/repository"
+	holdRepository "github.com/minand-mohan/library-app-api/api/holds/repository"
+	"github.com/minand-mohan/library-app-api/api/loans/repository"
+	"github.com/minand-mohan/library-app-api/database/models"
+)
+
+// MockLoanRepository is a mock of LoanRepository interface.
+type MockLoanRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoanRepositoryMockRecorder
+}
+
+// MockLoanRepositoryMockRecorder is the mock recorder for MockLoanRepository.
+type MockLoanRepositoryMockRecorder struct {
+	mock *MockLoanRepository
+}
+
+// NewMockLoanRepository creates a new mock instance.
+func NewMockLoanRepository(ctrl *gomock.Controller) *MockLoanRepository {
+	mock := &MockLoanRepository{ctrl: ctrl}
+	mock.recorder = &MockLoanRepositoryMockRecorder{mock}
+	return mock
+}
+
+func (m *MockLoanRepository) EXPECT() *MockLoanRepositoryMockRecorder {
+	return m.recorder
+}
+
+func (m *MockLoanRepository) WithTransaction(arg0 func(repository.LoanRepository) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTransaction", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockLoanRepositoryMockRecorder) WithTransaction(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTransaction", reflect.TypeOf((*MockLoanRepository)(nil).WithTransaction), arg0)
+}
+
+func (m *MockLoanRepository) Fines() fineRepository.FineRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fines")
+	ret0, _ := ret[0].(fineRepository.FineRepository)
+	return ret0
+}
+
+func (mr *MockLoanRepositoryMockRecorder) Fines() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fines", reflect.TypeOf((*MockLoanRepository)(nil).Fines))
+}
+
+func (m *MockLoanRepository) Holds() holdRepository.HoldRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Holds")
+	ret0, _ := ret[0].(holdRepository.HoldRepository)
+	return ret0
+}
+
+func (mr *MockLoanRepositoryMockRecorder) Holds() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Holds", reflect.TypeOf((*MockLoanRepository)(nil).Holds))
+}
+
+func (m *MockLoanRepository) CreateLoan(arg0 *models.Loan) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLoan", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockLoanRepositoryMockRecorder) CreateLoan(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLoan", reflect.TypeOf((*MockLoanRepository)(nil).CreateLoan), arg0)
+}
+
+func (m *MockLoanRepository) FindByLoanIdForUpdate(arg0 uuid.UUID) (*models.Loan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByLoanIdForUpdate", arg0)
+	ret0, _ := ret[0].(*models.Loan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockLoanRepositoryMockRecorder) FindByLoanIdForUpdate(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByLoanIdForUpdate", reflect.TypeOf((*MockLoanRepository)(nil).FindByLoanIdForUpdate), arg0)
+}
+
+func (m *MockLoanRepository) FindActiveLoanByItemId(arg0 uuid.UUID) (*models.Loan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActiveLoanByItemId", arg0)
+	ret0, _ := ret[0].(*models.Loan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockLoanRepositoryMockRecorder) FindActiveLoanByItemId(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActiveLoanByItemId", reflect.TypeOf((*MockLoanRepository)(nil).FindActiveLoanByItemId), arg0)
+}
+
+func (m *MockLoanRepository) FindItemByBarcodeForUpdate(arg0 string) (*models.Item, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindItemByBarcodeForUpdate", arg0)
+	ret0, _ := ret[0].(*models.Item)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockLoanRepositoryMockRecorder) FindItemByBarcodeForUpdate(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindItemByBarcodeForUpdate", reflect.TypeOf((*MockLoanRepository)(nil).FindItemByBarcodeForUpdate), arg0)
+}
+
+func (m *MockLoanRepository) UpdateByLoanId(arg0 uuid.UUID, arg1 *models.Loan) (*models.Loan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateByLoanId", arg0, arg1)
+	ret0, _ := ret[0].(*models.Loan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockLoanRepositoryMockRecorder) UpdateByLoanId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateByLoanId", reflect.TypeOf((*MockLoanRepository)(nil).UpdateByLoanId), arg0, arg1)
+}
+
+func (m *MockLoanRepository) FindItemById(arg0 uuid.UUID) (*models.Item, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindItemById", arg0)
+	ret0, _ := ret[0].(*models.Item)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockLoanRepositoryMockRecorder) FindItemById(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindItemById", reflect.TypeOf((*MockLoanRepository)(nil).FindItemById), arg0)
+}
+
+func (m *MockLoanRepository) CountPendingHoldsByOtherUsers(arg0 uuid.UUID, arg1 uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountPendingHoldsByOtherUsers", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockLoanRepositoryMockRecorder) CountPendingHoldsByOtherUsers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountPendingHoldsByOtherUsers", reflect.TypeOf((*MockLoanRepository)(nil).CountPendingHoldsByOtherUsers), arg0, arg1)
+}
+
+func (m *MockLoanRepository) FindReadyHoldByItemIdForUpdate(arg0 uuid.UUID) (*models.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindReadyHoldByItemIdForUpdate", arg0)
+	ret0, _ := ret[0].(*models.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockLoanRepositoryMockRecorder) FindReadyHoldByItemIdForUpdate(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindReadyHoldByItemIdForUpdate", reflect.TypeOf((*MockLoanRepository)(nil).FindReadyHoldByItemIdForUpdate), arg0)
+}
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/database/dberrors"
+	"github.com/minand-mohan/library-app-api/database/models"
+	"gorm.io/gorm/clause"
+)
+
+// Retrieve a loan by its ID, locking the row until the transaction ends
+func (repo *LoanRepositoryImpl) FindByLoanIdForUpdate(id uuid.UUID) (*models.Loan, error) {
+	var loan models.Loan
+	result := repo.db.Clauses(clause.Locking{Strength: "UPDATE"}).First(&loan, id)
+	if result.Error != nil {
+		return nil, dberrors.Translate(result.Error)
+	}
+	return &loan, nil
+}
+
+// Retrieve the loan of an item that has not been returned yet
+func (repo *LoanRepositoryImpl) FindActiveLoanByItemId(itemId uuid.UUID) (*models.Loan, error) {
+	var loan models.Loan
+	result := repo.db.First(&loan, "item_id = ? AND returned_at IS NULL", itemId)
+	if result.Error != nil {
+		return nil, dberrors.Translate(result.Error)
+	}
+	return &loan, nil
+}
+
+// Retrieve an item by its barcode, locking the row until the transaction ends
+func (repo *LoanRepositoryImpl) FindItemByBarcodeForUpdate(barcode string) (*models.Item, error) {
+	var item models.Item
+	result := repo.db.Clauses(clause.Locking{Strength: "UPDATE"}).First(&item, "barcode = ?", barcode)
+	if result.Error != nil {
+		return nil, dberrors.Translate(result.Error)
+	}
+	return &item, nil
+}
+
+// Retrieve an item by its ID
+func (repo *LoanRepositoryImpl) FindItemById(id uuid.UUID) (*models.Item, error) {
+	var item models.Item
+	result := repo.db.First(&item, id)
+	if result.Error != nil {
+		return nil, dberrors.Translate(result.Error)
+	}
+	return &item, nil
+}
+
+// Count the outstanding holds placed on a book by anyone other than userId
+func (repo *LoanRepositoryImpl) CountPendingHoldsByOtherUsers(bookId uuid.UUID, userId uuid.UUID) (int64, error) {
+	var count int64
+	result := repo.db.Model(&models.Hold{}).
+		Where("book_id = ? AND user_id <> ? AND status = ?", bookId, userId, models.HoldStatusPending).
+		Count(&count)
+	if result.Error != nil {
+		return 0, dberrors.Translate(result.Error)
+	}
+	return count, nil
+}
+
+// Retrieve the ready hold an item has been set aside for, locking the row
+// until the transaction ends. Returns nil when the item is not set aside.
+func (repo *LoanRepositoryImpl) FindReadyHoldByItemIdForUpdate(itemId uuid.UUID) (*models.Hold, error) {
+	var holds []models.Hold
+	result := repo.db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("item_id = ? AND status = ?", itemId, models.HoldStatusReady).
+		Limit(1).
+		Find(&holds)
+	if result.Error != nil {
+		return nil, dberrors.Translate(result.Error)
+	}
+	if len(holds) == 0 {
+		return nil, nil
+	}
+	return &holds[0], nil
+}
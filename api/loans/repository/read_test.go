@@ -0,0 +1,328 @@
+package repository
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/database/models"
+
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+func TestFindByLoanIdForUpdate(t *testing.T) {
+
+	loan := generateRandomLoan01()
+
+	tc := []struct {
+		name          string
+		id            uuid.UUID
+		mockFunction  func(mock sqlmock.Sqlmock, id string) error
+		expectedError error
+		expectedLoan  *models.Loan
+	}{
+		{
+			name: "Find loan by id successfully",
+			id:   *loan.ID,
+			mockFunction: func(mock sqlmock.Sqlmock, id string) error {
+				query := regexp.QuoteMeta(`SELECT * FROM "loans" WHERE "loans"."id" = $1 ORDER BY "loans"."id" LIMIT 1 FOR UPDATE`)
+				mock.ExpectQuery(query).
+					WithArgs(id).
+					WillReturnRows(sqlmock.NewRows(loanColumns).
+						AddRow(loan.ID, loan.UserID, loan.ItemID, loan.CheckedOutAt, loan.DueAt, loan.ReturnedAt, loan.RenewalCount))
+				return nil
+			},
+			expectedError: nil,
+			expectedLoan:  &loan,
+		},
+		{
+			name: "Find loan by id with error",
+			id:   *loan.ID,
+			mockFunction: func(mock sqlmock.Sqlmock, id string) error {
+				err := sqlmock.ErrCancelled
+				query := regexp.QuoteMeta(`SELECT * FROM "loans" WHERE "loans"."id" = $1 ORDER BY "loans"."id" LIMIT 1 FOR UPDATE`)
+				mock.ExpectQuery(query).
+					WithArgs(id).
+					WillReturnError(err)
+				return err
+			},
+			expectedError: sqlmock.ErrCancelled,
+			expectedLoan:  nil,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, loanRepository := createLoanRepository()
+			tt.mockFunction(mock, tt.id.String())
+			loan, err := loanRepository.FindByLoanIdForUpdate(tt.id)
+			if err != tt.expectedError {
+				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
+			}
+			if loan != nil && *loan.ItemID != *tt.expectedLoan.ItemID {
+				t.Errorf("Expected loan: %v, got: %v", tt.expectedLoan, loan)
+			}
+		})
+	}
+}
+
+func TestFindActiveLoanByItemId(t *testing.T) {
+
+	loan := generateRandomLoan01()
+
+	tc := []struct {
+		name          string
+		itemId        uuid.UUID
+		mockFunction  func(mock sqlmock.Sqlmock, itemId uuid.UUID) error
+		expectedError error
+	}{
+		{
+			name:   "Find active loan successfully",
+			itemId: *loan.ItemID,
+			mockFunction: func(mock sqlmock.Sqlmock, itemId uuid.UUID) error {
+				query := regexp.QuoteMeta(`SELECT * FROM "loans" WHERE item_id = $1 AND returned_at IS NULL ORDER BY "loans"."id" LIMIT 1`)
+				mock.ExpectQuery(query).
+					WithArgs(itemId).
+					WillReturnRows(sqlmock.NewRows(loanColumns).
+						AddRow(loan.ID, loan.UserID, loan.ItemID, loan.CheckedOutAt, loan.DueAt, loan.ReturnedAt, loan.RenewalCount))
+				return nil
+			},
+			expectedError: nil,
+		},
+		{
+			name:   "Find active loan with error",
+			itemId: *loan.ItemID,
+			mockFunction: func(mock sqlmock.Sqlmock, itemId uuid.UUID) error {
+				err := sqlmock.ErrCancelled
+				query := regexp.QuoteMeta(`SELECT * FROM "loans" WHERE item_id = $1 AND returned_at IS NULL ORDER BY "loans"."id" LIMIT 1`)
+				mock.ExpectQuery(query).
+					WithArgs(itemId).
+					WillReturnError(err)
+				return err
+			},
+			expectedError: sqlmock.ErrCancelled,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, loanRepository := createLoanRepository()
+			tt.mockFunction(mock, tt.itemId)
+			_, err := loanRepository.FindActiveLoanByItemId(tt.itemId)
+			if err != tt.expectedError {
+				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestFindItemByBarcodeForUpdate(t *testing.T) {
+
+	test_id := uuid.New()
+	test_barcode := "LIB-000001"
+
+	tc := []struct {
+		name          string
+		barcode       string
+		mockFunction  func(mock sqlmock.Sqlmock, barcode string) error
+		expectedError error
+	}{
+		{
+			name:    "Find item by barcode successfully",
+			barcode: test_barcode,
+			mockFunction: func(mock sqlmock.Sqlmock, barcode string) error {
+				query := regexp.QuoteMeta(`SELECT * FROM "items" WHERE barcode = $1 ORDER BY "items"."id" LIMIT 1 FOR UPDATE`)
+				mock.ExpectQuery(query).
+					WithArgs(barcode).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "barcode", "status"}).
+						AddRow(test_id.String(), test_barcode, models.ItemStatusAvailable))
+				return nil
+			},
+			expectedError: nil,
+		},
+		{
+			name:    "Find item by barcode with error",
+			barcode: test_barcode,
+			mockFunction: func(mock sqlmock.Sqlmock, barcode string) error {
+				err := sqlmock.ErrCancelled
+				query := regexp.QuoteMeta(`SELECT * FROM "items" WHERE barcode = $1 ORDER BY "items"."id" LIMIT 1 FOR UPDATE`)
+				mock.ExpectQuery(query).
+					WithArgs(barcode).
+					WillReturnError(err)
+				return err
+			},
+			expectedError: sqlmock.ErrCancelled,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, loanRepository := createLoanRepository()
+			tt.mockFunction(mock, tt.barcode)
+			_, err := loanRepository.FindItemByBarcodeForUpdate(tt.barcode)
+			if err != tt.expectedError {
+				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestFindItemById(t *testing.T) {
+
+	test_id := uuid.New()
+	test_book_id := uuid.New()
+
+	tc := []struct {
+		name          string
+		id            uuid.UUID
+		mockFunction  func(mock sqlmock.Sqlmock, id uuid.UUID) error
+		expectedError error
+	}{
+		{
+			name: "Find item by id successfully",
+			id:   test_id,
+			mockFunction: func(mock sqlmock.Sqlmock, id uuid.UUID) error {
+				query := regexp.QuoteMeta(`SELECT * FROM "items" WHERE "items"."id" = $1 ORDER BY "items"."id" LIMIT 1`)
+				mock.ExpectQuery(query).
+					WithArgs(id).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "book_id", "status"}).
+						AddRow(id.String(), test_book_id.String(), models.ItemStatusOnLoan))
+				return nil
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Find item by id with error",
+			id:   test_id,
+			mockFunction: func(mock sqlmock.Sqlmock, id uuid.UUID) error {
+				err := sqlmock.ErrCancelled
+				query := regexp.QuoteMeta(`SELECT * FROM "items" WHERE "items"."id" = $1 ORDER BY "items"."id" LIMIT 1`)
+				mock.ExpectQuery(query).
+					WithArgs(id).
+					WillReturnError(err)
+				return err
+			},
+			expectedError: sqlmock.ErrCancelled,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, loanRepository := createLoanRepository()
+			tt.mockFunction(mock, tt.id)
+			_, err := loanRepository.FindItemById(tt.id)
+			if err != tt.expectedError {
+				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestCountPendingHoldsByOtherUsers(t *testing.T) {
+
+	test_book_id := uuid.New()
+	test_user_id := uuid.New()
+	query := regexp.QuoteMeta(`SELECT count(*) FROM "holds" WHERE book_id = $1 AND user_id <> $2 AND status = $3`)
+
+	tc := []struct {
+		name          string
+		mockFunction  func(mock sqlmock.Sqlmock)
+		expectedCount int64
+		expectedError error
+	}{
+		{
+			name: "Count pending holds successfully",
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(test_book_id, test_user_id, models.HoldStatusPending).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+			},
+			expectedCount: 2,
+			expectedError: nil,
+		},
+		{
+			name: "Count pending holds with error",
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(test_book_id, test_user_id, models.HoldStatusPending).
+					WillReturnError(sqlmock.ErrCancelled)
+			},
+			expectedCount: 0,
+			expectedError: sqlmock.ErrCancelled,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, loanRepository := createLoanRepository()
+			tt.mockFunction(mock)
+			count, err := loanRepository.CountPendingHoldsByOtherUsers(test_book_id, test_user_id)
+			if err != tt.expectedError {
+				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
+			}
+			if count != tt.expectedCount {
+				t.Errorf("Expected count: %d, got: %d", tt.expectedCount, count)
+			}
+		})
+	}
+}
+
+func TestFindReadyHoldByItemIdForUpdate(t *testing.T) {
+
+	test_id := uuid.New()
+	test_item_id := uuid.New()
+	query := regexp.QuoteMeta(`SELECT * FROM "holds" WHERE item_id = $1 AND status = $2 LIMIT 1 FOR UPDATE`)
+
+	tc := []struct {
+		name          string
+		mockFunction  func(mock sqlmock.Sqlmock)
+		expectedHold  bool
+		expectedError error
+	}{
+		{
+			name: "Find ready hold successfully",
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(test_item_id, models.HoldStatusReady).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "item_id", "status"}).
+						AddRow(test_id.String(), test_item_id.String(), models.HoldStatusReady))
+			},
+			expectedHold:  true,
+			expectedError: nil,
+		},
+		{
+			name: "Find ready hold with no hold",
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(test_item_id, models.HoldStatusReady).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "item_id", "status"}))
+			},
+			expectedHold:  false,
+			expectedError: nil,
+		},
+		{
+			name: "Find ready hold with error",
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(test_item_id, models.HoldStatusReady).
+					WillReturnError(sqlmock.ErrCancelled)
+			},
+			expectedHold:  false,
+			expectedError: sqlmock.ErrCancelled,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, loanRepository := createLoanRepository()
+			tt.mockFunction(mock)
+			hold, err := loanRepository.FindReadyHoldByItemIdForUpdate(test_item_id)
+			if err != tt.expectedError {
+				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
+			}
+			if (hold != nil) != tt.expectedHold {
+				t.Errorf("Expected hold found: %v, got: %v", tt.expectedHold, hold)
+			}
+		})
+	}
+}
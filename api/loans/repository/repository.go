@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	fineRepository "github.com/minand-mohan/library-app-api/api/fines/repository"
+	holdRepository "github.com/minand-mohan/library-app-api/api/holds/repository"
+	"github.com/minand-mohan/library-app-api/database"
+	"github.com/minand-mohan/library-app-api/database/models"
+	"gorm.io/gorm"
+)
+
+type LoanRepository interface {
+	// WithTransaction runs fn against a repository bound to a single
+	// database transaction. The transaction is rolled back if fn returns an
+	// error and committed otherwise.
+	WithTransaction(fn func(repo LoanRepository) error) error
+	// Fines returns the fines repository on the same database handle, so
+	// that inside WithTransaction it joins the loan's transaction
+	Fines() fineRepository.FineRepository
+	// Holds does the same for the holds repository
+	Holds() holdRepository.HoldRepository
+	CreateLoan(loanObj *models.Loan) error
+	FindByLoanIdForUpdate(id uuid.UUID) (*models.Loan, error)
+	FindActiveLoanByItemId(itemId uuid.UUID) (*models.Loan, error)
+	FindItemByBarcodeForUpdate(barcode string) (*models.Item, error)
+	FindItemById(id uuid.UUID) (*models.Item, error)
+	CountPendingHoldsByOtherUsers(bookId uuid.UUID, userId uuid.UUID) (int64, error)
+	FindReadyHoldByItemIdForUpdate(itemId uuid.UUID) (*models.Hold, error)
+	UpdateByLoanId(id uuid.UUID, loan *models.Loan) (*models.Loan, error)
+}
+
+type LoanRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewLoanRepository(db *gorm.DB) LoanRepository {
+	return &LoanRepositoryImpl{db}
+}
+
+// errors are translated as described at database.Transaction
+func (repo *LoanRepositoryImpl) WithTransaction(fn func(repo LoanRepository) error) error {
+	return database.Transaction(repo.db, func(tx *gorm.DB) error {
+		return fn(&LoanRepositoryImpl{tx})
+	})
+}
+
+func (repo *LoanRepositoryImpl) Fines() fineRepository.FineRepository {
+	return fineRepository.NewFineRepository(repo.db)
+}
+
+func (repo *LoanRepositoryImpl) Holds() holdRepository.HoldRepository {
+	return holdRepository.NewHoldRepository(repo.db)
+}
@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/database/models"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+var loanColumns = []string{"id", "user_id", "item_id", "checked_out_at", "due_at", "returned_at", "renewal_count"}
+
+func createLoanRepository() (sqlmock.Sqlmock, LoanRepository) {
+	var (
+		db   *sql.DB
+		mock sqlmock.Sqlmock
+	)
+
+	db, mock, _ = sqlmock.New()
+	dialector := postgres.New(postgres.Config{
+		DSN:                  "sqlmock_db_0",
+		DriverName:           "postgres",
+		Conn:                 db,
+		PreferSimpleProtocol: true,
+	})
+	sDb, _ := gorm.Open(dialector, &gorm.Config{})
+
+	loanRepository := NewLoanRepository(sDb)
+
+	return mock, loanRepository
+}
+
+func generateRandomLoan01() models.Loan {
+	//initialize variables
+	test_checked_out_at := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	test_due_at := test_checked_out_at.AddDate(0, 0, 14)
+	test_renewal_count := 0
+	test_id := uuid.New()
+	test_user_id := uuid.New()
+	test_item_id := uuid.New()
+	return models.Loan{
+		ID:           &test_id,
+		UserID:       &test_user_id,
+		ItemID:       &test_item_id,
+		CheckedOutAt: &test_checked_out_at,
+		DueAt:        &test_due_at,
+		RenewalCount: &test_renewal_count,
+	}
+}
+
+func TestWithTransaction(t *testing.T) {
+	loan := generateRandomLoan01()
+	fnError := errors.New("item not available")
+
+	tc := []struct {
+		name          string
+		fn            func(repo LoanRepository) error
+		mockFunction  func(mock sqlmock.Sqlmock)
+		expectedError error
+	}{
+		{
+			name: "Transaction committed",
+			fn: func(repo LoanRepository) error {
+				// the holds repository runs in the loan transaction
+				return repo.Holds().UpdateItemStatus(*loan.ItemID, models.ItemStatusOnLoan)
+			},
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(`UPDATE "items" SET "status"=$1 WHERE id = $2`)).
+					WithArgs(models.ItemStatusOnLoan, *loan.ItemID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Transaction rolled back",
+			fn: func(repo LoanRepository) error {
+				return fnError
+			},
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectRollback()
+			},
+			expectedError: fnError,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, loanRepository := createLoanRepository()
+			tt.mockFunction(mock)
+			err := loanRepository.WithTransaction(tt.fn)
+			if err != tt.expectedError {
+				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Unmet expectations: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/database/dberrors"
+	"github.com/minand-mohan/library-app-api/database/models"
+)
+
+// Update/Partial update a loan by id
+func (repo *LoanRepositoryImpl) UpdateByLoanId(id uuid.UUID, loan *models.Loan) (*models.Loan, error) {
+	result := repo.db.Model(&loan).Where("id = ?", id).Updates(loan)
+	if result.Error != nil {
+		return nil, dberrors.Translate(result.Error)
+	}
+	return loan, nil
+}
@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/database/models"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+func TestUpdateLoan(t *testing.T) {
+	//initialize variables
+	test_returned_at := time.Now().UTC()
+
+	tc := []struct {
+		name          string
+		loan          *models.Loan
+		id            uuid.UUID
+		mockFunction  func(mock sqlmock.Sqlmock, id uuid.UUID, loan *models.Loan) error
+		expectedError error
+	}{
+		{
+			name: "Loan updated successfully",
+			loan: &models.Loan{
+				ReturnedAt: &test_returned_at,
+			},
+			id: uuid.New(),
+			mockFunction: func(mock sqlmock.Sqlmock, id uuid.UUID, loan *models.Loan) error {
+				query := regexp.QuoteMeta(`UPDATE "loans" SET "returned_at"=$1 WHERE id = $2`)
+				mock.ExpectBegin()
+				mock.ExpectExec(query).
+					WithArgs(*loan.ReturnedAt, id).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+				return nil
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Loan update failed",
+			loan: &models.Loan{
+				ReturnedAt: &test_returned_at,
+			},
+			id: uuid.New(),
+			mockFunction: func(mock sqlmock.Sqlmock, id uuid.UUID, loan *models.Loan) error {
+				err := sqlmock.ErrCancelled
+				query := regexp.QuoteMeta(`UPDATE "loans" SET "returned_at"=$1 WHERE id = $2`)
+				mock.ExpectBegin()
+				mock.ExpectExec(query).
+					WithArgs(*loan.ReturnedAt, id).
+					WillReturnError(err)
+				mock.ExpectRollback()
+				return err
+			},
+			expectedError: sqlmock.ErrCancelled,
+		},
+	}
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, loanRepository := createLoanRepository()
+			tt.mockFunction(mock, tt.id, tt.loan)
+			_, err := loanRepository.UpdateByLoanId(tt.id, tt.loan)
+			if err != tt.expectedError {
+				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/api/loans/dto"
+	"github.com/minand-mohan/library-app-api/api/loans/repository"
+	"github.com/minand-mohan/library-app-api/api/response"
+	"github.com/minand-mohan/library-app-api/database/dberrors"
+	"github.com/minand-mohan/library-app-api/database/models"
+)
+
+func (service *LoanServiceImpl) CheckoutItem(ctx context.Context, loanReq *dto.LoanRequestBody) (*response.HTTPResponse, error) {
+	service.logger.Info("Loan Service: Checkout item")
+	userId, _ := uuid.Parse(loanReq.UserID)
+	_, err := service.userRepo.FindByUserId(ctx, userId)
+	if err != nil {
+		service.logger.Error(fmt.Sprintf("LoanService: Error while finding user by id: %s", err))
+		return response.GetRepositoryErrorHTTPResponseBody(err, "User not found."), err
+	}
+	balance, err := service.repo.Fines().SumByUserId(ctx, userId)
+	if err != nil {
+		service.logger.Error(fmt.Sprintf("LoanService: Error while summing fines: %s", err))
+		return response.GetRepositoryErrorHTTPResponseBody(err, "User not found."), err
+	}
+	if balance > int64(service.config.FineBlockThresholdCents) {
+		service.logger.Error(fmt.Sprintf("LoanService: User %s owes %d in fines", userId, balance))
+		responseBody := response.HTTPResponse{
+			Code:    400,
+			Message: "Bad request, outstanding fines exceed limit",
+			Content: map[string]interface{}{
+				"balance_cents":   balance,
+				"threshold_cents": service.config.FineBlockThresholdCents,
+			},
+		}
+		return &responseBody, errors.New("outstanding fines exceed limit")
+	}
+
+	var responseBody *response.HTTPResponse
+	loanObj := &models.Loan{}
+	err = service.repo.WithTransaction(func(repo repository.LoanRepository) error {
+		item, err := repo.FindItemByBarcodeForUpdate(loanReq.Barcode)
+		if err != nil {
+			service.logger.Error(fmt.Sprintf("LoanService: Error while finding item by barcode: %s", err))
+			responseBody = response.GetRepositoryErrorHTTPResponseBody(err, "Item not found.")
+			return err
+		}
+		available := item.Status != nil && *item.Status == models.ItemStatusAvailable
+		var hold *models.Hold
+		if item.Status != nil && *item.Status == models.ItemStatusOnHold {
+			// a copy set aside for a hold can only go to the user who placed it
+			hold, err = repo.FindReadyHoldByItemIdForUpdate(*item.ID)
+			if err != nil {
+				return err
+			}
+			available = hold != nil && *hold.UserID == userId
+		}
+		if !available {
+			service.logger.Error(fmt.Sprintf("LoanService: Item with barcode %s is not available", loanReq.Barcode))
+			responseBody = &response.HTTPResponse{
+				Code:    400,
+				Message: "Bad request, item not available",
+				Content: map[string]interface{}{},
+			}
+			return errors.New("item not available")
+		}
+		_, err = repo.FindActiveLoanByItemId(*item.ID)
+		if err == nil {
+			service.logger.Error(fmt.Sprintf("LoanService: Item with barcode %s is already on loan", loanReq.Barcode))
+			responseBody = &response.HTTPResponse{
+				Code:    400,
+				Message: "Bad request, item already on loan",
+				Content: map[string]interface{}{},
+			}
+			return errors.New("item already on loan")
+		}
+		if !errors.Is(err, dberrors.ErrNotFound) {
+			return err
+		}
+
+		checkedOutAt := time.Now().UTC()
+		dueAt := service.calculateDueDate(checkedOutAt)
+		renewalCount := 0
+		loanObj.UserID = &userId
+		loanObj.ItemID = item.ID
+		loanObj.CheckedOutAt = &checkedOutAt
+		loanObj.DueAt = &dueAt
+		loanObj.RenewalCount = &renewalCount
+		err = repo.CreateLoan(loanObj)
+		if err != nil {
+			return err
+		}
+		if hold != nil {
+			status := models.HoldStatusFulfilled
+			_, err = repo.Holds().UpdateByHoldId(*hold.ID, &models.Hold{Status: &status, ClosedAt: &checkedOutAt})
+			if err != nil {
+				return err
+			}
+		}
+		return repo.Holds().UpdateItemStatus(*item.ID, models.ItemStatusOnLoan)
+	})
+	if err != nil {
+		if responseBody != nil {
+			return responseBody, err
+		}
+		service.logger.Error(fmt.Sprintf("LoanService: Error while checking out item: %s", err))
+		return response.GetRepositoryErrorHTTPResponseBody(err, "Item not found."), err
+	}
+
+	responseBody = &response.HTTPResponse{
+		Code:    200,
+		Message: "Item checked out successfully",
+		Content: loanResponseContent(loanObj),
+	}
+	return responseBody, nil
+}
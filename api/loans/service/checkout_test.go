@@ -0,0 +1,253 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/minand-mohan/library-app-api/api/loans/dto"
+	repomocks "github.com/minand-mohan/library-app-api/api/loans/repository/mocks"
+	"github.com/minand-mohan/library-app-api/api/response"
+	userrepomocks "github.com/minand-mohan/library-app-api/api/users/repository/mocks"
+	"github.com/minand-mohan/library-app-api/database/dberrors"
+	"github.com/minand-mohan/library-app-api/database/models"
+	"github.com/minand-mohan/library-app-api/utils"
+)
+
+func TestCheckoutItem(t *testing.T) {
+
+	internalServerError := errors.New("Internal Server Error")
+	recordNotFoundError := dberrors.ErrNotFound
+
+	test_user := generateRandomUser01()
+	available_item := generateRandomItem01()
+	on_loan_item := generateRandomItem01()
+	on_loan_status := models.ItemStatusOnLoan
+	on_loan_item.Status = &on_loan_status
+	active_loan := generateRandomLoan01()
+	on_hold_item := generateRandomItem01()
+	on_hold_status := models.ItemStatusOnHold
+	on_hold_item.Status = &on_hold_status
+	own_hold := generateRandomHold01()
+	own_hold.UserID = test_user.ID
+	other_hold := generateRandomHold01()
+
+	test_cases_that_require_transaction := map[string]bool{
+		"Checkout Item sucessfully":         true,
+		"Checkout missing item":             true,
+		"Checkout item not available":       true,
+		"Checkout item with active loan":    true,
+		"Checkout Item with service error":  true,
+		"Checkout item held for user":       true,
+		"Checkout item held for other user": true,
+	}
+	test_cases_that_require_find_hold := map[string]bool{
+		"Checkout item held for user":       true,
+		"Checkout item held for other user": true,
+	}
+	test_cases_that_require_find_loan := map[string]bool{
+		"Checkout Item sucessfully":        true,
+		"Checkout item with active loan":   true,
+		"Checkout Item with service error": true,
+		"Checkout item held for user":      true,
+	}
+	test_cases_that_require_create_loan := map[string]bool{
+		"Checkout Item sucessfully":        true,
+		"Checkout Item with service error": true,
+		"Checkout item held for user":      true,
+	}
+
+	tc := []struct {
+		name                string
+		expectedResponse    *response.HTTPResponse
+		expectedError       error
+		mockFindUserReturn  *models.User
+		mockFindUserError   error
+		mockFindItemReturn  *models.Item
+		mockFindItemError   error
+		mockFindHoldReturn  *models.Hold
+		mockFindLoanReturn  *models.Loan
+		mockFindLoanError   error
+		mockCreateLoanError error
+		mockFineBalance     int64
+		mockSumFinesError   error
+	}{
+		{
+			name: "Checkout Item sucessfully",
+			expectedResponse: &response.HTTPResponse{
+				Code:    200,
+				Message: "Item checked out successfully",
+			},
+			expectedError:       nil,
+			mockFindUserReturn:  &test_user,
+			mockFindItemReturn:  &available_item,
+			mockFindLoanError:   recordNotFoundError,
+			mockCreateLoanError: nil,
+		},
+		{
+			name: "Checkout for missing user",
+			expectedResponse: &response.HTTPResponse{
+				Code:    404,
+				Message: "User not found.",
+			},
+			expectedError:     recordNotFoundError,
+			mockFindUserError: recordNotFoundError,
+		},
+		{
+			name: "Checkout missing item",
+			expectedResponse: &response.HTTPResponse{
+				Code:    404,
+				Message: "Item not found.",
+			},
+			expectedError:      recordNotFoundError,
+			mockFindUserReturn: &test_user,
+			mockFindItemError:  recordNotFoundError,
+		},
+		{
+			name: "Checkout item not available",
+			expectedResponse: &response.HTTPResponse{
+				Code:    400,
+				Message: "Bad request, item not available",
+			},
+			expectedError:      errors.New("item not available"),
+			mockFindUserReturn: &test_user,
+			mockFindItemReturn: &on_loan_item,
+		},
+		{
+			name: "Checkout item with active loan",
+			expectedResponse: &response.HTTPResponse{
+				Code:    400,
+				Message: "Bad request, item already on loan",
+			},
+			expectedError:      errors.New("item already on loan"),
+			mockFindUserReturn: &test_user,
+			mockFindItemReturn: &available_item,
+			mockFindLoanReturn: &active_loan,
+		},
+		{
+			name: "Checkout Item with service error",
+			expectedResponse: &response.HTTPResponse{
+				Code:    500,
+				Message: "Internal Server Error",
+			},
+			expectedError:       internalServerError,
+			mockFindUserReturn:  &test_user,
+			mockFindItemReturn:  &available_item,
+			mockFindLoanError:   recordNotFoundError,
+			mockCreateLoanError: internalServerError,
+		},
+		{
+			name: "Checkout item held for user",
+			expectedResponse: &response.HTTPResponse{
+				Code:    200,
+				Message: "Item checked out successfully",
+			},
+			expectedError:      nil,
+			mockFindUserReturn: &test_user,
+			mockFindItemReturn: &on_hold_item,
+			mockFindHoldReturn: &own_hold,
+			mockFindLoanError:  recordNotFoundError,
+		},
+		{
+			name: "Checkout item held for other user",
+			expectedResponse: &response.HTTPResponse{
+				Code:    400,
+				Message: "Bad request, item not available",
+			},
+			expectedError:      errors.New("item not available"),
+			mockFindUserReturn: &test_user,
+			mockFindItemReturn: &on_hold_item,
+			mockFindHoldReturn: &other_hold,
+		},
+		{
+			name: "Checkout with outstanding fines",
+			expectedResponse: &response.HTTPResponse{
+				Code:    400,
+				Message: "Bad request, outstanding fines exceed limit",
+			},
+			expectedError:      errors.New("outstanding fines exceed limit"),
+			mockFindUserReturn: &test_user,
+			mockFineBalance:    750,
+		},
+		{
+			name: "Checkout with fines error",
+			expectedResponse: &response.HTTPResponse{
+				Code:    500,
+				Message: "Internal Server Error",
+			},
+			expectedError:      internalServerError,
+			mockFindUserReturn: &test_user,
+			mockSumFinesError:  internalServerError,
+		},
+	}
+
+	for _, tt := range tc {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+
+		t.Run(tt.name, func(t *testing.T) {
+			requestBody := &dto.LoanRequestBody{
+				UserID:  test_user.ID.String(),
+				Barcode: *available_item.Barcode,
+			}
+			mockRepo := repomocks.NewMockLoanRepository(mockCtrl)
+			mockFineRepo := expectFines(mockCtrl, mockRepo)
+			mockHoldRepo := expectHolds(mockCtrl, mockRepo)
+			mockUserRepo := userrepomocks.NewMockUserRepository(mockCtrl)
+			mockUserRepo.EXPECT().FindByUserId(gomock.Any(), *test_user.ID).Return(tt.mockFindUserReturn, tt.mockFindUserError)
+			if tt.mockFindUserError == nil {
+				mockFineRepo.EXPECT().SumByUserId(gomock.Any(), *test_user.ID).Return(tt.mockFineBalance, tt.mockSumFinesError)
+			}
+			if test_cases_that_require_transaction[tt.name] {
+				expectTransaction(mockRepo)
+				mockRepo.EXPECT().FindItemByBarcodeForUpdate(requestBody.Barcode).Return(tt.mockFindItemReturn, tt.mockFindItemError)
+			}
+			if test_cases_that_require_find_hold[tt.name] {
+				mockRepo.EXPECT().FindReadyHoldByItemIdForUpdate(*on_hold_item.ID).Return(tt.mockFindHoldReturn, nil)
+			}
+			if test_cases_that_require_find_loan[tt.name] {
+				mockRepo.EXPECT().FindActiveLoanByItemId(*tt.mockFindItemReturn.ID).Return(tt.mockFindLoanReturn, tt.mockFindLoanError)
+			}
+			if test_cases_that_require_create_loan[tt.name] {
+				mockRepo.EXPECT().CreateLoan(gomock.Any()).Return(tt.mockCreateLoanError)
+			}
+			if tt.name == "Checkout Item sucessfully" {
+				mockHoldRepo.EXPECT().UpdateItemStatus(*available_item.ID, models.ItemStatusOnLoan).Return(nil)
+			}
+			if tt.name == "Checkout item held for user" {
+				mockHoldRepo.EXPECT().UpdateByHoldId(*own_hold.ID, gomock.Any()).DoAndReturn(func(id interface{}, hold *models.Hold) (*models.Hold, error) {
+					if *hold.Status != models.HoldStatusFulfilled {
+						t.Errorf("Expected hold status %s, got %s", models.HoldStatusFulfilled, *hold.Status)
+					}
+					return hold, nil
+				})
+				mockHoldRepo.EXPECT().UpdateItemStatus(*on_hold_item.ID, models.ItemStatusOnLoan).Return(nil)
+			}
+
+			service := NewLoanService(mockRepo, mockUserRepo, testCirculationConfig, *utils.NewLogger())
+			response, err := service.CheckoutItem(context.Background(), requestBody)
+
+			if err != nil && tt.expectedError != nil {
+				if err.Error() != tt.expectedError.Error() {
+					t.Errorf("Expected error %v, got %v", tt.expectedError, err)
+				}
+			}
+			if response.Code != tt.expectedResponse.Code {
+				t.Errorf("Expected code %d, got %d", tt.expectedResponse.Code, response.Code)
+			}
+			if response.Message != tt.expectedResponse.Message {
+				t.Errorf("Expected message %s, got %s", tt.expectedResponse.Message, response.Message)
+			}
+		})
+	}
+}
+
+func TestCalculateDueDate(t *testing.T) {
+	service := &LoanServiceImpl{config: testCirculationConfig}
+	checkedOutAt := generateRandomLoan01().CheckedOutAt
+	dueAt := service.calculateDueDate(*checkedOutAt)
+	if dueAt.Sub(*checkedOutAt).Hours() != 14*24 {
+		t.Errorf("Expected due date 14 days after %v, got %v", checkedOutAt, dueAt)
+	}
+}
@@ -0,0 +1,79 @@
+package mocks
+
+import (
+	"context"
+	"reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/api/loans/dto"
+	"github.com/minand-mohan/library-app-api/api/response"
+)
+
+// MockLoanService is a mock of LoanService interface.
+type MockLoanService struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoanServiceMockRecorder
+}
+
+// MockLoanServiceMockRecorder is the mock recorder for MockLoanService.
+type MockLoanServiceMockRecorder struct {
+	mock *MockLoanService
+}
+
+// NewMockLoanService creates a new mock instance.
+func NewMockLoanService(ctrl *gomock.Controller) *MockLoanService {
+	mock := &MockLoanService{ctrl: ctrl}
+	mock.recorder = &MockLoanServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoanService) EXPECT() *MockLoanServiceMockRecorder {
+	return m.recorder
+}
+
+// CheckoutItem mocks base method.
+func (m *MockLoanService) CheckoutItem(arg0 context.Context, arg1 *dto.LoanRequestBody) (*response.HTTPResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckoutItem", arg0, arg1)
+	ret0, _ := ret[0].(*response.HTTPResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckoutItem indicates an expected call of CheckoutItem.
+func (mr *MockLoanServiceMockRecorder) CheckoutItem(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckoutItem", reflect.TypeOf((*MockLoanService)(nil).CheckoutItem), arg0, arg1)
+}
+
+// ReturnByLoanId mocks base method.
+func (m *MockLoanService) ReturnByLoanId(arg0 context.Context, arg1 uuid.UUID) (*response.HTTPResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReturnByLoanId", arg0, arg1)
+	ret0, _ := ret[0].(*response.HTTPResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReturnByLoanId indicates an expected call of ReturnByLoanId.
+func (mr *MockLoanServiceMockRecorder) ReturnByLoanId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReturnByLoanId", reflect.TypeOf((*MockLoanService)(nil).ReturnByLoanId), arg0, arg1)
+}
+
+// RenewByLoanId mocks base method.
+func (m *MockLoanService) RenewByLoanId(arg0 uuid.UUID) (*response.HTTPResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenewByLoanId", arg0)
+	ret0, _ := ret[0].(*response.HTTPResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenewByLoanId indicates an expected call of RenewByLoanId.
+func (mr *MockLoanServiceMockRecorder) RenewByLoanId(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenewByLoanId", reflect.TypeOf((*MockLoanService)(nil).RenewByLoanId), arg0)
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/api/loans/repository"
+	"github.com/minand-mohan/library-app-api/api/response"
+	"github.com/minand-mohan/library-app-api/database/models"
+)
+
+// Reasons reported when a renewal is refused
+const (
+	RenewalRefusedLoanReturned = "loan_returned"
+	RenewalRefusedLimitReached = "renewal_limit_reached"
+	RenewalRefusedHoldPending  = "hold_pending"
+)
+
+// renewalRefused builds the response for a refused renewal, carrying a
+// machine readable reason alongside the loan
+func renewalRefused(loan *models.Loan, reason string, message string) *response.HTTPResponse {
+	content := loanResponseContent(loan)
+	content["reason"] = reason
+	return &response.HTTPResponse{
+		Code:    400,
+		Message: fmt.Sprintf("Bad request, renewal refused: %s", message),
+		Content: content,
+	}
+}
+
+func (service *LoanServiceImpl) RenewByLoanId(id uuid.UUID) (*response.HTTPResponse, error) {
+	service.logger.Info("Loan Service: Renew loan by id")
+
+	var responseBody *response.HTTPResponse
+	var loan *models.Loan
+	err := service.repo.WithTransaction(func(repo repository.LoanRepository) error {
+		var err error
+		loan, err = repo.FindByLoanIdForUpdate(id)
+		if err != nil {
+			service.logger.Error(fmt.Sprintf("LoanService: Error while finding loan by id: %s", err))
+			responseBody = response.GetRepositoryErrorHTTPResponseBody(err, "Loan not found.")
+			return err
+		}
+		if loan.ReturnedAt != nil {
+			service.logger.Error(fmt.Sprintf("LoanService: Loan %s is already returned", id))
+			responseBody = renewalRefused(loan, RenewalRefusedLoanReturned, "loan already returned")
+			return errors.New("loan already returned")
+		}
+		renewalCount := 0
+		if loan.RenewalCount != nil {
+			renewalCount = *loan.RenewalCount
+		}
+		if renewalCount >= service.config.MaxRenewals {
+			service.logger.Error(fmt.Sprintf("LoanService: Loan %s reached the renewal limit", id))
+			responseBody = renewalRefused(loan, RenewalRefusedLimitReached, "renewal limit reached")
+			return errors.New("renewal limit reached")
+		}
+
+		item, err := repo.FindItemById(*loan.ItemID)
+		if err != nil {
+			return err
+		}
+		holds, err := repo.CountPendingHoldsByOtherUsers(*item.BookID, *loan.UserID)
+		if err != nil {
+			return err
+		}
+		if holds > 0 {
+			service.logger.Error(fmt.Sprintf("LoanService: Loan %s has outstanding holds on its title", id))
+			responseBody = renewalRefused(loan, RenewalRefusedHoldPending, "title has outstanding holds")
+			return errors.New("title has outstanding holds")
+		}
+
+		dueAt := service.calculateDueDate(*loan.DueAt)
+		renewalCount++
+		_, err = repo.UpdateByLoanId(id, &models.Loan{DueAt: &dueAt, RenewalCount: &renewalCount})
+		if err != nil {
+			return err
+		}
+		loan.DueAt = &dueAt
+		loan.RenewalCount = &renewalCount
+		return nil
+	})
+	if err != nil {
+		if responseBody != nil {
+			return responseBody, err
+		}
+		service.logger.Error(fmt.Sprintf("LoanService: Error while renewing loan: %s", err))
+		return response.GetRepositoryErrorHTTPResponseBody(err, "Loan not found."), err
+	}
+
+	responseBody = &response.HTTPResponse{
+		Code:    200,
+		Message: "Loan renewed successfully",
+		Content: loanResponseContent(loan),
+	}
+	return responseBody, nil
+}
@@ -0,0 +1,188 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	repomocks "github.com/minand-mohan/library-app-api/api/loans/repository/mocks"
+	"github.com/minand-mohan/library-app-api/api/response"
+	userrepomocks "github.com/minand-mohan/library-app-api/api/users/repository/mocks"
+	"github.com/minand-mohan/library-app-api/database/dberrors"
+	"github.com/minand-mohan/library-app-api/database/models"
+	"github.com/minand-mohan/library-app-api/utils"
+)
+
+func TestRenewLoan(t *testing.T) {
+
+	internalServerError := errors.New("Internal Server Error")
+	recordNotFoundError := dberrors.ErrNotFound
+
+	item := generateRandomItem01()
+	active_loan := generateRandomLoan01()
+	active_loan.ItemID = item.ID
+	returned_loan := generateRandomLoan01()
+	returned_at := time.Now().UTC()
+	returned_loan.ReturnedAt = &returned_at
+	exhausted_loan := generateRandomLoan01()
+	max_renewals := testCirculationConfig.MaxRenewals
+	exhausted_loan.RenewalCount = &max_renewals
+
+	test_cases_that_require_hold_check := map[string]bool{
+		"Renew Loan sucessfully":            true,
+		"Renew Loan with error":             true,
+		"Renew Loan with outstanding holds": true,
+		"Renew Loan with hold lookup error": true,
+	}
+	test_cases_that_require_update_loan := map[string]bool{
+		"Renew Loan sucessfully": true,
+		"Renew Loan with error":  true,
+	}
+
+	tc := []struct {
+		name                string
+		loan                models.Loan
+		expectedResponse    *response.HTTPResponse
+		expectedReason      string
+		expectedError       error
+		mockFindLoanReturn  *models.Loan
+		mockFindLoanError   error
+		mockHoldCount       int64
+		mockHoldCountError  error
+		mockUpdateLoanError error
+	}{
+		{
+			name: "Renew Loan sucessfully",
+			loan: active_loan,
+			expectedResponse: &response.HTTPResponse{
+				Code:    200,
+				Message: "Loan renewed successfully",
+			},
+			expectedError:      nil,
+			mockFindLoanReturn: &active_loan,
+		},
+		{
+			name: "Renew Loan with error",
+			loan: active_loan,
+			expectedResponse: &response.HTTPResponse{
+				Code:    500,
+				Message: "Internal Server Error",
+			},
+			expectedError:       internalServerError,
+			mockFindLoanReturn:  &active_loan,
+			mockUpdateLoanError: internalServerError,
+		},
+		{
+			name: "Renew Loan with hold lookup error",
+			loan: active_loan,
+			expectedResponse: &response.HTTPResponse{
+				Code:    500,
+				Message: "Internal Server Error",
+			},
+			expectedError:      internalServerError,
+			mockFindLoanReturn: &active_loan,
+			mockHoldCountError: internalServerError,
+		},
+		{
+			name: "Renew Loan with outstanding holds",
+			loan: active_loan,
+			expectedResponse: &response.HTTPResponse{
+				Code:    400,
+				Message: "Bad request, renewal refused: title has outstanding holds",
+			},
+			expectedReason:     RenewalRefusedHoldPending,
+			expectedError:      errors.New("title has outstanding holds"),
+			mockFindLoanReturn: &active_loan,
+			mockHoldCount:      1,
+		},
+		{
+			name: "Renew Loan at renewal limit",
+			loan: exhausted_loan,
+			expectedResponse: &response.HTTPResponse{
+				Code:    400,
+				Message: "Bad request, renewal refused: renewal limit reached",
+			},
+			expectedReason:     RenewalRefusedLimitReached,
+			expectedError:      errors.New("renewal limit reached"),
+			mockFindLoanReturn: &exhausted_loan,
+		},
+		{
+			name: "Renew Loan already returned",
+			loan: returned_loan,
+			expectedResponse: &response.HTTPResponse{
+				Code:    400,
+				Message: "Bad request, renewal refused: loan already returned",
+			},
+			expectedReason:     RenewalRefusedLoanReturned,
+			expectedError:      errors.New("loan already returned"),
+			mockFindLoanReturn: &returned_loan,
+		},
+		{
+			name: "Cannot find loan",
+			loan: active_loan,
+			expectedResponse: &response.HTTPResponse{
+				Code:    404,
+				Message: "Loan not found.",
+			},
+			expectedError:     recordNotFoundError,
+			mockFindLoanError: recordNotFoundError,
+		},
+	}
+
+	for _, tt := range tc {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+
+		t.Run(tt.name, func(t *testing.T) {
+			loan := tt.loan
+			// the service updates the renewed loan in place, so hand it a copy
+			var foundLoan *models.Loan
+			if tt.mockFindLoanReturn != nil {
+				loanCopy := *tt.mockFindLoanReturn
+				foundLoan = &loanCopy
+			}
+			mockRepo := repomocks.NewMockLoanRepository(mockCtrl)
+			expectTransaction(mockRepo)
+			mockRepo.EXPECT().FindByLoanIdForUpdate(*loan.ID).Return(foundLoan, tt.mockFindLoanError)
+			if test_cases_that_require_hold_check[tt.name] {
+				mockRepo.EXPECT().FindItemById(*loan.ItemID).Return(&item, nil)
+				mockRepo.EXPECT().CountPendingHoldsByOtherUsers(*item.BookID, *loan.UserID).Return(tt.mockHoldCount, tt.mockHoldCountError)
+			}
+			if test_cases_that_require_update_loan[tt.name] {
+				mockRepo.EXPECT().UpdateByLoanId(*loan.ID, gomock.Any()).Return(nil, tt.mockUpdateLoanError)
+			}
+
+			service := NewLoanService(mockRepo, userrepomocks.NewMockUserRepository(mockCtrl), testCirculationConfig, *utils.NewLogger())
+			response, err := service.RenewByLoanId(*loan.ID)
+
+			if err != nil && tt.expectedError != nil {
+				if err.Error() != tt.expectedError.Error() {
+					t.Errorf("Expected error %v, got %v", tt.expectedError, err)
+				}
+			}
+			if response.Code != tt.expectedResponse.Code {
+				t.Errorf("Expected code %d, got %d", tt.expectedResponse.Code, response.Code)
+			}
+			if response.Message != tt.expectedResponse.Message {
+				t.Errorf("Expected message %s, got %s", tt.expectedResponse.Message, response.Message)
+			}
+			if tt.expectedReason != "" {
+				content := response.Content.(map[string]interface{})
+				if content["reason"] != tt.expectedReason {
+					t.Errorf("Expected reason %s, got %v", tt.expectedReason, content["reason"])
+				}
+			}
+			if tt.name == "Renew Loan sucessfully" {
+				expectedDueAt := loan.DueAt.AddDate(0, 0, testCirculationConfig.LoanPeriodDays)
+				content := response.Content.(map[string]interface{})
+				if !content["due_at"].(*time.Time).Equal(expectedDueAt) {
+					t.Errorf("Expected due date %v, got %v", expectedDueAt, content["due_at"])
+				}
+				if *content["renewal_count"].(*int) != 1 {
+					t.Errorf("Expected renewal count 1, got %v", *content["renewal_count"].(*int))
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	fineService "github.com/minand-mohan/library-app-api/api/fines/service"
+	holdService "github.com/minand-mohan/library-app-api/api/holds/service"
+	"github.com/minand-mohan/library-app-api/api/loans/repository"
+	"github.com/minand-mohan/library-app-api/api/response"
+	"github.com/minand-mohan/library-app-api/database/models"
+)
+
+func (service *LoanServiceImpl) ReturnByLoanId(ctx context.Context, id uuid.UUID) (*response.HTTPResponse, error) {
+	service.logger.Info("Loan Service: Return loan by id")
+
+	var responseBody *response.HTTPResponse
+	var loan *models.Loan
+	var assignedHold *models.Hold
+	var fine int64
+	err := service.repo.WithTransaction(func(repo repository.LoanRepository) error {
+		var err error
+		loan, err = repo.FindByLoanIdForUpdate(id)
+		if err != nil {
+			service.logger.Error(fmt.Sprintf("LoanService: Error while finding loan by id: %s", err))
+			responseBody = response.GetRepositoryErrorHTTPResponseBody(err, "Loan not found.")
+			return err
+		}
+		if loan.ReturnedAt != nil {
+			service.logger.Error(fmt.Sprintf("LoanService: Loan %s is already returned", id))
+			responseBody = &response.HTTPResponse{
+				Code:    400,
+				Message: "Bad request, loan already returned",
+				Content: loanResponseContent(loan),
+			}
+			return errors.New("loan already returned")
+		}
+
+		returnedAt := time.Now().UTC()
+		_, err = repo.UpdateByLoanId(id, &models.Loan{ReturnedAt: &returnedAt})
+		if err != nil {
+			return err
+		}
+		loan.ReturnedAt = &returnedAt
+		fine, _, err = fineService.SettleOverdueFine(ctx, repo.Fines(), service.config, loan, returnedAt)
+		if err != nil {
+			return err
+		}
+		item, err := repo.FindItemById(*loan.ItemID)
+		if err != nil {
+			return err
+		}
+		assignedHold, err = holdService.AssignItemToNextHold(repo.Holds(), service.config, *item.ID, *item.BookID, returnedAt)
+		return err
+	})
+	if err != nil {
+		if responseBody != nil {
+			return responseBody, err
+		}
+		service.logger.Error(fmt.Sprintf("LoanService: Error while returning loan: %s", err))
+		return response.GetRepositoryErrorHTTPResponseBody(err, "Loan not found."), err
+	}
+
+	responseContent := loanResponseContent(loan)
+	responseContent["overdue"] = loan.ReturnedAt.After(*loan.DueAt)
+	responseContent["fine_cents"] = fine
+	responseContent["assigned_hold_id"] = nil
+	if assignedHold != nil {
+		responseContent["assigned_hold_id"] = assignedHold.ID
+	}
+	responseBody = &response.HTTPResponse{
+		Code:    200,
+		Message: "Loan returned successfully",
+		Content: responseContent,
+	}
+	return responseBody, nil
+}
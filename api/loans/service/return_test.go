@@ -0,0 +1,198 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	repomocks "github.com/minand-mohan/library-app-api/api/loans/repository/mocks"
+	"github.com/minand-mohan/library-app-api/api/response"
+	userrepomocks "github.com/minand-mohan/library-app-api/api/users/repository/mocks"
+	"github.com/minand-mohan/library-app-api/database/dberrors"
+	"github.com/minand-mohan/library-app-api/database/models"
+	"github.com/minand-mohan/library-app-api/utils"
+)
+
+func TestReturnLoan(t *testing.T) {
+
+	internalServerError := errors.New("Internal Server Error")
+	recordNotFoundError := dberrors.ErrNotFound
+
+	item := generateRandomItem01()
+	active_loan := generateRandomLoan01()
+	active_loan.ItemID = item.ID
+	next_hold := generateRandomHold01()
+	next_hold.BookID = item.BookID
+	// checked out 20 days ago, so five days late with one day of grace
+	overdue_loan := generateRandomLoan01()
+	overdue_loan.ItemID = item.ID
+	overdue_checked_out_at := time.Now().UTC().AddDate(0, 0, -19).Add(-time.Hour)
+	overdue_due_at := overdue_checked_out_at.AddDate(0, 0, 14)
+	overdue_loan.CheckedOutAt = &overdue_checked_out_at
+	overdue_loan.DueAt = &overdue_due_at
+	returned_loan := generateRandomLoan01()
+	returned_at := time.Now().UTC()
+	returned_loan.ReturnedAt = &returned_at
+
+	test_cases_that_require_update_loan := map[string]bool{
+		"Return Loan sucessfully":               true,
+		"Return Loan with error":                true,
+		"Return Loan assigns copy to next hold": true,
+		"Return overdue Loan posts fine":        true,
+	}
+
+	tc := []struct {
+		name                string
+		loan                models.Loan
+		expectedResponse    *response.HTTPResponse
+		expectedError       error
+		mockFindLoanReturn  *models.Loan
+		mockFindLoanError   error
+		mockUpdateLoanError error
+		mockNextHoldReturn  *models.Hold
+		expectedItemStatus  string
+		mockAccruedFine     int64
+		expectedFine        int64
+	}{
+		{
+			name: "Return Loan sucessfully",
+			loan: active_loan,
+			expectedResponse: &response.HTTPResponse{
+				Code:    200,
+				Message: "Loan returned successfully",
+			},
+			expectedError:      nil,
+			mockFindLoanReturn: &active_loan,
+			expectedItemStatus: models.ItemStatusAvailable,
+		},
+		{
+			name: "Return Loan assigns copy to next hold",
+			loan: active_loan,
+			expectedResponse: &response.HTTPResponse{
+				Code:    200,
+				Message: "Loan returned successfully",
+			},
+			expectedError:      nil,
+			mockFindLoanReturn: &active_loan,
+			mockNextHoldReturn: &next_hold,
+			expectedItemStatus: models.ItemStatusOnHold,
+		},
+		{
+			name: "Return overdue Loan posts fine",
+			loan: overdue_loan,
+			expectedResponse: &response.HTTPResponse{
+				Code:    200,
+				Message: "Loan returned successfully",
+			},
+			expectedError:      nil,
+			mockFindLoanReturn: &overdue_loan,
+			expectedItemStatus: models.ItemStatusAvailable,
+			mockAccruedFine:    75,
+			expectedFine:       100,
+		},
+		{
+			name: "Return Loan with error",
+			loan: active_loan,
+			expectedResponse: &response.HTTPResponse{
+				Code:    500,
+				Message: "Internal Server Error",
+			},
+			expectedError:       internalServerError,
+			mockFindLoanReturn:  &active_loan,
+			mockUpdateLoanError: internalServerError,
+		},
+		{
+			name: "Return Loan already returned",
+			loan: returned_loan,
+			expectedResponse: &response.HTTPResponse{
+				Code:    400,
+				Message: "Bad request, loan already returned",
+			},
+			expectedError:      errors.New("loan already returned"),
+			mockFindLoanReturn: &returned_loan,
+		},
+		{
+			name: "Cannot find loan",
+			loan: active_loan,
+			expectedResponse: &response.HTTPResponse{
+				Code:    404,
+				Message: "Loan not found.",
+			},
+			expectedError:     recordNotFoundError,
+			mockFindLoanError: recordNotFoundError,
+		},
+	}
+
+	for _, tt := range tc {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+
+		t.Run(tt.name, func(t *testing.T) {
+			loan := tt.loan
+			// the service updates the returned loan in place, so hand it a copy
+			var foundLoan *models.Loan
+			if tt.mockFindLoanReturn != nil {
+				loanCopy := *tt.mockFindLoanReturn
+				foundLoan = &loanCopy
+			}
+			mockRepo := repomocks.NewMockLoanRepository(mockCtrl)
+			expectTransaction(mockRepo)
+			mockFineRepo := expectFines(mockCtrl, mockRepo)
+			mockHoldRepo := expectHolds(mockCtrl, mockRepo)
+			mockRepo.EXPECT().FindByLoanIdForUpdate(*loan.ID).Return(foundLoan, tt.mockFindLoanError)
+			if test_cases_that_require_update_loan[tt.name] {
+				mockRepo.EXPECT().UpdateByLoanId(*loan.ID, gomock.Any()).Return(nil, tt.mockUpdateLoanError)
+			}
+			if tt.expectedFine != 0 {
+				mockFineRepo.EXPECT().SumOverdueByLoanId(gomock.Any(), *loan.ID).Return(tt.mockAccruedFine, nil)
+				mockFineRepo.EXPECT().CreateFineEntry(gomock.Any()).DoAndReturn(func(entry *models.FineEntry) error {
+					if *entry.AmountCents != tt.expectedFine-tt.mockAccruedFine {
+						t.Errorf("Expected fine entry of %d, got %d", tt.expectedFine-tt.mockAccruedFine, *entry.AmountCents)
+					}
+					return nil
+				})
+			}
+			if tt.expectedItemStatus != "" {
+				mockRepo.EXPECT().FindItemById(*loan.ItemID).Return(&item, nil)
+				// the service updates the assigned hold in place, so hand it a copy
+				var nextHold *models.Hold
+				if tt.mockNextHoldReturn != nil {
+					holdCopy := *tt.mockNextHoldReturn
+					nextHold = &holdCopy
+					mockHoldRepo.EXPECT().UpdateByHoldId(*next_hold.ID, gomock.Any()).Return(nil, nil)
+				}
+				mockHoldRepo.EXPECT().FindNextPendingHoldForUpdate(*item.BookID).Return(nextHold, nil)
+				mockHoldRepo.EXPECT().UpdateItemStatus(*loan.ItemID, tt.expectedItemStatus).Return(nil)
+			}
+
+			service := NewLoanService(mockRepo, userrepomocks.NewMockUserRepository(mockCtrl), testCirculationConfig, *utils.NewLogger())
+			response, err := service.ReturnByLoanId(context.Background(), *loan.ID)
+
+			if err != nil && tt.expectedError != nil {
+				if err.Error() != tt.expectedError.Error() {
+					t.Errorf("Expected error %v, got %v", tt.expectedError, err)
+				}
+			}
+			if response.Code != tt.expectedResponse.Code {
+				t.Errorf("Expected code %d, got %d", tt.expectedResponse.Code, response.Code)
+			}
+			if response.Message != tt.expectedResponse.Message {
+				t.Errorf("Expected message %s, got %s", tt.expectedResponse.Message, response.Message)
+			}
+			if tt.expectedResponse.Code == 200 {
+				content := response.Content.(map[string]interface{})
+				if content["fine_cents"].(int64) != tt.expectedFine {
+					t.Errorf("Expected fine %d, got %d", tt.expectedFine, content["fine_cents"].(int64))
+				}
+			}
+			if tt.mockNextHoldReturn != nil {
+				content := response.Content.(map[string]interface{})
+				if content["assigned_hold_id"] != next_hold.ID {
+					t.Errorf("Expected assigned hold %v, got %v", next_hold.ID, content["assigned_hold_id"])
+				}
+			}
+		})
+	}
+}
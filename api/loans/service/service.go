@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/api/loans/dto"
+	"github.com/minand-mohan/library-app-api/api/loans/repository"
+	"github.com/minand-mohan/library-app-api/api/response"
+	userRepository "github.com/minand-mohan/library-app-api/api/users/repository"
+	"github.com/minand-mohan/library-app-api/database/models"
+	"github.com/minand-mohan/library-app-api/system"
+	"github.com/minand-mohan/library-app-api/utils"
+)
+
+type LoanService interface {
+	CheckoutItem(ctx context.Context, loanReqBody *dto.LoanRequestBody) (*response.HTTPResponse, error)
+	ReturnByLoanId(ctx context.Context, id uuid.UUID) (*response.HTTPResponse, error)
+	RenewByLoanId(id uuid.UUID) (*response.HTTPResponse, error)
+}
+
+type LoanServiceImpl struct {
+	repo     repository.LoanRepository
+	userRepo userRepository.UserRepository
+	config   *system.CirculationConfig
+	logger   *utils.AppLogger
+}
+
+func NewLoanService(repo repository.LoanRepository, userRepo userRepository.UserRepository, config *system.CirculationConfig, logger utils.AppLogger) LoanService {
+	return &LoanServiceImpl{
+		repo:     repo,
+		userRepo: userRepo,
+		config:   config,
+		logger:   &logger,
+	}
+}
+
+// calculateDueDate returns the due date of a loan starting at checkedOutAt
+func (service *LoanServiceImpl) calculateDueDate(checkedOutAt time.Time) time.Time {
+	return checkedOutAt.AddDate(0, 0, service.config.LoanPeriodDays)
+}
+
+func loanResponseContent(loan *models.Loan) map[string]interface{} {
+	return map[string]interface{}{
+		"id":             loan.ID,
+		"user_id":        loan.UserID,
+		"item_id":        loan.ItemID,
+		"checked_out_at": loan.CheckedOutAt,
+		"due_at":         loan.DueAt,
+		"returned_at":    loan.ReturnedAt,
+		"renewal_count":  loan.RenewalCount,
+	}
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	finerepomocks "github.com/minand-mohan/library-app-api/api/fines/repository/mocks"
+	holdrepomocks "github.com/minand-mohan/library-app-api/api/holds/repository/mocks"
+	"github.com/minand-mohan/library-app-api/api/loans/repository"
+	repomocks "github.com/minand-mohan/library-app-api/api/loans/repository/mocks"
+	"github.com/minand-mohan/library-app-api/database/models"
+	"github.com/minand-mohan/library-app-api/system"
+)
+
+var testCirculationConfig = &system.CirculationConfig{
+	LoanPeriodDays:          14,
+	MaxRenewals:             2,
+	HoldPickupDays:          3,
+	FineDailyCents:          25,
+	FineGraceDays:           1,
+	FineMaxPerItemCents:     1000,
+	FineBlockThresholdCents: 500,
+}
+
+// expectTransaction makes the mock repository run the transaction callback
+// against itself
+func expectTransaction(mockRepo *repomocks.MockLoanRepository) {
+	mockRepo.EXPECT().WithTransaction(gomock.Any()).DoAndReturn(func(fn func(repo repository.LoanRepository) error) error {
+		return fn(mockRepo)
+	})
+}
+
+// expectFines hands out a mock fines repository whenever the service asks
+// the loan repository for one
+func expectFines(mockCtrl *gomock.Controller, mockRepo *repomocks.MockLoanRepository) *finerepomocks.MockFineRepository {
+	mockFineRepo := finerepomocks.NewMockFineRepository(mockCtrl)
+	mockRepo.EXPECT().Fines().Return(mockFineRepo).AnyTimes()
+	return mockFineRepo
+}
+
+// expectHolds hands out a mock holds repository whenever the service asks
+// the loan repository for one
+func expectHolds(mockCtrl *gomock.Controller, mockRepo *repomocks.MockLoanRepository) *holdrepomocks.MockHoldRepository {
+	mockHoldRepo := holdrepomocks.NewMockHoldRepository(mockCtrl)
+	mockRepo.EXPECT().Holds().Return(mockHoldRepo).AnyTimes()
+	return mockHoldRepo
+}
+
+func generateRandomUser01() models.User {
+	//initialize variables
+	test_email := "test1@example.com"
+	test_username := "test1"
+	test_phone := "1234567890"
+	test_id := uuid.New()
+	return models.User{
+		ID:       &test_id,
+		Email:    &test_email,
+		Username: &test_username,
+		Phone:    &test_phone,
+	}
+}
+
+func generateRandomItem01() models.Item {
+	//initialize variables
+	test_barcode := "LIB-000001"
+	test_status := models.ItemStatusAvailable
+	test_id := uuid.New()
+	test_book_id := uuid.New()
+	return models.Item{
+		ID:      &test_id,
+		BookID:  &test_book_id,
+		Barcode: &test_barcode,
+		Status:  &test_status,
+	}
+}
+
+func generateRandomLoan01() models.Loan {
+	//initialize variables
+	test_checked_out_at := time.Now().UTC().AddDate(0, 0, -7)
+	test_due_at := test_checked_out_at.AddDate(0, 0, 14)
+	test_id := uuid.New()
+	test_user_id := uuid.New()
+	test_item_id := uuid.New()
+	test_renewal_count := 0
+	return models.Loan{
+		ID:           &test_id,
+		UserID:       &test_user_id,
+		ItemID:       &test_item_id,
+		CheckedOutAt: &test_checked_out_at,
+		DueAt:        &test_due_at,
+		RenewalCount: &test_renewal_count,
+	}
+}
+
+func generateRandomHold01() models.Hold {
+	//initialize variables
+	test_status := models.HoldStatusPending
+	test_placed_at := time.Now().UTC().AddDate(0, 0, -3)
+	test_id := uuid.New()
+	test_user_id := uuid.New()
+	test_book_id := uuid.New()
+	return models.Hold{
+		ID:       &test_id,
+		UserID:   &test_user_id,
+		BookID:   &test_book_id,
+		Status:   &test_status,
+		PlacedAt: &test_placed_at,
+	}
+}
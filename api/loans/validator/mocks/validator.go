@@ -0,0 +1,40 @@
+package mocks
+
+import (
+	"reflect"
+
+	"github.com/golang/mock/gomock"
+	"github.com/minand-mohan/library-app-api/api/loans/dto"
+)
+
+// MockLoanValidator is a mock type for the LoanValidator type
+type MockLoanValidator struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoanValidatorMockRecorder
+}
+
+type MockLoanValidatorMockRecorder struct {
+	mock *MockLoanValidator
+}
+
+func NewMockLoanValidator(ctrl *gomock.Controller) *MockLoanValidator {
+	mock := &MockLoanValidator{ctrl: ctrl}
+	mock.recorder = &MockLoanValidatorMockRecorder{mock}
+	return mock
+}
+
+func (m *MockLoanValidator) EXPECT() *MockLoanValidatorMockRecorder {
+	return m.recorder
+}
+
+func (m *MockLoanValidator) ValidateLoan(arg0 *dto.LoanRequestBody) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateLoan", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockLoanValidatorMockRecorder) ValidateLoan(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateLoan", reflect.TypeOf((*MockLoanValidator)(nil).ValidateLoan), arg0)
+}
@@ -0,0 +1,41 @@
+package validator
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/api/loans/dto"
+	"github.com/minand-mohan/library-app-api/utils"
+)
+
+type LoanValidator interface {
+	ValidateLoan(requestBody *dto.LoanRequestBody) error
+}
+
+type LoanValidatorImpl struct {
+	logger *utils.AppLogger
+}
+
+func NewLoanValidator(logger utils.AppLogger) LoanValidator {
+	return &LoanValidatorImpl{
+		logger: &logger,
+	}
+}
+
+func (validator *LoanValidatorImpl) ValidateLoan(loanReq *dto.LoanRequestBody) error {
+	validator.logger.Info("Validate loan")
+	if loanReq.UserID == "" {
+		validator.logger.Error("User id is empty")
+		return errors.New("User id is empty")
+	}
+	if _, err := uuid.Parse(loanReq.UserID); err != nil {
+		validator.logger.Error("User id is invalid")
+		return errors.New("User id is invalid")
+	}
+	if loanReq.Barcode == "" {
+		validator.logger.Error("Barcode is empty")
+		return errors.New("Barcode is empty")
+	}
+
+	return nil
+}
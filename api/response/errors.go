@@ -0,0 +1,59 @@
+package response
+
+import (
+	"errors"
+
+	"github.com/minand-mohan/library-app-api/database/dberrors"
+)
+
+// RepositoryErrorStatusCode maps an error returned by a repository to the
+// HTTP status code it is reported with
+func RepositoryErrorStatusCode(err error) int {
+	switch {
+	case errors.Is(err, dberrors.ErrNotFound):
+		return 404
+	case errors.Is(err, dberrors.ErrConflict):
+		return 409
+	case errors.Is(err, dberrors.ErrStaleVersion):
+		return 412
+	case errors.Is(err, dberrors.ErrUnavailable):
+		return 503
+	}
+	return 500
+}
+
+// GetStaleVersionHTTPResponseBody builds the response for a write whose
+// If-Match header names an older version than the stored one
+func GetStaleVersionHTTPResponseBody() *HTTPResponse {
+	return GetErrorHTTPResponseBody(412, "Precondition failed, stale version")
+}
+
+// GetRepositoryErrorHTTPResponseBody builds the response for an error returned
+// by a repository. notFound is the message for a lookup that found nothing,
+// e.g. "User not found.", a conflict names the offending field in the content.
+// Every service reports repository errors through it, so that an error is
+// answered with the same status wherever it happens.
+func GetRepositoryErrorHTTPResponseBody(err error, notFound string) *HTTPResponse {
+	code := RepositoryErrorStatusCode(err)
+	switch code {
+	case 404:
+		return GetErrorHTTPResponseBody(code, notFound)
+	case 409:
+		responseBody := GetErrorHTTPResponseBody(code, "Conflict, non-unique values")
+		var conflict *dberrors.ConflictError
+		if errors.As(err, &conflict) && conflict.ForeignKey {
+			responseBody = GetErrorHTTPResponseBody(code, "Conflict, related records")
+		}
+		if conflict != nil && conflict.Field != "" {
+			responseBody.Content = map[string]interface{}{
+				"field": conflict.Field,
+			}
+		}
+		return responseBody
+	case 412:
+		return GetStaleVersionHTTPResponseBody()
+	case 503:
+		return GetErrorHTTPResponseBody(code, "Service unavailable")
+	}
+	return GetErrorHTTPResponseBody(code, "Internal Server Error")
+}
@@ -0,0 +1,50 @@
+package response
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ETag formats the version of a row as a strong entity tag
+func ETag(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// IfMatch is a parsed If-Match request header. A nil *IfMatch stands for a
+// request without the header, which matches any version.
+type IfMatch struct {
+	any  bool
+	tags []string
+}
+
+// ParseIfMatch returns nil when the header is empty
+func ParseIfMatch(header string) *IfMatch {
+	header = strings.TrimSpace(header)
+	if header == "" {
+		return nil
+	}
+	ifMatch := &IfMatch{}
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" {
+			ifMatch.any = true
+		}
+		ifMatch.tags = append(ifMatch.tags, tag)
+	}
+	return ifMatch
+}
+
+// Matches compares version against the header with the strong comparison of
+// RFC 9110, so weak tags never match
+func (ifMatch *IfMatch) Matches(version int64) bool {
+	if ifMatch == nil || ifMatch.any {
+		return true
+	}
+	etag := ETag(version)
+	for _, tag := range ifMatch.tags {
+		if tag == etag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,17 @@
+package response
+
+import "net/url"
+
+const (
+	// Page size used when a listing request does not ask for one
+	DefaultPageSize = 20
+	// Largest page size a listing request may ask for
+	MaxPageSize = 100
+)
+
+// PageURL returns the link to another page of a paginated listing served at
+// baseURL, for use as HTTPResponseContent.Previous or Next
+func PageURL(baseURL string, query url.Values) *string {
+	pageURL := baseURL + "?" + query.Encode()
+	return &pageURL
+}
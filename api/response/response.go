@@ -12,6 +12,11 @@ type HTTPResponse struct {
 	Code    int         `json:"code,omitempty"`
 	Message string      `json:"message,omitempty"`
 	Content interface{} `json:"content"`
+	// Set on error responses from the X-Request-ID response header, so that
+	// a failure reported by a client can be found in the logs
+	RequestID string `json:"request_id,omitempty"`
+	// Sent as the ETag header when set
+	ETag string `json:"-"`
 }
 
 type HTTPResponseContent struct {
@@ -34,6 +39,12 @@ func WriteHTTPResponse(c *fiber.Ctx, statusCode int, responseBody *HTTPResponse)
 		return errors.New(fmt.Sprintf("Invalid status code for HTTP response: %v", statusCode))
 	}
 	c.Status(statusCode)
+	if responseBody != nil && statusCode >= 400 {
+		responseBody.RequestID = c.GetRespHeader(fiber.HeaderXRequestID)
+	}
+	if responseBody != nil && responseBody.ETag != "" {
+		c.Set(fiber.HeaderETag, responseBody.ETag)
+	}
 	err := c.JSON(responseBody)
 	return err
 }
@@ -48,7 +59,7 @@ func GetErrorHTTPResponseBody(code int, message string) *HTTPResponse {
 }
 
 func DefaultErrorHandler(c *fiber.Ctx, err error) error {
-	log := utils.NewLogger()
+	log := utils.ContextLogger(c)
 	log.Error(fmt.Sprintf("Error thrown from DefaultErrorHandler %e", err))
 	errorBody := GetErrorHTTPResponseBody(500, "Internal Server Error")
 	return WriteHTTPResponse(c, 500, errorBody)
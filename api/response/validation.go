@@ -0,0 +1,179 @@
+package response
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Codes of a FieldError
+const (
+	FieldErrorSyntax   = "syntax"
+	FieldErrorUnknown  = "unknown"
+	FieldErrorType     = "type"
+	FieldErrorRequired = "required"
+	FieldErrorLength   = "length"
+	FieldErrorFormat   = "format"
+)
+
+// FieldError is one problem with a request body. Field is empty when the
+// problem is with the body as a whole.
+type FieldError struct {
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// ValidationError collects every problem found in a request body so that
+// they can be reported at once
+type ValidationError struct {
+	Errors []FieldError
+}
+
+func (validationError *ValidationError) Add(field string, code string, message string) {
+	validationError.Errors = append(validationError.Errors, FieldError{
+		Field:   field,
+		Code:    code,
+		Message: message,
+	})
+}
+
+func (validationError *ValidationError) Error() string {
+	messages := make([]string, 0, len(validationError.Errors))
+	for _, fieldError := range validationError.Errors {
+		messages = append(messages, fieldError.Message)
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Err returns nil when no problem was added, so a validator can end with
+// `return errs.Err()`
+func (validationError *ValidationError) Err() error {
+	if len(validationError.Errors) == 0 {
+		return nil
+	}
+	return validationError
+}
+
+// DecodeJSONObject unmarshals a JSON object into the struct target points
+// to. Unlike json.Unmarshal it does not stop at the first problem: members
+// without a matching json tag and members of the wrong type are all
+// returned in a *ValidationError.
+func DecodeJSONObject(data []byte, target interface{}) error {
+	errs := &ValidationError{}
+	var members map[string]json.RawMessage
+	err := json.Unmarshal(data, &members)
+	if err != nil || members == nil {
+		errs.Errors = append(errs.Errors, toFieldErrors(err)...)
+		return errs
+	}
+
+	fields := jsonFields(reflect.TypeOf(target).Elem())
+	names := make([]string, 0, len(members))
+	for name := range members {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fieldType, ok := fields[name]
+		if !ok {
+			errs.Add(name, FieldErrorUnknown, fmt.Sprintf("Field %s is unknown", name))
+			continue
+		}
+		value := reflect.New(fieldType).Interface()
+		err = json.Unmarshal(members[name], value)
+		if err != nil {
+			errs.Add(name, FieldErrorType, fmt.Sprintf("Field %s must be %s", name, jsonTypeName(fieldType)))
+		}
+	}
+	if len(errs.Errors) > 0 {
+		return errs
+	}
+	return json.Unmarshal(data, target)
+}
+
+// GetValidationErrorHTTPResponseBody lists the problems of a request body
+// under "errors". Errors from json.Unmarshal are reported as well as a
+// *ValidationError.
+func GetValidationErrorHTTPResponseBody(err error) *HTTPResponse {
+	var validationError *ValidationError
+	fieldErrors := []FieldError{}
+	if errors.As(err, &validationError) {
+		fieldErrors = append(fieldErrors, validationError.Errors...)
+	} else {
+		fieldErrors = append(fieldErrors, toFieldErrors(err)...)
+	}
+	return &HTTPResponse{
+		Code:    400,
+		Message: "Bad request, invalid request body",
+		Content: map[string]interface{}{
+			"errors": fieldErrors,
+		},
+	}
+}
+
+// toFieldErrors describes an error returned by json.Unmarshal. A nil error
+// means the body was null.
+func toFieldErrors(err error) []FieldError {
+	var syntaxError *json.SyntaxError
+	var typeError *json.UnmarshalTypeError
+	switch {
+	case err == nil:
+		return []FieldError{{Code: FieldErrorType, Message: "Request body must be a JSON object"}}
+	case errors.As(err, &syntaxError):
+		return []FieldError{{Code: FieldErrorSyntax, Message: "Request body is not valid JSON"}}
+	case errors.As(err, &typeError):
+		if typeError.Field == "" {
+			return []FieldError{{Code: FieldErrorType, Message: "Request body must be a JSON object"}}
+		}
+		return []FieldError{{
+			Field:   typeError.Field,
+			Code:    FieldErrorType,
+			Message: fmt.Sprintf("Field %s must be %s", typeError.Field, jsonTypeName(typeError.Type)),
+		}}
+	}
+	return []FieldError{{Code: FieldErrorSyntax, Message: err.Error()}}
+}
+
+// jsonFields maps the json names of the exported fields of a struct to
+// their types
+func jsonFields(structType reflect.Type) map[string]reflect.Type {
+	fields := map[string]reflect.Type{}
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = field.Type
+	}
+	return fields
+}
+
+func jsonTypeName(goType reflect.Type) string {
+	for goType.Kind() == reflect.Pointer {
+		goType = goType.Elem()
+	}
+	switch goType.Kind() {
+	case reflect.String:
+		return "a string"
+	case reflect.Bool:
+		return "a boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return "a number"
+	case reflect.Slice, reflect.Array:
+		return "an array"
+	}
+	return "an object"
+}
@@ -0,0 +1,81 @@
+package response
+
+import (
+	"testing"
+)
+
+func TestDecodeJSONObject(t *testing.T) {
+	type body struct {
+		Name  string `json:"name"`
+		Count int    `json:"count"`
+	}
+
+	tc := []struct {
+		name           string
+		data           string
+		expectedBody   body
+		expectedErrors []FieldError
+	}{
+		{
+			name:         "Valid object",
+			data:         `{"name": "test", "count": 2}`,
+			expectedBody: body{Name: "test", Count: 2},
+		},
+		{
+			name: "Unknown and mistyped members are all reported",
+			data: `{"name": 1, "count": "two", "extra": true}`,
+			expectedErrors: []FieldError{
+				{Field: "count", Code: FieldErrorType, Message: "Field count must be a number"},
+				{Field: "extra", Code: FieldErrorUnknown, Message: "Field extra is unknown"},
+				{Field: "name", Code: FieldErrorType, Message: "Field name must be a string"},
+			},
+		},
+		{
+			name: "Not an object",
+			data: `["test"]`,
+			expectedErrors: []FieldError{
+				{Code: FieldErrorType, Message: "Request body must be a JSON object"},
+			},
+		},
+		{
+			name: "Null",
+			data: `null`,
+			expectedErrors: []FieldError{
+				{Code: FieldErrorType, Message: "Request body must be a JSON object"},
+			},
+		},
+		{
+			name: "Invalid JSON",
+			data: `{"name": `,
+			expectedErrors: []FieldError{
+				{Code: FieldErrorSyntax, Message: "Request body is not valid JSON"},
+			},
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			decoded := body{}
+			err := DecodeJSONObject([]byte(tt.data), &decoded)
+			if tt.expectedErrors == nil {
+				if err != nil {
+					t.Errorf("Expected no error, got %v", err)
+				}
+				if decoded != tt.expectedBody {
+					t.Errorf("Expected body %v, got %v", tt.expectedBody, decoded)
+				}
+				return
+			}
+			content := GetValidationErrorHTTPResponseBody(err).Content.(map[string]interface{})
+			fieldErrors := content["errors"].([]FieldError)
+			if len(fieldErrors) != len(tt.expectedErrors) {
+				t.Fatalf("Expected %d field errors, got %v", len(tt.expectedErrors), fieldErrors)
+			}
+			for i, expected := range tt.expectedErrors {
+				if fieldErrors[i] != expected {
+					t.Errorf("Expected field error %v, got %v", expected, fieldErrors[i])
+				}
+			}
+		})
+	}
+}
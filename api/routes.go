@@ -1,26 +1,125 @@
 package api
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/adaptor"
+	"github.com/gofiber/fiber/v2/middleware/recover"
+	apiKeyRepository "github.com/minand-mohan/library-app-api/api/apikeys/repository"
+	bookHandler "github.com/minand-mohan/library-app-api/api/books/handler"
+	bookRepository "github.com/minand-mohan/library-app-api/api/books/repository"
+	bookService "github.com/minand-mohan/library-app-api/api/books/service"
+	bookValidator "github.com/minand-mohan/library-app-api/api/books/validator"
+	fineHandler "github.com/minand-mohan/library-app-api/api/fines/handler"
+	fineRepository "github.com/minand-mohan/library-app-api/api/fines/repository"
+	fineService "github.com/minand-mohan/library-app-api/api/fines/service"
+	fineValidator "github.com/minand-mohan/library-app-api/api/fines/validator"
+	"github.com/minand-mohan/library-app-api/api/health"
+	holdHandler "github.com/minand-mohan/library-app-api/api/holds/handler"
+	holdRepository "github.com/minand-mohan/library-app-api/api/holds/repository"
+	holdService "github.com/minand-mohan/library-app-api/api/holds/service"
+	holdValidator "github.com/minand-mohan/library-app-api/api/holds/validator"
+	itemHandler "github.com/minand-mohan/library-app-api/api/items/handler"
+	itemRepository "github.com/minand-mohan/library-app-api/api/items/repository"
+	itemService "github.com/minand-mohan/library-app-api/api/items/service"
+	itemValidator "github.com/minand-mohan/library-app-api/api/items/validator"
+	loanHandler "github.com/minand-mohan/library-app-api/api/loans/handler"
+	loanRepository "github.com/minand-mohan/library-app-api/api/loans/repository"
+	loanService "github.com/minand-mohan/library-app-api/api/loans/service"
+	loanValidator "github.com/minand-mohan/library-app-api/api/loans/validator"
 	"github.com/minand-mohan/library-app-api/api/response"
 	userHandler "github.com/minand-mohan/library-app-api/api/users/handler"
 	userRepository "github.com/minand-mohan/library-app-api/api/users/repository"
 	userService "github.com/minand-mohan/library-app-api/api/users/service"
 	userValidator "github.com/minand-mohan/library-app-api/api/users/validator"
+	"github.com/minand-mohan/library-app-api/database"
+	"github.com/minand-mohan/library-app-api/metrics"
 	"github.com/minand-mohan/library-app-api/middleware"
 	"github.com/minand-mohan/library-app-api/utils"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"gorm.io/gorm"
 )
 
-func getDefaultUserHandler(server *APIServer) *userHandler.UserHandler {
-	logger := utils.NewLogger()
-	repository := userRepository.NewUserRepository(server.dataSource.DB)
-	validator := userValidator.NewUserValidator(*logger)
-	service := userService.NewUserService(repository, *logger)
+// requestScope returns the logger of a request and a database handle that
+// logs its queries with it
+func (server *APIServer) requestScope(c *fiber.Ctx) (*utils.AppLogger, *gorm.DB) {
+	logger := utils.ContextLogger(c)
+	db := server.dataSource.DB.Session(&gorm.Session{Logger: database.NewGormLogger(logger)})
+	return logger, db
+}
+
+func getDefaultUserHandler(server *APIServer, c *fiber.Ctx) *userHandler.UserHandler {
+	logger, db := server.requestScope(c)
+	repository := userRepository.NewUserRepository(db)
+	fineRepo := fineRepository.NewFineRepository(db)
+	validator := userValidator.NewUserValidator(server.userConfig, *logger)
+	service := userService.NewUserService(repository, fineRepo, *logger)
 	return userHandler.NewUserHandler(service, validator)
 }
 
+func getDefaultBookHandler(server *APIServer, c *fiber.Ctx) *bookHandler.BookHandler {
+	logger, db := server.requestScope(c)
+	repository := bookRepository.NewBookRepository(db)
+	validator := bookValidator.NewBookValidator(*logger)
+	service := bookService.NewBookService(repository, *logger)
+	return bookHandler.NewBookHandler(service, validator)
+}
+
+func getDefaultItemHandler(server *APIServer, c *fiber.Ctx) *itemHandler.ItemHandler {
+	logger, db := server.requestScope(c)
+	repository := itemRepository.NewItemRepository(db)
+	bookRepo := bookRepository.NewBookRepository(db)
+	validator := itemValidator.NewItemValidator(*logger)
+	service := itemService.NewItemService(repository, bookRepo, *logger)
+	return itemHandler.NewItemHandler(service, validator)
+}
+
+func getDefaultLoanHandler(server *APIServer, c *fiber.Ctx) *loanHandler.LoanHandler {
+	logger, db := server.requestScope(c)
+	repository := loanRepository.NewLoanRepository(db)
+	userRepo := userRepository.NewUserRepository(db)
+	validator := loanValidator.NewLoanValidator(*logger)
+	service := loanService.NewLoanService(repository, userRepo, server.circulationConfig, *logger)
+	return loanHandler.NewLoanHandler(service, validator)
+}
+
+func getDefaultHoldService(server *APIServer, db *gorm.DB, logger *utils.AppLogger) holdService.HoldService {
+	repository := holdRepository.NewHoldRepository(db)
+	userRepo := userRepository.NewUserRepository(db)
+	bookRepo := bookRepository.NewBookRepository(db)
+	return holdService.NewHoldService(repository, userRepo, bookRepo, server.circulationConfig, *logger)
+}
+
+func getDefaultHoldHandler(server *APIServer, c *fiber.Ctx) *holdHandler.HoldHandler {
+	logger, db := server.requestScope(c)
+	validator := holdValidator.NewHoldValidator(*logger)
+	return holdHandler.NewHoldHandler(getDefaultHoldService(server, db, logger), validator)
+}
+
+func getDefaultFineService(server *APIServer, db *gorm.DB, logger *utils.AppLogger) fineService.FineService {
+	repository := fineRepository.NewFineRepository(db)
+	userRepo := userRepository.NewUserRepository(db)
+	return fineService.NewFineService(repository, userRepo, server.circulationConfig, *logger)
+}
+
+func getDefaultFineHandler(server *APIServer, c *fiber.Ctx) *fineHandler.FineHandler {
+	logger, db := server.requestScope(c)
+	validator := fineValidator.NewFineValidator(*logger)
+	return fineHandler.NewFineHandler(getDefaultFineService(server, db, logger), validator)
+}
+
+func getDefaultHealthHandler(server *APIServer) *health.HealthHandler {
+	logger := utils.NewLogger()
+	migrations, err := database.Migrations(server.userConfig.PhoneDefaultRegion)
+	if err != nil {
+		logger.Fatal(fmt.Sprintf("Error loading migrations %v", err))
+	}
+	migrator := database.NewMigrator(server.dataSource.DB, migrations, *logger)
+	return health.NewHealthHandler(server.dataSource.DB, migrator, *logger)
+}
+
 func setUpDefaultRoutes(server *APIServer) {
 	app := server.app
 	app.All("/*", func(c *fiber.Ctx) error {
@@ -33,34 +132,144 @@ func setUpDefaultRoutes(server *APIServer) {
 func SetupRoutes(server *APIServer) {
 
 	app := server.app
+	// a panicking handler fails its request with a 500 instead of the server
+	app.Use(recover.New(), middleware.NewRequestID(), middleware.NewTracing(), middleware.NewMetrics(), middleware.NewRequestLogger(server.logger))
+
+	// Health probes, unauthenticated so that orchestrators can call them
+	server.healthHandler = getDefaultHealthHandler(server)
+	app.Get("/health/live", server.healthHandler.Live)
+	app.Get("/health/ready", server.healthHandler.Ready)
+	app.Get("/metrics", adaptor.HTTPHandler(promhttp.HandlerFor(metrics.Registry, promhttp.HandlerOpts{})))
+
 	libraryv1 := app.Group("/library-app/api/v1")
+	keyAuth := middleware.NewKeyAuth(server.appConfig.Auth.Token, apiKeyRepository.NewAPIKeyRepository(server.dataSource.DB))
 
 	// User routes
-	libraryv1.Post("/users", middleware.KeyAuth, func(c *fiber.Ctx) error {
-		handler := getDefaultUserHandler(server)
+	libraryv1.Post("/users", keyAuth, func(c *fiber.Ctx) error {
+		handler := getDefaultUserHandler(server, c)
 		return handler.CreateUser(c)
 	})
 
-	libraryv1.Get("/users", middleware.KeyAuth, func(c *fiber.Ctx) error {
-		handler := getDefaultUserHandler(server)
+	libraryv1.Get("/users", keyAuth, func(c *fiber.Ctx) error {
+		handler := getDefaultUserHandler(server, c)
 		return handler.FindAllUsers(c)
 	})
 
-	libraryv1.Get("/users/:id", middleware.KeyAuth, func(c *fiber.Ctx) error {
-		handler := getDefaultUserHandler(server)
+	libraryv1.Get("/users/:id", keyAuth, func(c *fiber.Ctx) error {
+		handler := getDefaultUserHandler(server, c)
 		return handler.FindByUserId(c)
 	})
 
-	libraryv1.Put("/users/:id", middleware.KeyAuth, func(c *fiber.Ctx) error {
-		handler := getDefaultUserHandler(server)
+	libraryv1.Put("/users/:id", keyAuth, func(c *fiber.Ctx) error {
+		handler := getDefaultUserHandler(server, c)
 		return handler.UpdateByUserId(c)
 	})
 
-	libraryv1.Delete("/users/:id", middleware.KeyAuth, func(c *fiber.Ctx) error {
-		handler := getDefaultUserHandler(server)
+	libraryv1.Patch("/users/:id", keyAuth, func(c *fiber.Ctx) error {
+		handler := getDefaultUserHandler(server, c)
+		return handler.PatchByUserId(c)
+	})
+
+	libraryv1.Delete("/users/:id", keyAuth, func(c *fiber.Ctx) error {
+		handler := getDefaultUserHandler(server, c)
 		return handler.DeleteByUserId(c)
 	})
 
+	libraryv1.Post("/users/:id/restore", keyAuth, func(c *fiber.Ctx) error {
+		handler := getDefaultUserHandler(server, c)
+		return handler.RestoreByUserId(c)
+	})
+
+	// Book routes
+	libraryv1.Post("/books", keyAuth, func(c *fiber.Ctx) error {
+		handler := getDefaultBookHandler(server, c)
+		return handler.CreateBook(c)
+	})
+
+	libraryv1.Get("/books", keyAuth, func(c *fiber.Ctx) error {
+		handler := getDefaultBookHandler(server, c)
+		return handler.FindAllBooks(c)
+	})
+
+	libraryv1.Get("/books/:id", keyAuth, func(c *fiber.Ctx) error {
+		handler := getDefaultBookHandler(server, c)
+		return handler.FindByBookId(c)
+	})
+
+	libraryv1.Put("/books/:id", keyAuth, func(c *fiber.Ctx) error {
+		handler := getDefaultBookHandler(server, c)
+		return handler.UpdateByBookId(c)
+	})
+
+	libraryv1.Delete("/books/:id", keyAuth, func(c *fiber.Ctx) error {
+		handler := getDefaultBookHandler(server, c)
+		return handler.DeleteByBookId(c)
+	})
+
+	// Item (physical copy) routes
+	libraryv1.Post("/books/:id/items", keyAuth, func(c *fiber.Ctx) error {
+		handler := getDefaultItemHandler(server, c)
+		return handler.CreateItem(c)
+	})
+
+	libraryv1.Get("/books/:id/items", keyAuth, func(c *fiber.Ctx) error {
+		handler := getDefaultItemHandler(server, c)
+		return handler.FindAllItemsByBookId(c)
+	})
+
+	libraryv1.Get("/items/barcode/:barcode", keyAuth, func(c *fiber.Ctx) error {
+		handler := getDefaultItemHandler(server, c)
+		return handler.FindByBarcode(c)
+	})
+
+	libraryv1.Post("/items/:id/retire", keyAuth, func(c *fiber.Ctx) error {
+		handler := getDefaultItemHandler(server, c)
+		return handler.RetireByItemId(c)
+	})
+
+	// Loan routes
+	libraryv1.Post("/loans", keyAuth, func(c *fiber.Ctx) error {
+		handler := getDefaultLoanHandler(server, c)
+		return handler.CheckoutItem(c)
+	})
+
+	libraryv1.Post("/loans/:id/return", keyAuth, func(c *fiber.Ctx) error {
+		handler := getDefaultLoanHandler(server, c)
+		return handler.ReturnByLoanId(c)
+	})
+
+	libraryv1.Post("/loans/:id/renew", keyAuth, func(c *fiber.Ctx) error {
+		handler := getDefaultLoanHandler(server, c)
+		return handler.RenewByLoanId(c)
+	})
+
+	// Hold routes
+	libraryv1.Post("/users/:id/holds", keyAuth, func(c *fiber.Ctx) error {
+		handler := getDefaultHoldHandler(server, c)
+		return handler.PlaceHold(c)
+	})
+
+	libraryv1.Get("/users/:id/holds", keyAuth, func(c *fiber.Ctx) error {
+		handler := getDefaultHoldHandler(server, c)
+		return handler.FindAllHoldsByUserId(c)
+	})
+
+	libraryv1.Delete("/users/:id/holds/:holdId", keyAuth, func(c *fiber.Ctx) error {
+		handler := getDefaultHoldHandler(server, c)
+		return handler.CancelHold(c)
+	})
+
+	// Fine routes
+	libraryv1.Post("/users/:id/fines", keyAuth, func(c *fiber.Ctx) error {
+		handler := getDefaultFineHandler(server, c)
+		return handler.RecordFineEntry(c)
+	})
+
+	libraryv1.Get("/users/:id/fines", keyAuth, func(c *fiber.Ctx) error {
+		handler := getDefaultFineHandler(server, c)
+		return handler.FindAllFineEntriesByUserId(c)
+	})
+
 	setUpDefaultRoutes(server)
 
 }
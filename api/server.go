@@ -2,64 +2,145 @@ package api
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"os/signal"
 	"sync"
 	"syscall"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/minand-mohan/library-app-api/api/health"
 	"github.com/minand-mohan/library-app-api/api/response"
+	"github.com/minand-mohan/library-app-api/metrics"
 	"github.com/minand-mohan/library-app-api/system"
+	"github.com/minand-mohan/library-app-api/tracing"
 	"github.com/minand-mohan/library-app-api/utils"
 )
 
+// How long to wait for buffered spans to be exported on shutdown
+const tracingFlushTimeout = 5 * time.Second
+
 type APIServer struct {
-	// appConfig  *system.Config
-	logger     *utils.AppLogger
-	dataSource *system.DataSource
-	app        *fiber.App
+	appConfig         *system.Config
+	logger            *utils.AppLogger
+	dataSource        *system.DataSource
+	circulationConfig *system.CirculationConfig
+	userConfig        *system.UserConfig
+	healthHandler     *health.HealthHandler
+	shutdownTracing   func(context.Context) error
+	app               *fiber.App
 }
 
-func NewServer() *APIServer {
-	dataSource := system.NewDataSource()
+func NewServer(appConfig *system.Config) *APIServer {
+	dataSource := system.NewDataSource(&appConfig.DB, &appConfig.Users)
 	app := fiber.New(fiber.Config{
 		CaseSensitive:         true,
 		ServerHeader:          "minand-mohan/library-app-api",
-		Concurrency:           1024,
+		Concurrency:           appConfig.Server.Concurrency,
 		DisableStartupMessage: false,
 		ErrorHandler:          response.DefaultErrorHandler,
 	})
 	appLogger := utils.NewLogger()
+	sqlDB, err := dataSource.DB.DB()
+	if err == nil {
+		err = metrics.RegisterDB(sqlDB, appConfig.DB.Name)
+	}
+	if err != nil {
+		appLogger.Error(fmt.Sprintf("Error registering connection pool metrics %v", err))
+	}
+	shutdownTracing, err := tracing.Setup(&appConfig.Tracing)
+	if err != nil {
+		appLogger.Error(fmt.Sprintf("Error setting up tracing, spans will not be exported %v", err))
+		shutdownTracing = func(context.Context) error { return nil }
+	} else if appConfig.Tracing.Exporter != "none" {
+		if err := dataSource.DB.Use(tracing.NewGormPlugin()); err != nil {
+			appLogger.Error(fmt.Sprintf("Error instrumenting database queries %v", err))
+		}
+		appLogger.Info(fmt.Sprintf("Exporting traces to %s", appConfig.Tracing.Exporter))
+	}
 	return &APIServer{
-		logger:     appLogger,
-		dataSource: dataSource,
-		app:        app,
+		appConfig:         appConfig,
+		logger:            appLogger,
+		dataSource:        dataSource,
+		circulationConfig: &appConfig.Circulation,
+		userConfig:        &appConfig.Users,
+		shutdownTracing:   shutdownTracing,
+		app:               app,
 	}
 }
 
-func (server *APIServer) StartServer() {
+// StartServer serves the API and runs the background workers until SIGINT
+// or SIGTERM, then shuts down in order: the readiness probe fails, the
+// server stops accepting requests and drains in-flight ones, the workers
+// stop, the connection pool is closed and buffered spans are flushed. It
+// returns an error if the server could not start or a step of the shutdown
+// failed.
+func (server *APIServer) StartServer() error {
 	log := server.logger
 	SetupRoutes(server)
 	ctx, cancel := context.WithCancel(context.Background())
-	wg := &sync.WaitGroup{}
-	wg.Add(1)
+	workers := &sync.WaitGroup{}
+	workers.Add(2)
 	go func() {
-		defer wg.Done()
-		// Start the server
-		if err := server.app.Listen(":8080"); err != nil {
-			log.Fatal(fmt.Sprintf("Error starting api server %e", err))
-		}
+		defer workers.Done()
+		server.runHoldExpiry(ctx)
+	}()
+	go func() {
+		defer workers.Done()
+		server.runFineAccrual(ctx)
+	}()
+
+	listenErr := make(chan error, 1)
+	go func() {
+		listenErr <- server.app.Listen(server.appConfig.Server.Address)
 	}()
 	sigterm := make(chan os.Signal, 1)
 	signal.Notify(sigterm, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(sigterm)
+
+	var errs []error
 	select {
-	case <-ctx.Done():
-		log.Info("Terminating api server: context cancelled")
-		server.app.Shutdown()
-	case <-sigterm:
-		log.Info("Terminating api server: via signal")
-		server.app.Shutdown()
+	case err := <-listenErr:
+		log.Error(fmt.Sprintf("Error starting api server %v", err))
+		errs = append(errs, err)
+	case sig := <-sigterm:
+		log.Info(fmt.Sprintf("Terminating api server: received %s", sig))
+		errs = append(errs, server.drain())
 	}
+
 	cancel()
+	workers.Wait()
+	log.Info("Background workers stopped")
+	if err := server.dataSource.Close(); err != nil {
+		log.Error(fmt.Sprintf("Error closing database connections %v", err))
+		errs = append(errs, err)
+	}
+	flushCtx, cancelFlush := context.WithTimeout(context.Background(), tracingFlushTimeout)
+	defer cancelFlush()
+	if err := server.shutdownTracing(flushCtx); err != nil {
+		log.Error(fmt.Sprintf("Error flushing traces %v", err))
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// drain fails the readiness probe for the shutdown delay, then stops the
+// server, waiting up to the shutdown timeout for in-flight requests
+func (server *APIServer) drain() error {
+	log := server.logger
+	serverConfig := server.appConfig.Server
+	server.healthHandler.Drain()
+	if serverConfig.ShutdownDelaySeconds > 0 {
+		log.Info(fmt.Sprintf("Failing readiness for %ds before shutting down", serverConfig.ShutdownDelaySeconds))
+		time.Sleep(time.Duration(serverConfig.ShutdownDelaySeconds) * time.Second)
+	}
+	timeout := time.Duration(serverConfig.ShutdownTimeoutSeconds) * time.Second
+	if err := server.app.ShutdownWithTimeout(timeout); err != nil {
+		log.Error(fmt.Sprintf("Error draining requests within %s %v", timeout, err))
+		return err
+	}
+	log.Info("In-flight requests drained")
+	return nil
 }
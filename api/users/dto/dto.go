@@ -1,5 +1,13 @@
 package dto
 
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/minand-mohan/library-app-api/api/response"
+)
+
 type UserRequestBody struct {
 	Username string `json:"username"`
 	Email    string `json:"email"`
@@ -9,4 +17,95 @@ type UserRequestBody struct {
 type UserQueryParams struct {
 	Username string `query:"username"`
 	Email    string `query:"email"`
+	// Offset pagination, 1-based
+	Page     int `query:"page"`
+	PageSize int `query:"page_size"`
+	// Cursor pagination, the id of the last user on the previous page
+	After string `query:"after"`
+	// Comma separated sort columns, a leading "-" sorts descending
+	Sort string `query:"sort"`
+	// Comma separated fields to return for each user
+	Fields string `query:"fields"`
+	// List soft deleted users alongside active ones
+	IncludeDeleted bool `query:"include_deleted"`
+	// URL the listing is served at, used to build the prev/next links
+	BaseURL string `query:"-"`
+}
+
+// Columns a user listing can be sorted on
+var UserSortFields = []string{"id", "username", "email", "phone"}
+
+// Fields a user listing can be narrowed to
+var UserFields = []string{"id", "username", "email", "phone", "deleted_at"}
+
+// UserPatchBody is a JSON merge patch (RFC 7396) of a user. Members left out
+// of the patch are left untouched, members set to null are recorded in
+// Fields with a nil value. Members of the wrong type are all reported in a
+// *response.ValidationError.
+type UserPatchBody struct {
+	Username *string
+	Email    *string
+	Phone    *string
+	// Names of the members present in the patch, in document order
+	Fields []string
+}
+
+// Members of a user that can be patched
+var UserPatchFields = []string{"username", "email", "phone"}
+
+func (patch *UserPatchBody) UnmarshalJSON(data []byte) error {
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(data, &members); err != nil {
+		return err
+	}
+	errs := &response.ValidationError{}
+	if members == nil {
+		errs.Add("", response.FieldErrorType, "Request body must be a JSON object")
+		return errs
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	// the opening brace
+	if _, err := decoder.Token(); err != nil {
+		return err
+	}
+	*patch = UserPatchBody{}
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+		name := token.(string)
+		var value json.RawMessage
+		if err := decoder.Decode(&value); err != nil {
+			return err
+		}
+		// a repeated member overrides the earlier one
+		if !containsField(patch.Fields, name) {
+			patch.Fields = append(patch.Fields, name)
+		}
+		var target **string
+		switch name {
+		case "username":
+			target = &patch.Username
+		case "email":
+			target = &patch.Email
+		case "phone":
+			target = &patch.Phone
+		default:
+			continue
+		}
+		if err := json.Unmarshal(value, target); err != nil {
+			errs.Add(name, response.FieldErrorType, fmt.Sprintf("Field %s must be a string", name))
+		}
+	}
+	return errs.Err()
+}
+
+func containsField(fields []string, name string) bool {
+	for _, field := range fields {
+		if field == name {
+			return true
+		}
+	}
+	return false
 }
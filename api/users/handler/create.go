@@ -1,28 +1,26 @@
 package handler
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/minand-mohan/library-app-api/api/response"
 	"github.com/minand-mohan/library-app-api/api/users/dto"
+	"github.com/minand-mohan/library-app-api/tracing"
 	"github.com/minand-mohan/library-app-api/utils"
 )
 
 func (handler *UserHandler) CreateUser(ctx *fiber.Ctx) error {
-	log := utils.NewLogger()
+	log := utils.ContextLogger(ctx)
+	spanCtx, span := tracing.Start(ctx.UserContext(), "UserHandler.CreateUser")
+	defer span.End()
 	log.Info("Create user")
-	var userReq *dto.UserRequestBody
-	err := json.Unmarshal(ctx.Request().Body(), &userReq)
+	userReq := &dto.UserRequestBody{}
+	err := response.DecodeJSONObject(ctx.Request().Body(), userReq)
 	if err != nil {
 		log.Error(fmt.Sprintf("Error while unmarshalling request body %v", err))
-		responseBody := response.HTTPResponse{
-			Code:    400,
-			Message: "Bad request, invalid request body",
-			Content: map[string]interface{}{},
-		}
-		err := response.WriteHTTPResponse(ctx, 400, &responseBody)
+		responseBody := response.GetValidationErrorHTTPResponseBody(err)
+		err := response.WriteHTTPResponse(ctx, 400, responseBody)
 		if err != nil {
 			log.Error(fmt.Sprintf("Error while writing response %v", err))
 			return err
@@ -32,12 +30,8 @@ func (handler *UserHandler) CreateUser(ctx *fiber.Ctx) error {
 	err = handler.validator.ValidateUser(userReq)
 	if err != nil {
 		log.Error(fmt.Sprintf("Error while validating request body %v", err))
-		responseBody := response.HTTPResponse{
-			Code:    400,
-			Message: "Bad request, invalid request body",
-			Content: map[string]interface{}{},
-		}
-		err = response.WriteHTTPResponse(ctx, 400, &responseBody)
+		responseBody := response.GetValidationErrorHTTPResponseBody(err)
+		err = response.WriteHTTPResponse(ctx, 400, responseBody)
 		if err != nil {
 			log.Error(fmt.Sprintf("Error while writing response %v", err))
 			return err
@@ -45,7 +39,7 @@ func (handler *UserHandler) CreateUser(ctx *fiber.Ctx) error {
 		return nil
 	}
 
-	responseBody, err := handler.service.CreateUser(userReq)
+	responseBody, err := handler.service.CreateUser(spanCtx, userReq)
 	if err != nil {
 		log.Error(fmt.Sprintf("UserHandler: Error while creating user %v", err))
 		err = response.WriteHTTPResponse(ctx, responseBody.Code, responseBody)
@@ -20,11 +20,13 @@ func TestCreateUser(t *testing.T) {
 	testCases := []struct {
 		name                      string
 		requestBody               map[string]interface{}
+		rawRequestBody            string
 		mockServiceExpectResponse *response.HTTPResponse
 		mockServiceExpectError    error
 		mockValidatorExpectError  error
 		expectedStatus            int
 		expectedMessage           string
+		expectedErrorFields       []string
 	}{
 		{
 			name: "Create user with valid request body",
@@ -89,6 +91,20 @@ func TestCreateUser(t *testing.T) {
 			expectedStatus:           500,
 			expectedMessage:          "Internal Server Error",
 		},
+		{
+			name:                "Create user with unknown and mistyped fields",
+			rawRequestBody:      `{"username": "test", "email": 42, "phone": "1234567890", "role": "admin"}`,
+			expectedStatus:      400,
+			expectedMessage:     "Bad request, invalid request body",
+			expectedErrorFields: []string{"email", "role"},
+		},
+		{
+			name:                "Create user with malformed body",
+			rawRequestBody:      `{"username": "test",`,
+			expectedStatus:      400,
+			expectedMessage:     "Bad request, invalid request body",
+			expectedErrorFields: []string{""},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -103,9 +119,11 @@ func TestCreateUser(t *testing.T) {
 				validator := validatormocks.NewMockUserValidator(mockCtrl)
 				service := servicemocks.NewMockUserService(mockCtrl)
 				if tc.mockServiceExpectResponse != nil {
-					service.EXPECT().CreateUser(gomock.Any()).Return(tc.mockServiceExpectResponse, tc.mockServiceExpectError)
+					service.EXPECT().CreateUser(gomock.Any(), gomock.Any()).Return(tc.mockServiceExpectResponse, tc.mockServiceExpectError)
+				}
+				if tc.rawRequestBody == "" {
+					validator.EXPECT().ValidateUser(gomock.Any()).Return(tc.mockValidatorExpectError)
 				}
-				validator.EXPECT().ValidateUser(gomock.Any()).Return(tc.mockValidatorExpectError)
 				handler := NewUserHandler(service, validator)
 				t.Logf("Handler: %v", handler)
 				return handler.CreateUser(c)
@@ -114,7 +132,10 @@ func TestCreateUser(t *testing.T) {
 			if err != nil {
 				t.Errorf("Error while marshalling request body: %v", err)
 			}
-			t.Logf("Request body: %v", tc.requestBody)
+			if tc.rawRequestBody != "" {
+				requestBody = []byte(tc.rawRequestBody)
+			}
+			t.Logf("Request body: %s", requestBody)
 			request := httptest.NewRequest("POST", "/users/", strings.NewReader(string(requestBody)))
 
 			response, err := app.Test(request)
@@ -146,6 +167,19 @@ func TestCreateUser(t *testing.T) {
 			if message != tc.expectedMessage {
 				t.Errorf("Expected message %s, got %s", tc.expectedMessage, message)
 			}
+			if tc.expectedErrorFields != nil {
+				content := responseBody["content"].(map[string]interface{})
+				fieldErrors := content["errors"].([]interface{})
+				if len(fieldErrors) != len(tc.expectedErrorFields) {
+					t.Fatalf("Expected %d field errors, got %v", len(tc.expectedErrorFields), fieldErrors)
+				}
+				for i, fieldError := range fieldErrors {
+					field := fieldError.(map[string]interface{})["field"]
+					if field != tc.expectedErrorFields[i] {
+						t.Errorf("Expected error on field %s, got %v", tc.expectedErrorFields[i], field)
+					}
+				}
+			}
 		})
 	}
 
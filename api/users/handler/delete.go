@@ -6,11 +6,14 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
 	"github.com/minand-mohan/library-app-api/api/response"
+	"github.com/minand-mohan/library-app-api/tracing"
 	"github.com/minand-mohan/library-app-api/utils"
 )
 
 func (handler *UserHandler) DeleteByUserId(ctx *fiber.Ctx) error {
-	log := utils.NewLogger()
+	log := utils.ContextLogger(ctx)
+	spanCtx, span := tracing.Start(ctx.UserContext(), "UserHandler.DeleteByUserId")
+	defer span.End()
 	log.Info("Delete user by id")
 	id := ctx.Params("id")
 	uuid, err := uuid.Parse(id)
@@ -28,7 +31,7 @@ func (handler *UserHandler) DeleteByUserId(ctx *fiber.Ctx) error {
 		}
 		return nil
 	}
-	responseBody, err := handler.service.DeleteByUserId(uuid)
+	responseBody, err := handler.service.DeleteByUserId(spanCtx, uuid, response.ParseIfMatch(ctx.Get(fiber.HeaderIfMatch)))
 	if err != nil {
 		log.Error(fmt.Sprintf("UserHandler: Error while deleting user by id %v", err))
 		err = response.WriteHTTPResponse(ctx, responseBody.Code, responseBody)
@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"io/ioutil"
@@ -19,6 +20,7 @@ func TestDeleteByUserId(t *testing.T) {
 	testCases := []struct {
 		name                      string
 		id                        string
+		ifMatch                   string
 		mockServiceExpectResponse *response.HTTPResponse
 		mockServiceExpectError    error
 		expectedStatus            int
@@ -56,6 +58,19 @@ func TestDeleteByUserId(t *testing.T) {
 			expectedStatus:         500,
 			expectedMessage:        "Internal server error",
 		},
+		{
+			name:    "Delete user by id at stale version",
+			id:      "d3b3b3b3-3b3b-3b3b-3b3b-3b3b3b3b3b3b",
+			ifMatch: `"1"`,
+			mockServiceExpectResponse: &response.HTTPResponse{
+				Code:    412,
+				Message: "Precondition failed, stale version",
+				Content: map[string]interface{}{},
+			},
+			mockServiceExpectError: errors.New("stale user version"),
+			expectedStatus:         412,
+			expectedMessage:        "Precondition failed, stale version",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -67,14 +82,23 @@ func TestDeleteByUserId(t *testing.T) {
 			app.Delete("/users/:id", func(c *fiber.Ctx) error {
 				logger := utils.NewLogger()
 				service := mocks.NewMockUserService(mockCtrl)
-				validator := validator.NewUserValidator(*logger)
+				validator := validator.NewUserValidator(testUserConfig, *logger)
 				if tc.mockServiceExpectResponse != nil {
-					service.EXPECT().DeleteByUserId(gomock.Any()).Return(tc.mockServiceExpectResponse, tc.mockServiceExpectError)
+					service.EXPECT().DeleteByUserId(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, id interface{}, ifMatch *response.IfMatch) (*response.HTTPResponse, error) {
+						// the handler hands the If-Match header on to the service
+						if tc.ifMatch != "" && (!ifMatch.Matches(1) || ifMatch.Matches(2)) {
+							t.Errorf("Expected If-Match %s to be passed on", tc.ifMatch)
+						}
+						return tc.mockServiceExpectResponse, tc.mockServiceExpectError
+					})
 				}
 				handler := NewUserHandler(service, validator)
 				return handler.DeleteByUserId(c)
 			})
 			request := httptest.NewRequest("DELETE", "/users/"+tc.id, nil)
+			if tc.ifMatch != "" {
+				request.Header.Set("If-Match", tc.ifMatch)
+			}
 
 			response, err := app.Test(request)
 			if err != nil {
@@ -1,6 +1,13 @@
 package handler
 
-import "github.com/gofiber/fiber/v2"
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/minand-mohan/library-app-api/system"
+)
+
+var testUserConfig = &system.UserConfig{
+	PhoneDefaultRegion: "US",
+}
 
 func setupApp() *fiber.App {
 	app := fiber.New()
@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/api/response"
+	"github.com/minand-mohan/library-app-api/api/users/dto"
+	"github.com/minand-mohan/library-app-api/tracing"
+	"github.com/minand-mohan/library-app-api/utils"
+)
+
+// Media type of a JSON merge patch (RFC 7396), plain JSON is accepted too
+const mergePatchContentType = "application/merge-patch+json"
+
+func (handler *UserHandler) PatchByUserId(ctx *fiber.Ctx) error {
+	log := utils.ContextLogger(ctx)
+	spanCtx, span := tracing.Start(ctx.UserContext(), "UserHandler.PatchByUserId")
+	defer span.End()
+	log.Info("Patch user by id")
+	id := ctx.Params("id")
+	uuid, err := uuid.Parse(id)
+	if err != nil {
+		log.Error(fmt.Sprintf("Error while parsing uuid %v", err))
+		responseBody := response.HTTPResponse{
+			Code:    400,
+			Message: "Bad request, invalid id",
+			Content: map[string]interface{}{},
+		}
+		err = response.WriteHTTPResponse(ctx, 400, &responseBody)
+		if err != nil {
+			log.Error(fmt.Sprintf("Error while writing response %v", err))
+			return err
+		}
+		return nil
+	}
+	contentType := strings.TrimSpace(strings.Split(string(ctx.Request().Header.ContentType()), ";")[0])
+	if contentType != mergePatchContentType && contentType != fiber.MIMEApplicationJSON {
+		log.Error(fmt.Sprintf("Unsupported content type %s", contentType))
+		responseBody := response.HTTPResponse{
+			Code:    415,
+			Message: "Unsupported media type",
+			Content: map[string]interface{}{},
+		}
+		err = response.WriteHTTPResponse(ctx, 415, &responseBody)
+		if err != nil {
+			log.Error(fmt.Sprintf("Error while writing response %v", err))
+			return err
+		}
+		return nil
+	}
+	patch := &dto.UserPatchBody{}
+	err = json.Unmarshal(ctx.Request().Body(), patch)
+	if err != nil {
+		log.Error(fmt.Sprintf("Error while unmarshalling request body %v", err))
+		responseBody := response.GetValidationErrorHTTPResponseBody(err)
+		err := response.WriteHTTPResponse(ctx, 400, responseBody)
+		if err != nil {
+			log.Error(fmt.Sprintf("Error while writing response %v", err))
+			return err
+		}
+		return nil
+	}
+	err = handler.validator.ValidateUserPatch(patch)
+	if err != nil {
+		log.Error(fmt.Sprintf("Error while validating request body %v", err))
+		responseBody := response.GetValidationErrorHTTPResponseBody(err)
+		err = response.WriteHTTPResponse(ctx, 400, responseBody)
+		if err != nil {
+			log.Error(fmt.Sprintf("Error while writing response %v", err))
+			return err
+		}
+		return nil
+	}
+	responseBody, err := handler.service.PatchByUserId(spanCtx, uuid, patch, response.ParseIfMatch(ctx.Get(fiber.HeaderIfMatch)))
+	if err != nil {
+		log.Error(fmt.Sprintf("UserHandler: Error while patching user by id %v", err))
+		err = response.WriteHTTPResponse(ctx, responseBody.Code, responseBody)
+		if err != nil {
+			log.Error(fmt.Sprintf("Error while writing response %v", err))
+			return err
+		}
+		return nil
+	}
+	err = response.WriteHTTPResponse(ctx, 200, responseBody)
+	if err != nil {
+		log.Error(fmt.Sprintf("Error while writing response %v", err))
+		return err
+	}
+	return nil
+}
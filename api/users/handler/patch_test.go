@@ -0,0 +1,176 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/minand-mohan/library-app-api/api/response"
+	"github.com/minand-mohan/library-app-api/api/users/dto"
+	servicemocks "github.com/minand-mohan/library-app-api/api/users/service/mocks"
+	validatormocks "github.com/minand-mohan/library-app-api/api/users/validator/mocks"
+)
+
+func TestPatchByUserId(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	app := fiber.New()
+	mockService := servicemocks.NewMockUserService(mockCtrl)
+	mockValidator := validatormocks.NewMockUserValidator(mockCtrl)
+	handler := NewUserHandler(mockService, mockValidator)
+	app.Patch("/users/:id", handler.PatchByUserId)
+
+	test_cases_that_require_validation := map[string]bool{
+		"Patch user with valid patch":   true,
+		"Patch user with null member":   true,
+		"Patch user with service error": true,
+		"Patch user with plain JSON":    true,
+	}
+
+	testCases := []struct {
+		name                      string
+		id                        string
+		contentType               string
+		requestBody               string
+		expectedFields            []string
+		mockServiceExpectResponse *response.HTTPResponse
+		mockServiceExpectError    error
+		mockValidatorExpectError  error
+		expectedStatus            int
+		expectedMessage           string
+	}{
+		{
+			name:           "Patch user with valid patch",
+			id:             "d3b3b3b3-3b3b-3b3b-3b3b-3b3b3b3b3b3b",
+			contentType:    "application/merge-patch+json",
+			requestBody:    `{"email": "new@example.com"}`,
+			expectedFields: []string{"email"},
+			mockServiceExpectResponse: &response.HTTPResponse{
+				Code:    200,
+				Message: "User updated successfully",
+				Content: map[string]interface{}{},
+			},
+			expectedStatus:  200,
+			expectedMessage: "User updated successfully",
+		},
+		{
+			name:           "Patch user with plain JSON",
+			id:             "d3b3b3b3-3b3b-3b3b-3b3b-3b3b3b3b3b3b",
+			contentType:    "application/json; charset=utf-8",
+			requestBody:    `{"phone": "0987654321", "username": "patched"}`,
+			expectedFields: []string{"phone", "username"},
+			mockServiceExpectResponse: &response.HTTPResponse{
+				Code:    200,
+				Message: "User updated successfully",
+				Content: map[string]interface{}{},
+			},
+			expectedStatus:  200,
+			expectedMessage: "User updated successfully",
+		},
+		{
+			name:                     "Patch user with null member",
+			id:                       "d3b3b3b3-3b3b-3b3b-3b3b-3b3b3b3b3b3b",
+			contentType:              "application/merge-patch+json",
+			requestBody:              `{"phone": null}`,
+			expectedFields:           []string{"phone"},
+			mockValidatorExpectError: errors.New("Phone is empty"),
+			expectedStatus:           400,
+			expectedMessage:          "Bad request, invalid request body",
+		},
+		{
+			name:            "Patch user with invalid id",
+			id:              "invalid-id",
+			contentType:     "application/merge-patch+json",
+			requestBody:     `{"email": "new@example.com"}`,
+			expectedStatus:  400,
+			expectedMessage: "Bad request, invalid id",
+		},
+		{
+			name:            "Patch user with unsupported content type",
+			id:              "d3b3b3b3-3b3b-3b3b-3b3b-3b3b3b3b3b3b",
+			contentType:     "text/plain",
+			requestBody:     `{"email": "new@example.com"}`,
+			expectedStatus:  415,
+			expectedMessage: "Unsupported media type",
+		},
+		{
+			name:            "Patch user with non-object patch",
+			id:              "d3b3b3b3-3b3b-3b3b-3b3b-3b3b3b3b3b3b",
+			contentType:     "application/merge-patch+json",
+			requestBody:     `["email"]`,
+			expectedStatus:  400,
+			expectedMessage: "Bad request, invalid request body",
+		},
+		{
+			name:            "Patch user with mistyped member",
+			id:              "d3b3b3b3-3b3b-3b3b-3b3b-3b3b3b3b3b3b",
+			contentType:     "application/merge-patch+json",
+			requestBody:     `{"phone": 1234567890}`,
+			expectedStatus:  400,
+			expectedMessage: "Bad request, invalid request body",
+		},
+		{
+			name:           "Patch user with service error",
+			id:             "d3b3b3b3-3b3b-3b3b-3b3b-3b3b3b3b3b3b",
+			contentType:    "application/merge-patch+json",
+			requestBody:    `{"username": "taken"}`,
+			expectedFields: []string{"username"},
+			mockServiceExpectResponse: &response.HTTPResponse{
+				Code:    400,
+				Message: "Bad request, non-unique values",
+				Content: map[string]interface{}{},
+			},
+			mockServiceExpectError: errors.New("duplicate key value violates unique constraint"),
+			expectedStatus:         400,
+			expectedMessage:        "Bad request, non-unique values",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if test_cases_that_require_validation[tc.name] {
+				mockValidator.EXPECT().ValidateUserPatch(gomock.Any()).DoAndReturn(func(patch *dto.UserPatchBody) error {
+					if fmt.Sprint(patch.Fields) != fmt.Sprint(tc.expectedFields) {
+						t.Errorf("Expected patched fields %v, got %v", tc.expectedFields, patch.Fields)
+					}
+					return tc.mockValidatorExpectError
+				})
+			}
+			if tc.mockServiceExpectResponse != nil {
+				mockService.EXPECT().PatchByUserId(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(tc.mockServiceExpectResponse, tc.mockServiceExpectError)
+			}
+
+			request := httptest.NewRequest("PATCH", fmt.Sprintf("/users/%s", tc.id), strings.NewReader(tc.requestBody))
+			request.Header.Set("Content-Type", tc.contentType)
+
+			response, err := app.Test(request)
+			if err != nil {
+				t.Errorf("Error while making request: %v", err)
+			}
+			if response.StatusCode != tc.expectedStatus {
+				t.Errorf("Expected status code %d, got %d", tc.expectedStatus, response.StatusCode)
+			}
+
+			bodyBytes, err := ioutil.ReadAll(response.Body)
+			if err != nil {
+				t.Errorf("Error while reading response body: %v", err)
+			}
+			var responseBody map[string]interface{}
+			err = json.Unmarshal(bodyBytes, &responseBody)
+			if err != nil {
+				t.Errorf("Error while parsing response body: %v", err)
+			}
+			message := responseBody["message"].(string)
+			if message != tc.expectedMessage {
+				t.Errorf("Expected message %s, got %s", tc.expectedMessage, message)
+			}
+		})
+	}
+}
@@ -7,11 +7,14 @@ import (
 	"github.com/google/uuid"
 	"github.com/minand-mohan/library-app-api/api/response"
 	"github.com/minand-mohan/library-app-api/api/users/dto"
+	"github.com/minand-mohan/library-app-api/tracing"
 	"github.com/minand-mohan/library-app-api/utils"
 )
 
 func (handler *UserHandler) FindAllUsers(ctx *fiber.Ctx) error {
-	log := utils.NewLogger()
+	log := utils.ContextLogger(ctx)
+	spanCtx, span := tracing.Start(ctx.UserContext(), "UserHandler.FindAllUsers")
+	defer span.End()
 	log.Info("Find all users")
 
 	queryParams := new(dto.UserQueryParams)
@@ -31,6 +34,8 @@ func (handler *UserHandler) FindAllUsers(ctx *fiber.Ctx) error {
 		return nil
 	}
 
+	queryParams.BaseURL = ctx.BaseURL() + ctx.Path()
+
 	err = handler.validator.ValidateUserQueryParams(queryParams)
 	if err != nil {
 		log.Error(fmt.Sprintf("Error while validating query params %v", err))
@@ -47,7 +52,7 @@ func (handler *UserHandler) FindAllUsers(ctx *fiber.Ctx) error {
 		return nil
 	}
 
-	responseBody, err := handler.service.FindAllUsers(queryParams)
+	responseBody, err := handler.service.FindAllUsers(spanCtx, queryParams)
 	if err != nil {
 		log.Error(fmt.Sprintf("UserHandler: Error while finding all users %v", err))
 		err = response.WriteHTTPResponse(ctx, responseBody.Code, responseBody)
@@ -66,7 +71,9 @@ func (handler *UserHandler) FindAllUsers(ctx *fiber.Ctx) error {
 }
 
 func (handler *UserHandler) FindByUserId(ctx *fiber.Ctx) error {
-	log := utils.NewLogger()
+	log := utils.ContextLogger(ctx)
+	spanCtx, span := tracing.Start(ctx.UserContext(), "UserHandler.FindByUserId")
+	defer span.End()
 	log.Info("Find user by id")
 	id := ctx.Params("id")
 	uuid, err := uuid.Parse(id)
@@ -84,7 +91,7 @@ func (handler *UserHandler) FindByUserId(ctx *fiber.Ctx) error {
 		}
 		return nil
 	}
-	responseBody, err := handler.service.FindByUserId(uuid)
+	responseBody, err := handler.service.FindByUserId(spanCtx, uuid)
 	if err != nil {
 		log.Error(fmt.Sprintf("UserHandler: Error while finding user by id %v", err))
 		err = response.WriteHTTPResponse(ctx, responseBody.Code, responseBody)
@@ -74,7 +74,7 @@ func TestFindAllUsers(t *testing.T) {
 				validator := validatormocks.NewMockUserValidator(mockCtrl)
 				service := servicemocks.NewMockUserService(mockCtrl)
 				if tc.mockServiceExpectResponse != nil {
-					service.EXPECT().FindAllUsers(gomock.Any()).Return(tc.mockServiceExpectResponse, tc.mockServiceExpectError)
+					service.EXPECT().FindAllUsers(gomock.Any(), gomock.Any()).Return(tc.mockServiceExpectResponse, tc.mockServiceExpectError)
 				}
 				validator.EXPECT().ValidateUserQueryParams(gomock.Any()).Return(tc.mockValidatorExpectError)
 				handler := NewUserHandler(service, validator)
@@ -122,6 +122,7 @@ func TestFindByUserId(t *testing.T) {
 		mockServiceExpectError    error
 		expectedStatus            int
 		expectedMessage           string
+		expectedETag              string
 	}{
 		{
 			name: "Find user by id with valid id",
@@ -130,10 +131,12 @@ func TestFindByUserId(t *testing.T) {
 				Code:    200,
 				Message: "User found successfully",
 				Content: map[string]interface{}{},
+				ETag:    `"4"`,
 			},
 			mockServiceExpectError: nil,
 			expectedStatus:         200,
 			expectedMessage:        "User found successfully",
+			expectedETag:           `"4"`,
 		},
 		{
 			name:                      "Find user by id with invalid id",
@@ -166,9 +169,9 @@ func TestFindByUserId(t *testing.T) {
 			app.Get("/users/:id", func(c *fiber.Ctx) error {
 				logger := utils.NewLogger()
 				service := servicemocks.NewMockUserService(mockCtrl)
-				validator := validator.NewUserValidator(*logger)
+				validator := validator.NewUserValidator(testUserConfig, *logger)
 				if tc.mockServiceExpectResponse != nil {
-					service.EXPECT().FindByUserId(gomock.Any()).Return(tc.mockServiceExpectResponse, tc.mockServiceExpectError)
+					service.EXPECT().FindByUserId(gomock.Any(), gomock.Any()).Return(tc.mockServiceExpectResponse, tc.mockServiceExpectError)
 				}
 				handler := NewUserHandler(service, validator)
 				return handler.FindByUserId(c)
@@ -183,6 +186,9 @@ func TestFindByUserId(t *testing.T) {
 			if response.StatusCode != tc.expectedStatus {
 				t.Errorf("Expected status code %d, got %d", tc.expectedStatus, response.StatusCode)
 			}
+			if etag := response.Header.Get("ETag"); etag != tc.expectedETag {
+				t.Errorf("Expected ETag %s, got %s", tc.expectedETag, etag)
+			}
 
 			bodyBytes, err := ioutil.ReadAll(response.Body)
 			if err != nil {
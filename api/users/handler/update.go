@@ -1,18 +1,20 @@
 package handler
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
 	"github.com/minand-mohan/library-app-api/api/response"
 	"github.com/minand-mohan/library-app-api/api/users/dto"
+	"github.com/minand-mohan/library-app-api/tracing"
 	"github.com/minand-mohan/library-app-api/utils"
 )
 
 func (handler *UserHandler) UpdateByUserId(ctx *fiber.Ctx) error {
-	log := utils.NewLogger()
+	log := utils.ContextLogger(ctx)
+	spanCtx, span := tracing.Start(ctx.UserContext(), "UserHandler.UpdateByUserId")
+	defer span.End()
 	log.Info("Update user by id")
 	id := ctx.Params("id")
 	uuid, err := uuid.Parse(id)
@@ -30,16 +32,12 @@ func (handler *UserHandler) UpdateByUserId(ctx *fiber.Ctx) error {
 		}
 		return nil
 	}
-	var userReq *dto.UserRequestBody
-	err = json.Unmarshal(ctx.Request().Body(), &userReq)
+	userReq := &dto.UserRequestBody{}
+	err = response.DecodeJSONObject(ctx.Request().Body(), userReq)
 	if err != nil {
 		log.Error(fmt.Sprintf("Error while unmarshalling request body %v", err))
-		responseBody := response.HTTPResponse{
-			Code:    400,
-			Message: "Bad request, invalid request body",
-			Content: map[string]interface{}{},
-		}
-		err := response.WriteHTTPResponse(ctx, 400, &responseBody)
+		responseBody := response.GetValidationErrorHTTPResponseBody(err)
+		err := response.WriteHTTPResponse(ctx, 400, responseBody)
 		if err != nil {
 			log.Error(fmt.Sprintf("Error while writing response %v", err))
 			return err
@@ -49,19 +47,15 @@ func (handler *UserHandler) UpdateByUserId(ctx *fiber.Ctx) error {
 	err = handler.validator.ValidateUser(userReq)
 	if err != nil {
 		log.Error(fmt.Sprintf("Error while validating request body %v", err))
-		responseBody := response.HTTPResponse{
-			Code:    400,
-			Message: "Bad request, invalid request body",
-			Content: map[string]interface{}{},
-		}
-		err = response.WriteHTTPResponse(ctx, 400, &responseBody)
+		responseBody := response.GetValidationErrorHTTPResponseBody(err)
+		err = response.WriteHTTPResponse(ctx, 400, responseBody)
 		if err != nil {
 			log.Error(fmt.Sprintf("Error while writing response %v", err))
 			return err
 		}
 		return nil
 	}
-	responseBody, err := handler.service.UpdateByUserId(uuid, userReq)
+	responseBody, err := handler.service.UpdateByUserId(spanCtx, uuid, userReq, response.ParseIfMatch(ctx.Get(fiber.HeaderIfMatch)))
 	if err != nil {
 		log.Error(fmt.Sprintf("UserHandler: Error while updating user by id %v", err))
 		err = response.WriteHTTPResponse(ctx, responseBody.Code, responseBody)
@@ -114,7 +114,7 @@ func TestUpdateByUserId(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			// Reset the mock service expectations
 			if tc.mockServiceExpectResponse != nil {
-				mockService.EXPECT().UpdateByUserId(gomock.Any(), gomock.Any()).Return(tc.mockServiceExpectResponse, tc.mockServiceExpectError)
+				mockService.EXPECT().UpdateByUserId(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(tc.mockServiceExpectResponse, tc.mockServiceExpectError)
 			}
 			if tc.name != "Update user with invalid id" {
 				mockValidator.EXPECT().ValidateUser(gomock.Any()).Return(tc.mockValidatorExpectError)
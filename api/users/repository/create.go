@@ -1,12 +1,20 @@
 package repository
 
-import "github.com/minand-mohan/library-app-api/database/models"
+import (
+	"context"
+
+	"github.com/minand-mohan/library-app-api/database/dberrors"
+	"github.com/minand-mohan/library-app-api/database/models"
+	"github.com/minand-mohan/library-app-api/tracing"
+)
 
 // CreateUser creates a new user
-func (repo *UserRepositoryImpl) CreateUser(userObj *models.User) error {
-	result := repo.db.Create(&userObj)
+func (repo *UserRepositoryImpl) CreateUser(ctx context.Context, userObj *models.User) error {
+	ctx, span := tracing.Start(ctx, "UserRepository.CreateUser")
+	defer span.End()
+	result := repo.db.WithContext(ctx).Create(&userObj)
 	if result.Error != nil {
-		return result.Error
+		return dberrors.Translate(result.Error)
 	}
 	return nil
 }
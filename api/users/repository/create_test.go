@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"regexp"
 	"testing"
 
@@ -30,10 +31,10 @@ func TestCreateUser(t *testing.T) {
 				Username: &test_username,
 			},
 			mockFunction: func(mock sqlmock.Sqlmock, user *models.User) error {
-				query := regexp.QuoteMeta(`INSERT INTO "users" ("username","email","phone") VALUES ($1,$2,$3) RETURNING "id"`)
+				query := regexp.QuoteMeta(`INSERT INTO "users" ("username","email","phone","version","deleted_at") VALUES ($1,$2,$3,$4,$5) RETURNING "id"`)
 				mock.ExpectBegin()
 				mock.ExpectQuery(query).
-					WithArgs(*user.Username, *user.Email, *user.Phone).
+					WithArgs(*user.Username, *user.Email, *user.Phone, 1, nil).
 					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(test_id))
 				mock.ExpectCommit()
 				return nil
@@ -49,10 +50,10 @@ func TestCreateUser(t *testing.T) {
 			},
 			mockFunction: func(mock sqlmock.Sqlmock, user *models.User) error {
 				err := sqlmock.ErrCancelled
-				query := regexp.QuoteMeta(`INSERT INTO "users" ("username","email","phone") VALUES ($1,$2,$3) RETURNING "id"`)
+				query := regexp.QuoteMeta(`INSERT INTO "users" ("username","email","phone","version","deleted_at") VALUES ($1,$2,$3,$4,$5) RETURNING "id"`)
 				mock.ExpectBegin()
 				mock.ExpectQuery(query).
-					WithArgs(*user.Username, *user.Email, *user.Phone).
+					WithArgs(*user.Username, *user.Email, *user.Phone, 1, nil).
 					WillReturnError(err)
 				mock.ExpectRollback()
 				return err
@@ -65,7 +66,7 @@ func TestCreateUser(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mock, userRepository := createUserRepository()
 			tt.mockFunction(mock, tt.user)
-			err := userRepository.CreateUser(tt.user)
+			err := userRepository.CreateUser(context.Background(), tt.user)
 			if err != tt.expectedError {
 				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
 			}
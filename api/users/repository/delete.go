@@ -1,16 +1,48 @@
 package repository
 
 import (
+	"context"
+	"time"
+
 	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/database/dberrors"
 	"github.com/minand-mohan/library-app-api/database/models"
+	"github.com/minand-mohan/library-app-api/tracing"
+	"gorm.io/gorm"
 )
 
-// DeleteByUserId deletes a user by id
-func (repo *UserRepositoryImpl) DeleteByUserId(id uuid.UUID) error {
-	var user models.User
-	result := repo.db.Delete(&user, id)
+// DeleteByUserId soft deletes a user by id, as long as it is still at
+// version, and bumps the version
+func (repo *UserRepositoryImpl) DeleteByUserId(ctx context.Context, id uuid.UUID, version int64) error {
+	ctx, span := tracing.Start(ctx, "UserRepository.DeleteByUserId")
+	defer span.End()
+	result := repo.db.WithContext(ctx).Model(&models.User{}).
+		Where("version = ?", version).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"deleted_at": time.Now(),
+			"version":    gorm.Expr("version + 1"),
+		})
+	if result.Error != nil {
+		return dberrors.Translate(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return dberrors.ErrStaleVersion
+	}
+	return nil
+}
+
+// RestoreByUserId brings back a soft deleted user and bumps their version
+func (repo *UserRepositoryImpl) RestoreByUserId(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "UserRepository.RestoreByUserId")
+	defer span.End()
+	result := repo.db.WithContext(ctx).Unscoped().Model(&models.User{}).Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"deleted_at": nil,
+			"version":    gorm.Expr("version + 1"),
+		})
 	if result.Error != nil {
-		return result.Error
+		return dberrors.Translate(result.Error)
 	}
 	return nil
 }
@@ -1,10 +1,12 @@
 package repository
 
 import (
+	"context"
 	"regexp"
 	"testing"
 
 	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/database/dberrors"
 	"gopkg.in/DATA-DOG/go-sqlmock.v1"
 )
 
@@ -20,10 +22,10 @@ func TestDeleteUser(t *testing.T) {
 			name: "Delete User by id successfully",
 			id:   uuid.New(),
 			mockFunction: func(mock sqlmock.Sqlmock, id uuid.UUID) error {
-				query := regexp.QuoteMeta(`DELETE FROM "users" WHERE "users"."id" = $1`)
+				query := regexp.QuoteMeta(`UPDATE "users" SET "deleted_at"=$1,"version"=version + 1 WHERE version = $2 AND id = $3 AND "users"."deleted_at" IS NULL`)
 				mock.ExpectBegin()
 				mock.ExpectExec(query).
-					WithArgs(id.String()).
+					WithArgs(sqlmock.AnyArg(), 1, id.String()).
 					WillReturnResult(sqlmock.NewResult(0, 1))
 				mock.ExpectCommit()
 				return nil
@@ -35,10 +37,75 @@ func TestDeleteUser(t *testing.T) {
 			id:   uuid.New(),
 			mockFunction: func(mock sqlmock.Sqlmock, id uuid.UUID) error {
 				err := sqlmock.ErrCancelled
-				query := regexp.QuoteMeta(`DELETE FROM "users" WHERE "users"."id" = $1`)
+				query := regexp.QuoteMeta(`UPDATE "users" SET "deleted_at"=$1,"version"=version + 1 WHERE version = $2 AND id = $3 AND "users"."deleted_at" IS NULL`)
 				mock.ExpectBegin()
 				mock.ExpectExec(query).
-					WithArgs(id.String()).
+					WithArgs(sqlmock.AnyArg(), 1, id.String()).
+					WillReturnError(err)
+				mock.ExpectRollback()
+				return err
+			},
+			expectedError: sqlmock.ErrCancelled,
+		},
+		{
+			name: "Delete User by id with stale version",
+			id:   uuid.New(),
+			mockFunction: func(mock sqlmock.Sqlmock, id uuid.UUID) error {
+				query := regexp.QuoteMeta(`UPDATE "users" SET "deleted_at"=$1,"version"=version + 1 WHERE version = $2 AND id = $3 AND "users"."deleted_at" IS NULL`)
+				mock.ExpectBegin()
+				mock.ExpectExec(query).
+					WithArgs(sqlmock.AnyArg(), 1, id.String()).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectCommit()
+				return dberrors.ErrStaleVersion
+			},
+			expectedError: dberrors.ErrStaleVersion,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, userRepository := createUserRepository()
+			tt.mockFunction(mock, tt.id)
+			err := userRepository.DeleteByUserId(context.Background(), tt.id, 1)
+			if err != tt.expectedError {
+				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestRestoreUser(t *testing.T) {
+
+	query := regexp.QuoteMeta(`UPDATE "users" SET "deleted_at"=$1,"version"=version + 1 WHERE id = $2`)
+
+	tc := []struct {
+		name          string
+		id            uuid.UUID
+		mockFunction  func(mock sqlmock.Sqlmock, id uuid.UUID) error
+		expectedError error
+	}{
+		{
+			name: "Restore User by id successfully",
+			id:   uuid.New(),
+			mockFunction: func(mock sqlmock.Sqlmock, id uuid.UUID) error {
+				mock.ExpectBegin()
+				mock.ExpectExec(query).
+					WithArgs(nil, id).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+				return nil
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Restore User by id with error",
+			id:   uuid.New(),
+			mockFunction: func(mock sqlmock.Sqlmock, id uuid.UUID) error {
+				err := sqlmock.ErrCancelled
+				mock.ExpectBegin()
+				mock.ExpectExec(query).
+					WithArgs(nil, id).
 					WillReturnError(err)
 				mock.ExpectRollback()
 				return err
@@ -51,7 +118,7 @@ func TestDeleteUser(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mock, userRepository := createUserRepository()
 			tt.mockFunction(mock, tt.id)
-			err := userRepository.DeleteByUserId(tt.id)
+			err := userRepository.RestoreByUserId(context.Background(), tt.id)
 			if err != tt.expectedError {
 				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
 			}
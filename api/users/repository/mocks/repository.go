@@ -1,6 +1,7 @@
 package mocks
 
 import (
+	"context"
 	"reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -31,78 +32,116 @@ func (m *MockUserRepository) EXPECT() *MockUserRepositoryMockRecorder {
 	return m.recorder
 }
 
-func (m *MockUserRepository) CreateUser(arg0 *models.User) error {
+func (m *MockUserRepository) CreateUser(arg0 context.Context, arg1 *models.User) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUser", arg0)
+	ret := m.ctrl.Call(m, "CreateUser", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-func (mr *MockUserRepositoryMockRecorder) CreateUser(arg0 interface{}) *gomock.Call {
+func (mr *MockUserRepositoryMockRecorder) CreateUser(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockUserRepository)(nil).CreateUser), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockUserRepository)(nil).CreateUser), arg0, arg1)
 }
 
-func (m *MockUserRepository) FindByEmailOrUsernameOrPhone(arg0 string, arg1 string, arg2 string) (*models.User, error) {
+func (m *MockUserRepository) FindByEmailOrUsernameOrPhone(arg0 context.Context, arg1 string, arg2 string, arg3 string) (*models.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByEmailOrUsernameOrPhone", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "FindByEmailOrUsernameOrPhone", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(*models.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-func (mr *MockUserRepositoryMockRecorder) FindByEmailOrUsernameOrPhone(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockUserRepositoryMockRecorder) FindByEmailOrUsernameOrPhone(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEmailOrUsernameOrPhone", reflect.TypeOf((*MockUserRepository)(nil).FindByEmailOrUsernameOrPhone), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEmailOrUsernameOrPhone", reflect.TypeOf((*MockUserRepository)(nil).FindByEmailOrUsernameOrPhone), arg0, arg1, arg2, arg3)
 }
 
-func (m *MockUserRepository) FindAllUsers(arg0 *dto.UserQueryParams) ([]models.User, error) {
+func (m *MockUserRepository) FindAllUsers(arg0 context.Context, arg1 *dto.UserQueryParams) ([]models.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindAllUsers", arg0)
+	ret := m.ctrl.Call(m, "FindAllUsers", arg0, arg1)
 	ret0, _ := ret[0].([]models.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-func (mr *MockUserRepositoryMockRecorder) FindAllUsers(arg0 interface{}) *gomock.Call {
+func (mr *MockUserRepositoryMockRecorder) FindAllUsers(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllUsers", reflect.TypeOf((*MockUserRepository)(nil).FindAllUsers), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllUsers", reflect.TypeOf((*MockUserRepository)(nil).FindAllUsers), arg0, arg1)
 }
 
-func (m *MockUserRepository) FindByUserId(arg0 uuid.UUID) (*models.User, error) {
+func (m *MockUserRepository) FindByUserId(arg0 context.Context, arg1 uuid.UUID) (*models.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByUserId", arg0)
+	ret := m.ctrl.Call(m, "FindByUserId", arg0, arg1)
 	ret0, _ := ret[0].(*models.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-func (mr *MockUserRepositoryMockRecorder) FindByUserId(arg0 interface{}) *gomock.Call {
+func (mr *MockUserRepositoryMockRecorder) FindByUserId(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserId", reflect.TypeOf((*MockUserRepository)(nil).FindByUserId), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserId", reflect.TypeOf((*MockUserRepository)(nil).FindByUserId), arg0, arg1)
 }
 
-func (m *MockUserRepository) UpdateByUserId(arg0 uuid.UUID, arg1 *models.User) (*models.User, error) {
+func (m *MockUserRepository) UpdateByUserId(arg0 context.Context, arg1 uuid.UUID, arg2 *models.User, arg3 []string) (*models.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateByUserId", arg0, arg1)
+	ret := m.ctrl.Call(m, "UpdateByUserId", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(*models.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-func (mr *MockUserRepositoryMockRecorder) UpdateByUserId(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockUserRepositoryMockRecorder) UpdateByUserId(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateByUserId", reflect.TypeOf((*MockUserRepository)(nil).UpdateByUserId), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateByUserId", reflect.TypeOf((*MockUserRepository)(nil).UpdateByUserId), arg0, arg1, arg2, arg3)
 }
 
-func (m *MockUserRepository) DeleteByUserId(arg0 uuid.UUID) error {
+func (m *MockUserRepository) CountUsers(arg0 context.Context, arg1 *dto.UserQueryParams) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteByUserId", arg0)
+	ret := m.ctrl.Call(m, "CountUsers", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockUserRepositoryMockRecorder) CountUsers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUsers", reflect.TypeOf((*MockUserRepository)(nil).CountUsers), arg0, arg1)
+}
+
+func (m *MockUserRepository) FindByUserIdIncludingDeleted(arg0 context.Context, arg1 uuid.UUID) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserIdIncludingDeleted", arg0, arg1)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockUserRepositoryMockRecorder) FindByUserIdIncludingDeleted(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserIdIncludingDeleted", reflect.TypeOf((*MockUserRepository)(nil).FindByUserIdIncludingDeleted), arg0, arg1)
+}
+
+func (m *MockUserRepository) RestoreByUserId(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreByUserId", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockUserRepositoryMockRecorder) RestoreByUserId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreByUserId", reflect.TypeOf((*MockUserRepository)(nil).RestoreByUserId), arg0, arg1)
+}
+
+func (m *MockUserRepository) DeleteByUserId(arg0 context.Context, arg1 uuid.UUID, arg2 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByUserId", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-func (mr *MockUserRepositoryMockRecorder) DeleteByUserId(arg0 interface{}) *gomock.Call {
+func (mr *MockUserRepositoryMockRecorder) DeleteByUserId(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUserId", reflect.TypeOf((*MockUserRepository)(nil).DeleteByUserId), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUserId", reflect.TypeOf((*MockUserRepository)(nil).DeleteByUserId), arg0, arg1, arg2)
 }
@@ -1,41 +1,83 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/google/uuid"
 	"github.com/minand-mohan/library-app-api/api/users/dto"
+	"github.com/minand-mohan/library-app-api/database/dberrors"
 	"github.com/minand-mohan/library-app-api/database/models"
+	"github.com/minand-mohan/library-app-api/tracing"
 )
 
-// List all users
-func (repo *UserRepositoryImpl) FindAllUsers(queryParams *dto.UserQueryParams) ([]models.User, error) {
+// List a page of users in the requested order
+func (repo *UserRepositoryImpl) FindAllUsers(ctx context.Context, queryParams *dto.UserQueryParams) ([]models.User, error) {
+	ctx, span := tracing.Start(ctx, "UserRepository.FindAllUsers")
+	defer span.End()
 	var users []models.User
-	dbQuery := GenerateDbQueries(queryParams)
-	result := repo.db.
-		Where(dbQuery.Email).
-		Where(dbQuery.Username).
-		Find(&users)
+	query := GenerateDbQueries(repo.db.WithContext(ctx), queryParams)
+	if queryParams.After != "" {
+		query = query.Where("id > ?", queryParams.After)
+	} else if queryParams.Page > 1 {
+		query = query.Offset((queryParams.Page - 1) * queryParams.PageSize)
+	}
+	if queryParams.PageSize > 0 {
+		query = query.Limit(queryParams.PageSize)
+	}
+	result := GenerateDbOrder(query, queryParams).Find(&users)
 	if result.Error != nil {
-		return nil, result.Error
+		return nil, dberrors.Translate(result.Error)
 	}
 	return users, nil
 }
 
+// Count the users matching the filters, ignoring pagination
+func (repo *UserRepositoryImpl) CountUsers(ctx context.Context, queryParams *dto.UserQueryParams) (int64, error) {
+	ctx, span := tracing.Start(ctx, "UserRepository.CountUsers")
+	defer span.End()
+	var count int64
+	result := GenerateDbQueries(repo.db.WithContext(ctx).Model(&models.User{}), queryParams).Count(&count)
+	if result.Error != nil {
+		return 0, dberrors.Translate(result.Error)
+	}
+	return count, nil
+}
+
 // Retrieve a user by their ID
-func (repo *UserRepositoryImpl) FindByUserId(id uuid.UUID) (*models.User, error) {
+func (repo *UserRepositoryImpl) FindByUserId(ctx context.Context, id uuid.UUID) (*models.User, error) {
+	ctx, span := tracing.Start(ctx, "UserRepository.FindByUserId")
+	defer span.End()
+	var user models.User
+	result := repo.db.WithContext(ctx).First(&user, id)
+	if result.Error != nil {
+		return nil, dberrors.Translate(result.Error)
+	}
+	return &user, nil
+}
+
+// Retrieve a user by their ID whether or not they are deleted
+func (repo *UserRepositoryImpl) FindByUserIdIncludingDeleted(ctx context.Context, id uuid.UUID) (*models.User, error) {
+	ctx, span := tracing.Start(ctx, "UserRepository.FindByUserIdIncludingDeleted")
+	defer span.End()
 	var user models.User
-	result := repo.db.First(&user, id)
+	result := repo.db.WithContext(ctx).Unscoped().First(&user, id)
 	if result.Error != nil {
-		return nil, result.Error
+		return nil, dberrors.Translate(result.Error)
 	}
 	return &user, nil
 }
 
-// Used by create to check for any duplicate values
-func (repo *UserRepositoryImpl) FindByEmailOrUsernameOrPhone(email string, username string, phone string) (*models.User, error) {
+// Used by create to check for any duplicate values. Deleted users are
+// included since they keep their values reserved. Emails and usernames are
+// compared ignoring case; the phone number must be normalized to E.164 by
+// the validator, as it is stored.
+func (repo *UserRepositoryImpl) FindByEmailOrUsernameOrPhone(ctx context.Context, email string, username string, phone string) (*models.User, error) {
+	ctx, span := tracing.Start(ctx, "UserRepository.FindByEmailOrUsernameOrPhone")
+	defer span.End()
 	var user models.User
-	result := repo.db.First(&user, "email = ? OR username = ? OR phone = ?", email, username, phone)
+	result := repo.db.WithContext(ctx).Unscoped().First(&user, "lower(email) = lower(?) OR lower(username) = lower(?) OR phone = ?", email, username, phone)
 	if result.Error != nil {
-		return nil, result.Error
+		return nil, dberrors.Translate(result.Error)
 	}
 	return &user, nil
 }
@@ -1,8 +1,10 @@
 package repository
 
 import (
+	"context"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/minand-mohan/library-app-api/api/users/dto"
@@ -41,8 +43,77 @@ func TestFindAllUsers(t *testing.T) {
 			name:   "Find no users",
 			params: &dto.UserQueryParams{Username: "test 1234"},
 			mockFunction: func(mock sqlmock.Sqlmock, params dto.UserQueryParams) error {
-				query := regexp.QuoteMeta(`SELECT * FROM "users" WHERE username ILIKE '%test 1234%`)
+				query := regexp.QuoteMeta(`SELECT * FROM "users" WHERE "username" ILIKE $1 AND "users"."deleted_at" IS NULL`)
 				mock.ExpectQuery(query).
+					WithArgs("%test 1234%").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "username", "email", "phone"}))
+				return nil
+			},
+			expectedError: nil,
+			expectedList:  []models.User{},
+		},
+		{
+			name:   "Find a page of users",
+			params: &dto.UserQueryParams{Page: 3, PageSize: 2},
+			mockFunction: func(mock sqlmock.Sqlmock, params dto.UserQueryParams) error {
+				query := regexp.QuoteMeta(`SELECT * FROM "users" WHERE "users"."deleted_at" IS NULL ORDER BY "id" LIMIT 2 OFFSET 4`)
+				mock.ExpectQuery(query).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "username", "email", "phone"}).
+						AddRow(user1.ID, user1.Username, user1.Email, user1.Phone))
+				return nil
+			},
+			expectedError: nil,
+			expectedList:  []models.User{user1},
+		},
+		{
+			name:   "Find a page of users after a cursor",
+			params: &dto.UserQueryParams{After: user1.ID.String(), PageSize: 2},
+			mockFunction: func(mock sqlmock.Sqlmock, params dto.UserQueryParams) error {
+				query := regexp.QuoteMeta(`SELECT * FROM "users" WHERE id > $1 AND "users"."deleted_at" IS NULL ORDER BY "id" LIMIT 2`)
+				mock.ExpectQuery(query).
+					WithArgs(params.After).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "username", "email", "phone"}).
+						AddRow(user2.ID, user2.Username, user2.Email, user2.Phone))
+				return nil
+			},
+			expectedError: nil,
+			expectedList:  []models.User{user2},
+		},
+		{
+			name:   "Find users in requested order",
+			params: &dto.UserQueryParams{Sort: "-username,email"},
+			mockFunction: func(mock sqlmock.Sqlmock, params dto.UserQueryParams) error {
+				query := regexp.QuoteMeta(`SELECT * FROM "users" WHERE "users"."deleted_at" IS NULL ORDER BY "username" DESC,"email","id"`)
+				mock.ExpectQuery(query).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "username", "email", "phone"}).
+						AddRow(user2.ID, user2.Username, user2.Email, user2.Phone).
+						AddRow(user1.ID, user1.Username, user1.Email, user1.Phone))
+				return nil
+			},
+			expectedError: nil,
+			expectedList:  []models.User{user2, user1},
+		},
+		{
+			name:   "Find users including deleted",
+			params: &dto.UserQueryParams{IncludeDeleted: true},
+			mockFunction: func(mock sqlmock.Sqlmock, params dto.UserQueryParams) error {
+				query := regexp.QuoteMeta(`SELECT * FROM "users" ORDER BY "id"`) + "$"
+				mock.ExpectQuery(query).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "username", "email", "phone", "deleted_at"}).
+						AddRow(user1.ID, user1.Username, user1.Email, user1.Phone, nil).
+						AddRow(user2.ID, user2.Username, user2.Email, user2.Phone, time.Now()))
+				return nil
+			},
+			expectedError: nil,
+			expectedList:  []models.User{user1, user2},
+		},
+		{
+			name:   "Find users with injected filter value",
+			params: &dto.UserQueryParams{Email: "x' or '1'='1"},
+			mockFunction: func(mock sqlmock.Sqlmock, params dto.UserQueryParams) error {
+				query := regexp.QuoteMeta(`SELECT * FROM "users" WHERE "email" = $1 AND "users"."deleted_at" IS NULL ORDER BY "id"`)
+				mock.ExpectQuery(query).
+					WithArgs(params.Email).
 					WillReturnRows(sqlmock.NewRows([]string{"id", "username", "email", "phone"}))
 				return nil
 			},
@@ -54,8 +125,9 @@ func TestFindAllUsers(t *testing.T) {
 			params: &dto.UserQueryParams{Email: "test"},
 			mockFunction: func(mock sqlmock.Sqlmock, params dto.UserQueryParams) error {
 				err := sqlmock.ErrCancelled
-				query := regexp.QuoteMeta(`SELECT * FROM "users" WHERE email = 'test'`)
+				query := regexp.QuoteMeta(`SELECT * FROM "users" WHERE "email" = $1 AND "users"."deleted_at" IS NULL`)
 				mock.ExpectQuery(query).
+					WithArgs(params.Email).
 					WillReturnError(err)
 				return err
 			},
@@ -68,7 +140,7 @@ func TestFindAllUsers(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mock, userRepository := createUserRepository()
 			tt.mockFunction(mock, *tt.params)
-			users, err := userRepository.FindAllUsers(tt.params)
+			users, err := userRepository.FindAllUsers(context.Background(), tt.params)
 			if err != tt.expectedError {
 				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
 			}
@@ -79,6 +151,58 @@ func TestFindAllUsers(t *testing.T) {
 	}
 }
 
+func TestCountUsers(t *testing.T) {
+
+	tc := []struct {
+		name          string
+		params        *dto.UserQueryParams
+		mockFunction  func(mock sqlmock.Sqlmock)
+		expectedError error
+		expectedCount int64
+	}{
+		{
+			name:   "Count users successfully",
+			params: &dto.UserQueryParams{Username: "test", Page: 2, PageSize: 10},
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				query := regexp.QuoteMeta(`SELECT count(*) FROM "users" WHERE "username" ILIKE $1 AND "users"."deleted_at" IS NULL`)
+				mock.ExpectQuery(query).
+					WithArgs("%test%").
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(42))
+			},
+			expectedError: nil,
+			expectedCount: 42,
+		},
+		{
+			name:   "Count users with error",
+			params: &dto.UserQueryParams{},
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				query := regexp.QuoteMeta(`SELECT count(*) FROM "users" WHERE "users"."deleted_at" IS NULL`)
+				mock.ExpectQuery(query).
+					WillReturnError(sqlmock.ErrCancelled)
+			},
+			expectedError: sqlmock.ErrCancelled,
+			expectedCount: 0,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, userRepository := createUserRepository()
+			tt.mockFunction(mock)
+			count, err := userRepository.CountUsers(context.Background(), tt.params)
+			if err != tt.expectedError {
+				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
+			}
+			if count != tt.expectedCount {
+				t.Errorf("Expected count: %v, got: %v", tt.expectedCount, count)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Unmet expectations: %v", err)
+			}
+		})
+	}
+}
+
 func TestFindUserByID(t *testing.T) {
 
 	user := generateRandomUser01()
@@ -94,7 +218,7 @@ func TestFindUserByID(t *testing.T) {
 			name: "Find user by id successfully",
 			id:   *user.ID,
 			mockFunction: func(mock sqlmock.Sqlmock, id string) error {
-				query := regexp.QuoteMeta(`SELECT * FROM "users" WHERE "users"."id" = $1 ORDER BY "users"."id" LIMIT 1`)
+				query := regexp.QuoteMeta(`SELECT * FROM "users" WHERE "users"."id" = $1 AND "users"."deleted_at" IS NULL ORDER BY "users"."id" LIMIT 1`)
 				mock.ExpectQuery(query).
 					WithArgs(id).
 					WillReturnRows(sqlmock.NewRows([]string{"id", "username", "email", "phone"}).
@@ -109,7 +233,7 @@ func TestFindUserByID(t *testing.T) {
 			id:   *user.ID,
 			mockFunction: func(mock sqlmock.Sqlmock, id string) error {
 				err := sqlmock.ErrCancelled
-				query := regexp.QuoteMeta(`SELECT * FROM "users" WHERE "users"."id" = $1 ORDER BY "users"."id" LIMIT 1`)
+				query := regexp.QuoteMeta(`SELECT * FROM "users" WHERE "users"."id" = $1 AND "users"."deleted_at" IS NULL ORDER BY "users"."id" LIMIT 1`)
 				mock.ExpectQuery(query).
 					WithArgs(id).
 					WillReturnError(err)
@@ -124,7 +248,7 @@ func TestFindUserByID(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mock, userRepository := createUserRepository()
 			tt.mockFunction(mock, tt.id.String())
-			user, err := userRepository.FindByUserId(tt.id)
+			user, err := userRepository.FindByUserId(context.Background(), tt.id)
 			if err != tt.expectedError {
 				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
 			}
@@ -135,6 +259,52 @@ func TestFindUserByID(t *testing.T) {
 	}
 }
 
+func TestFindByUserIdIncludingDeleted(t *testing.T) {
+
+	user := generateRandomUser01()
+	query := regexp.QuoteMeta(`SELECT * FROM "users" WHERE "users"."id" = $1 ORDER BY "users"."id" LIMIT 1`)
+
+	tc := []struct {
+		name          string
+		mockFunction  func(mock sqlmock.Sqlmock)
+		expectedError error
+	}{
+		{
+			name: "Find deleted user by id successfully",
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(user.ID.String()).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "username", "email", "phone", "deleted_at"}).
+						AddRow(user.ID, user.Username, user.Email, user.Phone, time.Now()))
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Find deleted user by id with error",
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(user.ID.String()).
+					WillReturnError(sqlmock.ErrCancelled)
+			},
+			expectedError: sqlmock.ErrCancelled,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, userRepository := createUserRepository()
+			tt.mockFunction(mock)
+			foundUser, err := userRepository.FindByUserIdIncludingDeleted(context.Background(), *user.ID)
+			if err != tt.expectedError {
+				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
+			}
+			if err == nil && !foundUser.DeletedAt.Valid {
+				t.Errorf("Expected a deleted user, got: %v", foundUser)
+			}
+		})
+	}
+}
+
 func TestFindByEmailOrUsernameOrPhone(t *testing.T) {
 
 	outputUser := generateRandomUser01()
@@ -157,7 +327,7 @@ func TestFindByEmailOrUsernameOrPhone(t *testing.T) {
 				Phone:    &test_phone,
 			},
 			mockFunction: func(mock sqlmock.Sqlmock, user *models.User) error {
-				query := regexp.QuoteMeta(`SELECT * FROM "users" WHERE email = $1 OR username = $2 OR phone = $3 ORDER BY "users"."id" LIMIT 1`)
+				query := regexp.QuoteMeta(`SELECT * FROM "users" WHERE lower(email) = lower($1) OR lower(username) = lower($2) OR phone = $3 ORDER BY "users"."id" LIMIT 1`)
 				mock.ExpectQuery(query).
 					WithArgs(user.Email, user.Username, user.Phone).
 					WillReturnRows(sqlmock.NewRows([]string{"id", "username", "email", "phone"}).
@@ -175,7 +345,7 @@ func TestFindByEmailOrUsernameOrPhone(t *testing.T) {
 				Phone:    &test_phone,
 			},
 			mockFunction: func(mock sqlmock.Sqlmock, user *models.User) error {
-				query := regexp.QuoteMeta(`SELECT * FROM "users" WHERE email = $1 OR username = $2 OR phone = $3 ORDER BY "users"."id" LIMIT 1`)
+				query := regexp.QuoteMeta(`SELECT * FROM "users" WHERE lower(email) = lower($1) OR lower(username) = lower($2) OR phone = $3 ORDER BY "users"."id" LIMIT 1`)
 				mock.ExpectQuery(query).
 					WithArgs(user.Email, user.Username, user.Phone).
 					WillReturnRows(sqlmock.NewRows([]string{"id", "username", "email", "phone"}).
@@ -193,7 +363,7 @@ func TestFindByEmailOrUsernameOrPhone(t *testing.T) {
 				Phone:    outputUser.Phone,
 			},
 			mockFunction: func(mock sqlmock.Sqlmock, user *models.User) error {
-				query := regexp.QuoteMeta(`SELECT * FROM "users" WHERE email = $1 OR username = $2 OR phone = $3 ORDER BY "users"."id" LIMIT 1`)
+				query := regexp.QuoteMeta(`SELECT * FROM "users" WHERE lower(email) = lower($1) OR lower(username) = lower($2) OR phone = $3 ORDER BY "users"."id" LIMIT 1`)
 				mock.ExpectQuery(query).
 					WithArgs(user.Email, user.Username, user.Phone).
 					WillReturnRows(sqlmock.NewRows([]string{"id", "username", "email", "phone"}).
@@ -212,7 +382,7 @@ func TestFindByEmailOrUsernameOrPhone(t *testing.T) {
 			},
 			mockFunction: func(mock sqlmock.Sqlmock, user *models.User) error {
 				err := sqlmock.ErrCancelled
-				query := regexp.QuoteMeta(`SELECT * FROM "users" WHERE email = $1 OR username = $2 OR phone = $3 ORDER BY "users"."id" LIMIT 1`)
+				query := regexp.QuoteMeta(`SELECT * FROM "users" WHERE lower(email) = lower($1) OR lower(username) = lower($2) OR phone = $3 ORDER BY "users"."id" LIMIT 1`)
 				mock.ExpectQuery(query).
 					WithArgs(user.Email, user.Username, user.Phone).
 					WillReturnError(err)
@@ -227,7 +397,7 @@ func TestFindByEmailOrUsernameOrPhone(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mock, userRepository := createUserRepository()
 			tt.mockFunction(mock, tt.inputUser)
-			user, err := userRepository.FindByEmailOrUsernameOrPhone(*tt.inputUser.Email, *tt.inputUser.Username, *tt.inputUser.Phone)
+			user, err := userRepository.FindByEmailOrUsernameOrPhone(context.Background(), *tt.inputUser.Email, *tt.inputUser.Username, *tt.inputUser.Phone)
 			if err != tt.expectedError {
 				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
 			}
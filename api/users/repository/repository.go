@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/google/uuid"
 	"github.com/minand-mohan/library-app-api/api/users/dto"
 	"github.com/minand-mohan/library-app-api/database/models"
@@ -8,12 +10,15 @@ import (
 )
 
 type UserRepository interface {
-	CreateUser(userObj *models.User) error
-	FindByEmailOrUsernameOrPhone(email string, username string, phone string) (*models.User, error)
-	FindAllUsers(queryParams *dto.UserQueryParams) ([]models.User, error)
-	FindByUserId(id uuid.UUID) (*models.User, error)
-	UpdateByUserId(id uuid.UUID, user *models.User) (*models.User, error)
-	DeleteByUserId(id uuid.UUID) error
+	CreateUser(ctx context.Context, userObj *models.User) error
+	FindByEmailOrUsernameOrPhone(ctx context.Context, email string, username string, phone string) (*models.User, error)
+	FindAllUsers(ctx context.Context, queryParams *dto.UserQueryParams) ([]models.User, error)
+	CountUsers(ctx context.Context, queryParams *dto.UserQueryParams) (int64, error)
+	FindByUserId(ctx context.Context, id uuid.UUID) (*models.User, error)
+	FindByUserIdIncludingDeleted(ctx context.Context, id uuid.UUID) (*models.User, error)
+	UpdateByUserId(ctx context.Context, id uuid.UUID, user *models.User, fields []string) (*models.User, error)
+	DeleteByUserId(ctx context.Context, id uuid.UUID, version int64) error
+	RestoreByUserId(ctx context.Context, id uuid.UUID) error
 }
 
 type UserRepositoryImpl struct {
@@ -1,15 +1,47 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/database/dberrors"
 	"github.com/minand-mohan/library-app-api/database/models"
+	"github.com/minand-mohan/library-app-api/tracing"
+	"gorm.io/gorm"
 )
 
-// Update/Partial update a user by id
-func (repo *UserRepositoryImpl) UpdateByUserId(id uuid.UUID, user *models.User) (*models.User, error) {
-	result := repo.db.Model(&user).Where("id = ?", id).Updates(user)
+// Update/Partial update a user by id, only the columns in fields are written.
+// The version of the row is always bumped, and when the user carries a
+// version the update only applies to that version of the row and the user
+// is returned at the new version.
+func (repo *UserRepositoryImpl) UpdateByUserId(ctx context.Context, id uuid.UUID, user *models.User, fields []string) (*models.User, error) {
+	ctx, span := tracing.Start(ctx, "UserRepository.UpdateByUserId")
+	defer span.End()
+	columns := map[string]interface{}{
+		"username": user.Username,
+		"email":    user.Email,
+		"phone":    user.Phone,
+	}
+	values := map[string]interface{}{
+		"version": gorm.Expr("version + 1"),
+	}
+	for _, field := range fields {
+		values[field] = columns[field]
+	}
+	query := repo.db.WithContext(ctx).Model(&models.User{}).Where("id = ?", id)
+	if user.Version != nil {
+		query = query.Where("version = ?", *user.Version)
+	}
+	result := query.Updates(values)
 	if result.Error != nil {
-		return nil, result.Error
+		return nil, dberrors.Translate(result.Error)
+	}
+	if user.Version != nil {
+		if result.RowsAffected == 0 {
+			return nil, dberrors.ErrStaleVersion
+		}
+		version := *user.Version + 1
+		user.Version = &version
 	}
 	return user, nil
 }
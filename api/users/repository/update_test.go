@@ -1,10 +1,12 @@
 package repository
 
 import (
+	"context"
 	"regexp"
 	"testing"
 
 	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/database/dberrors"
 	"github.com/minand-mohan/library-app-api/database/models"
 	"gopkg.in/DATA-DOG/go-sqlmock.v1"
 )
@@ -14,11 +16,13 @@ func TestUpdateUser(t *testing.T) {
 	test_email := "test@example.com"
 	test_username := "test"
 	test_phone := "1234567890"
+	test_version := int64(3)
 
 	tc := []struct {
 		name          string
 		user          *models.User
 		id            uuid.UUID
+		fields        []string
 		mockFunction  func(mock sqlmock.Sqlmock, id uuid.UUID, user *models.User) error
 		expectedError error
 	}{
@@ -29,12 +33,13 @@ func TestUpdateUser(t *testing.T) {
 				Phone:    &test_phone,
 				Username: &test_username,
 			},
-			id: uuid.New(),
+			id:     uuid.New(),
+			fields: []string{"username", "email", "phone"},
 			mockFunction: func(mock sqlmock.Sqlmock, id uuid.UUID, user *models.User) error {
-				query := regexp.QuoteMeta(`UPDATE "users" SET "username"=$1,"email"=$2,"phone"=$3 WHERE id = $4`)
+				query := regexp.QuoteMeta(`UPDATE "users" SET "email"=$1,"phone"=$2,"username"=$3,"version"=version + 1 WHERE id = $4 AND "users"."deleted_at" IS NULL`)
 				mock.ExpectBegin()
 				mock.ExpectExec(query).
-					WithArgs(*user.Username, *user.Email, *user.Phone, id).
+					WithArgs(*user.Email, *user.Phone, *user.Username, id).
 					WillReturnResult(sqlmock.NewResult(0, 1))
 				mock.ExpectCommit()
 				return nil
@@ -48,13 +53,14 @@ func TestUpdateUser(t *testing.T) {
 				Phone:    &test_phone,
 				Username: &test_username,
 			},
-			id: uuid.New(),
+			id:     uuid.New(),
+			fields: []string{"username", "email", "phone"},
 			mockFunction: func(mock sqlmock.Sqlmock, id uuid.UUID, user *models.User) error {
 				err := sqlmock.ErrCancelled
-				query := regexp.QuoteMeta(`UPDATE "users" SET "username"=$1,"email"=$2,"phone"=$3 WHERE id = $4`)
+				query := regexp.QuoteMeta(`UPDATE "users" SET "email"=$1,"phone"=$2,"username"=$3,"version"=version + 1 WHERE id = $4 AND "users"."deleted_at" IS NULL`)
 				mock.ExpectBegin()
 				mock.ExpectExec(query).
-					WithArgs(*user.Username, *user.Email, *user.Phone, id).
+					WithArgs(*user.Email, *user.Phone, *user.Username, id).
 					WillReturnError(err)
 				mock.ExpectRollback()
 				return err
@@ -66,9 +72,10 @@ func TestUpdateUser(t *testing.T) {
 			user: &models.User{
 				Email: &test_email,
 			},
-			id: uuid.New(),
+			id:     uuid.New(),
+			fields: []string{"email"},
 			mockFunction: func(mock sqlmock.Sqlmock, id uuid.UUID, user *models.User) error {
-				query := regexp.QuoteMeta(`UPDATE "users" SET "email"=$1 WHERE id = $2`)
+				query := regexp.QuoteMeta(`UPDATE "users" SET "email"=$1,"version"=version + 1 WHERE id = $2`)
 				mock.ExpectBegin()
 				mock.ExpectExec(query).
 					WithArgs(*user.Email, id).
@@ -78,12 +85,70 @@ func TestUpdateUser(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		{
+			name: "User Update outside field mask",
+			user: &models.User{
+				Email:    &test_email,
+				Phone:    &test_phone,
+				Username: &test_username,
+			},
+			id:     uuid.New(),
+			fields: []string{"phone"},
+			mockFunction: func(mock sqlmock.Sqlmock, id uuid.UUID, user *models.User) error {
+				query := regexp.QuoteMeta(`UPDATE "users" SET "phone"=$1,"version"=version + 1 WHERE id = $2`)
+				mock.ExpectBegin()
+				mock.ExpectExec(query).
+					WithArgs(*user.Phone, id).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+				return nil
+			},
+			expectedError: nil,
+		},
+		{
+			name: "User Update at version",
+			user: &models.User{
+				Email:   &test_email,
+				Version: &test_version,
+			},
+			id:     uuid.New(),
+			fields: []string{"email"},
+			mockFunction: func(mock sqlmock.Sqlmock, id uuid.UUID, user *models.User) error {
+				query := regexp.QuoteMeta(`UPDATE "users" SET "email"=$1,"version"=version + 1 WHERE id = $2 AND version = $3`)
+				mock.ExpectBegin()
+				mock.ExpectExec(query).
+					WithArgs(*user.Email, id, *user.Version).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+				return nil
+			},
+			expectedError: nil,
+		},
+		{
+			name: "User Update at stale version",
+			user: &models.User{
+				Email:   &test_email,
+				Version: &test_version,
+			},
+			id:     uuid.New(),
+			fields: []string{"email"},
+			mockFunction: func(mock sqlmock.Sqlmock, id uuid.UUID, user *models.User) error {
+				query := regexp.QuoteMeta(`UPDATE "users" SET "email"=$1,"version"=version + 1 WHERE id = $2 AND version = $3`)
+				mock.ExpectBegin()
+				mock.ExpectExec(query).
+					WithArgs(*user.Email, id, *user.Version).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectCommit()
+				return dberrors.ErrStaleVersion
+			},
+			expectedError: dberrors.ErrStaleVersion,
+		},
 	}
 	for _, tt := range tc {
 		t.Run(tt.name, func(t *testing.T) {
 			mock, userRepository := createUserRepository()
 			tt.mockFunction(mock, tt.id, tt.user)
-			_, err := userRepository.UpdateByUserId(tt.id, tt.user)
+			_, err := userRepository.UpdateByUserId(context.Background(), tt.id, tt.user, tt.fields)
 			if err != tt.expectedError {
 				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
 			}
@@ -1,24 +1,45 @@
 package repository
 
 import (
-	"fmt"
+	"strings"
 
 	"github.com/minand-mohan/library-app-api/api/users/dto"
+	"github.com/minand-mohan/library-app-api/database/filters"
+	"gorm.io/gorm"
 )
 
-type UserDbQueries struct {
-	Email    string
-	Username string
-}
+// Columns users can be filtered on
+var userFilterColumns = []string{"id", "username", "email", "phone"}
 
-func GenerateDbQueries(queryParams *dto.UserQueryParams) UserDbQueries {
-	userDbQueries := UserDbQueries{}
+// GenerateDbQueries applies the filters from the query params to the db query
+func GenerateDbQueries(db *gorm.DB, queryParams *dto.UserQueryParams) *gorm.DB {
+	if queryParams == nil {
+		return db
+	}
+	if queryParams.IncludeDeleted {
+		db = db.Unscoped()
+	}
+	builder := filters.NewBuilder(userFilterColumns...)
 	if queryParams.Email != "" {
-		userDbQueries.Email = fmt.Sprintf("email = '%s'", queryParams.Email)
+		// emails are stored lowercase
+		builder.Eq("email", strings.ToLower(queryParams.Email))
 	}
 	if queryParams.Username != "" {
-		userDbQueries.Username = fmt.Sprintf("username ILIKE '%%%s%%'", queryParams.Username)
+		builder.Contains("username", queryParams.Username)
 	}
+	return builder.Apply(db)
+}
 
-	return userDbQueries
+// GenerateDbOrder applies the sort from the query params to the db query.
+// Users are ordered by id last so that pages are stable.
+func GenerateDbOrder(db *gorm.DB, queryParams *dto.UserQueryParams) *gorm.DB {
+	builder := filters.NewBuilder(dto.UserSortFields...)
+	sortFields := filters.ParseSort(queryParams.Sort)
+	builder.Sort(sortFields)
+	for _, field := range sortFields {
+		if field.Column == "id" {
+			return builder.Apply(db)
+		}
+	}
+	return builder.OrderBy("id", false).Apply(db)
 }
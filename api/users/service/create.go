@@ -1,15 +1,21 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
 	"github.com/minand-mohan/library-app-api/api/response"
 	"github.com/minand-mohan/library-app-api/api/users/dto"
+	"github.com/minand-mohan/library-app-api/database/dberrors"
 	"github.com/minand-mohan/library-app-api/database/models"
+	"github.com/minand-mohan/library-app-api/metrics"
+	"github.com/minand-mohan/library-app-api/tracing"
 )
 
-func (service *UserServiceImpl) CreateUser(userReq *dto.UserRequestBody) (*response.HTTPResponse, error) {
+func (service *UserServiceImpl) CreateUser(ctx context.Context, userReq *dto.UserRequestBody) (*response.HTTPResponse, error) {
+	ctx, span := tracing.Start(ctx, "UserService.CreateUser")
+	defer span.End()
 	service.logger.Info("User Service: Create user")
 	userObj := &models.User{
 		Username: &userReq.Username,
@@ -17,7 +23,7 @@ func (service *UserServiceImpl) CreateUser(userReq *dto.UserRequestBody) (*respo
 		Phone:    &userReq.Phone,
 	}
 
-	existingUser, err := service.repo.FindByEmailOrUsernameOrPhone(*userObj.Email, *userObj.Username, *userObj.Phone)
+	existingUser, err := service.repo.FindByEmailOrUsernameOrPhone(ctx, *userObj.Email, *userObj.Username, *userObj.Phone)
 	if err == nil {
 		service.logger.Error(fmt.Sprintf("UserService: User with email %s, username %s or phone %s already exists", *userObj.Email, *userObj.Username, *userObj.Phone))
 		responseContent := map[string]interface{}{
@@ -33,17 +39,17 @@ func (service *UserServiceImpl) CreateUser(userReq *dto.UserRequestBody) (*respo
 		}
 		return &responseBody, errors.New("user already exists")
 	}
+	if !errors.Is(err, dberrors.ErrNotFound) {
+		service.logger.Error(fmt.Sprintf("UserService: Error while finding existing user: %s", err))
+		return response.GetRepositoryErrorHTTPResponseBody(err, "User not found."), err
+	}
 
-	err = service.repo.CreateUser(userObj)
+	err = service.repo.CreateUser(ctx, userObj)
 	if err != nil {
 		service.logger.Error(fmt.Sprintf("UserService: Error while creating user: %s", err))
-		responseBody := response.HTTPResponse{
-			Code:    500,
-			Message: "Internal Server Error",
-			Content: map[string]interface{}{},
-		}
-		return &responseBody, err
+		return response.GetRepositoryErrorHTTPResponseBody(err, "User not found."), err
 	}
+	metrics.UsersCreated.Inc()
 
 	responseContent := map[string]interface{}{
 		"id":       userObj.ID,
@@ -1,13 +1,16 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	finerepomocks "github.com/minand-mohan/library-app-api/api/fines/repository/mocks"
 	"github.com/minand-mohan/library-app-api/api/response"
 	"github.com/minand-mohan/library-app-api/api/users/dto"
 	repomocks "github.com/minand-mohan/library-app-api/api/users/repository/mocks"
+	"github.com/minand-mohan/library-app-api/database/dberrors"
 	"github.com/minand-mohan/library-app-api/database/models"
 	"github.com/minand-mohan/library-app-api/utils"
 )
@@ -50,7 +53,7 @@ func TestCreateUser(t *testing.T) {
 			},
 			expectedError:       nil,
 			mockFindUserReturn:  nil,
-			mockFindUserError:   errors.New("cannot find user"),
+			mockFindUserError:   dberrors.ErrNotFound,
 			mockCreateUserError: nil,
 		},
 		{
@@ -88,7 +91,7 @@ func TestCreateUser(t *testing.T) {
 			},
 			expectedError:       errors.New("Internal Server Error"),
 			mockFindUserReturn:  nil,
-			mockFindUserError:   errors.New("cannot find user"),
+			mockFindUserError:   dberrors.ErrNotFound,
 			mockCreateUserError: errors.New("Internal Server Error"),
 		},
 	}
@@ -102,15 +105,15 @@ func TestCreateUser(t *testing.T) {
 
 			mockRepo := repomocks.NewMockUserRepository(mockCtrl)
 			if test_cases_that_require_find_user[tt.name] {
-				mockRepo.EXPECT().FindByEmailOrUsernameOrPhone(tt.requestbody.Email, tt.requestbody.Username, tt.requestbody.Phone).Return(tt.mockFindUserReturn, tt.mockFindUserError)
+				mockRepo.EXPECT().FindByEmailOrUsernameOrPhone(gomock.Any(), tt.requestbody.Email, tt.requestbody.Username, tt.requestbody.Phone).Return(tt.mockFindUserReturn, tt.mockFindUserError)
 			}
 			if test_cases_that_require_create_user[tt.name] {
-				mockRepo.EXPECT().CreateUser(gomock.Any()).Return(tt.mockCreateUserError)
+				mockRepo.EXPECT().CreateUser(gomock.Any(), gomock.Any()).Return(tt.mockCreateUserError)
 			}
-			service := NewUserService(mockRepo, *logger)
+			service := NewUserService(mockRepo, finerepomocks.NewMockFineRepository(mockCtrl), *logger)
 
 			// invoke the method
-			response, err := service.CreateUser(tt.requestbody)
+			response, err := service.CreateUser(context.Background(), tt.requestbody)
 
 			// Assert
 			if err != nil && tt.expectedError != nil {
@@ -1,34 +1,35 @@
 package service
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/google/uuid"
 	"github.com/minand-mohan/library-app-api/api/response"
+	"github.com/minand-mohan/library-app-api/database/dberrors"
+	"github.com/minand-mohan/library-app-api/metrics"
+	"github.com/minand-mohan/library-app-api/tracing"
 )
 
-func (service *UserServiceImpl) DeleteByUserId(id uuid.UUID) (*response.HTTPResponse, error) {
+func (service *UserServiceImpl) DeleteByUserId(ctx context.Context, id uuid.UUID, ifMatch *response.IfMatch) (*response.HTTPResponse, error) {
+	ctx, span := tracing.Start(ctx, "UserService.DeleteByUserId")
+	defer span.End()
 	service.logger.Info("User Service: Delete user by id")
-	_, err := service.repo.FindByUserId(id)
+	user, err := service.repo.FindByUserId(ctx, id)
 	if err != nil {
 		service.logger.Error(fmt.Sprintf("UserService: Error while finding user by id: %s", err))
-		responseBody := response.HTTPResponse{
-			Code:    404,
-			Message: "User not found.",
-			Content: map[string]interface{}{},
-		}
-		return &responseBody, nil
+		return response.GetRepositoryErrorHTTPResponseBody(err, "User not found."), err
 	}
-	err = service.repo.DeleteByUserId(id)
+	if !ifMatch.Matches(*user.Version) {
+		service.logger.Error(fmt.Sprintf("UserService: Stale version %d of user %s", *user.Version, id))
+		return response.GetStaleVersionHTTPResponseBody(), dberrors.ErrStaleVersion
+	}
+	err = service.repo.DeleteByUserId(ctx, id, *user.Version)
 	if err != nil {
 		service.logger.Error(fmt.Sprintf("UserService: Error while deleting user: %s", err))
-		responseBody := response.HTTPResponse{
-			Code:    500,
-			Message: "Internal Server Error",
-			Content: map[string]interface{}{},
-		}
-		return &responseBody, err
+		return response.GetRepositoryErrorHTTPResponseBody(err, "User not found."), err
 	}
+	metrics.UsersDeleted.Inc()
 	responseBody := response.HTTPResponse{
 		Code:    200,
 		Message: "User deleted successfully",
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"reflect"
 	"testing"
@@ -9,23 +10,24 @@ import (
 	"github.com/google/uuid"
 	"github.com/minand-mohan/library-app-api/api/response"
 	repomocks "github.com/minand-mohan/library-app-api/api/users/repository/mocks"
+	"github.com/minand-mohan/library-app-api/database/dberrors"
 	"github.com/minand-mohan/library-app-api/utils"
 )
 
 func TestDeleteUser(t *testing.T) {
 
-	test_cases_that_require_find_user := map[string]bool{
-		"Delete User by id successfully": true,
-		"Cannot find user":               true,
-		"Delete User by id with error":   true,
-	}
+	test_user := generateRandomUser01()
+
 	test_cases_that_require_delete_user := map[string]bool{
-		"Delete User by id successfully": true,
-		"Delete User by id with error":   true,
+		"Delete User by id successfully":    true,
+		"Delete User by id with error":      true,
+		"Delete User at matching version":   true,
+		"Delete User modified concurrently": true,
 	}
 	tc := []struct {
 		name                string
 		id                  string
+		ifMatch             string
 		expectedResponse    *response.HTTPResponse
 		expectedError       error
 		mockDeleteUserError error
@@ -53,7 +55,7 @@ func TestDeleteUser(t *testing.T) {
 			},
 			expectedError:       nil,
 			mockDeleteUserError: nil,
-			mockFindUserError:   errors.New("User not found"),
+			mockFindUserError:   dberrors.ErrNotFound,
 		},
 		{
 			name: "Delete User by id with error",
@@ -67,6 +69,38 @@ func TestDeleteUser(t *testing.T) {
 			mockDeleteUserError: errors.New("Internal Server Error"),
 			mockFindUserError:   nil,
 		},
+		{
+			name:    "Delete User at matching version",
+			id:      "d3b3b3b3-3b3b-3b3b-3b3b-3b3b3b3b3b3b",
+			ifMatch: `"1"`,
+			expectedResponse: &response.HTTPResponse{
+				Code:    200,
+				Message: "User deleted successfully",
+				Content: map[string]interface{}{},
+			},
+		},
+		{
+			name:    "Delete User at stale version",
+			id:      "d3b3b3b3-3b3b-3b3b-3b3b-3b3b3b3b3b3b",
+			ifMatch: `"0", W/"1"`,
+			expectedResponse: &response.HTTPResponse{
+				Code:    412,
+				Message: "Precondition failed, stale version",
+				Content: map[string]interface{}{},
+			},
+			expectedError: dberrors.ErrStaleVersion,
+		},
+		{
+			name: "Delete User modified concurrently",
+			id:   "d3b3b3b3-3b3b-3b3b-3b3b-3b3b3b3b3b3b",
+			expectedResponse: &response.HTTPResponse{
+				Code:    412,
+				Message: "Precondition failed, stale version",
+				Content: map[string]interface{}{},
+			},
+			expectedError:       dberrors.ErrStaleVersion,
+			mockDeleteUserError: dberrors.ErrStaleVersion,
+		},
 	}
 
 	for _, tc := range tc {
@@ -77,18 +111,20 @@ func TestDeleteUser(t *testing.T) {
 			id, _ := uuid.Parse(tc.id)
 			mockRepo := repomocks.NewMockUserRepository(mockCtrl)
 
-			if test_cases_that_require_find_user[tc.name] {
-				mockRepo.EXPECT().FindByUserId(id).Return(nil, tc.mockFindUserError)
+			if tc.mockFindUserError != nil {
+				mockRepo.EXPECT().FindByUserId(gomock.Any(), id).Return(nil, tc.mockFindUserError)
+			} else {
+				mockRepo.EXPECT().FindByUserId(gomock.Any(), id).Return(&test_user, nil)
 			}
 			if test_cases_that_require_delete_user[tc.name] {
-				mockRepo.EXPECT().DeleteByUserId(id).Return(tc.mockDeleteUserError)
+				mockRepo.EXPECT().DeleteByUserId(gomock.Any(), id, *test_user.Version).Return(tc.mockDeleteUserError)
 			}
 			service := UserServiceImpl{
 				repo:   mockRepo,
 				logger: utils.NewLogger(),
 			}
 
-			response, err := service.DeleteByUserId(id)
+			response, err := service.DeleteByUserId(context.Background(), id, response.ParseIfMatch(tc.ifMatch))
 			if err != nil && tc.expectedError != nil {
 				if err.Error() != tc.expectedError.Error() {
 					t.Errorf("Expected error: %v, got: %v", tc.expectedError, err)
@@ -1,6 +1,7 @@
 package mocks
 
 import (
+	"context"
 	"reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -33,68 +34,94 @@ func (m *MockUserService) EXPECT() *MockUserServiceMockRecorder {
 }
 
 // CreateUser mocks base method.
-func (m *MockUserService) CreateUser(arg0 *dto.UserRequestBody) (*response.HTTPResponse, error) {
+func (m *MockUserService) CreateUser(arg0 context.Context, arg1 *dto.UserRequestBody) (*response.HTTPResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUser", arg0)
+	ret := m.ctrl.Call(m, "CreateUser", arg0, arg1)
 	ret0, _ := ret[0].(*response.HTTPResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateUser indicates an expected call of CreateUser.
-func (mr *MockUserServiceMockRecorder) CreateUser(arg0 interface{}) *gomock.Call {
+func (mr *MockUserServiceMockRecorder) CreateUser(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockUserService)(nil).CreateUser), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockUserService)(nil).CreateUser), arg0, arg1)
 }
 
-func (m *MockUserService) FindAllUsers(arg0 *dto.UserQueryParams) (*response.HTTPResponse, error) {
+func (m *MockUserService) FindAllUsers(arg0 context.Context, arg1 *dto.UserQueryParams) (*response.HTTPResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindAllUsers", arg0)
+	ret := m.ctrl.Call(m, "FindAllUsers", arg0, arg1)
 	ret0, _ := ret[0].(*response.HTTPResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-func (mr *MockUserServiceMockRecorder) FindAllUsers(arg0 interface{}) *gomock.Call {
+func (mr *MockUserServiceMockRecorder) FindAllUsers(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllUsers", reflect.TypeOf((*MockUserService)(nil).FindAllUsers), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllUsers", reflect.TypeOf((*MockUserService)(nil).FindAllUsers), arg0, arg1)
 }
 
-func (m *MockUserService) FindByUserId(arg0 uuid.UUID) (*response.HTTPResponse, error) {
+func (m *MockUserService) FindByUserId(arg0 context.Context, arg1 uuid.UUID) (*response.HTTPResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByUserId", arg0)
+	ret := m.ctrl.Call(m, "FindByUserId", arg0, arg1)
 	ret0, _ := ret[0].(*response.HTTPResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-func (mr *MockUserServiceMockRecorder) FindByUserId(arg0 interface{}) *gomock.Call {
+func (mr *MockUserServiceMockRecorder) FindByUserId(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserId", reflect.TypeOf((*MockUserService)(nil).FindByUserId), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserId", reflect.TypeOf((*MockUserService)(nil).FindByUserId), arg0, arg1)
 }
 
-func (m *MockUserService) UpdateByUserId(arg0 uuid.UUID, arg1 *dto.UserRequestBody) (*response.HTTPResponse, error) {
+func (m *MockUserService) RestoreByUserId(arg0 context.Context, arg1 uuid.UUID) (*response.HTTPResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateByUserId", arg0, arg1)
+	ret := m.ctrl.Call(m, "RestoreByUserId", arg0, arg1)
 	ret0, _ := ret[0].(*response.HTTPResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-func (mr *MockUserServiceMockRecorder) UpdateByUserId(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockUserServiceMockRecorder) RestoreByUserId(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateByUserId", reflect.TypeOf((*MockUserService)(nil).UpdateByUserId), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreByUserId", reflect.TypeOf((*MockUserService)(nil).RestoreByUserId), arg0, arg1)
 }
 
-func (m *MockUserService) DeleteByUserId(arg0 uuid.UUID) (*response.HTTPResponse, error) {
+func (m *MockUserService) DeleteByUserId(arg0 context.Context, arg1 uuid.UUID, arg2 *response.IfMatch) (*response.HTTPResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteByUserId", arg0)
+	ret := m.ctrl.Call(m, "DeleteByUserId", arg0, arg1, arg2)
 	ret0, _ := ret[0].(*response.HTTPResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-func (mr *MockUserServiceMockRecorder) DeleteByUserId(arg0 interface{}) *gomock.Call {
+func (mr *MockUserServiceMockRecorder) DeleteByUserId(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUserId", reflect.TypeOf((*MockUserService)(nil).DeleteByUserId), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByUserId", reflect.TypeOf((*MockUserService)(nil).DeleteByUserId), arg0, arg1, arg2)
+}
+
+func (m *MockUserService) UpdateByUserId(arg0 context.Context, arg1 uuid.UUID, arg2 *dto.UserRequestBody, arg3 *response.IfMatch) (*response.HTTPResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateByUserId", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*response.HTTPResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockUserServiceMockRecorder) UpdateByUserId(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateByUserId", reflect.TypeOf((*MockUserService)(nil).UpdateByUserId), arg0, arg1, arg2, arg3)
+}
+
+func (m *MockUserService) PatchByUserId(arg0 context.Context, arg1 uuid.UUID, arg2 *dto.UserPatchBody, arg3 *response.IfMatch) (*response.HTTPResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchByUserId", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*response.HTTPResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (mr *MockUserServiceMockRecorder) PatchByUserId(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchByUserId", reflect.TypeOf((*MockUserService)(nil).PatchByUserId), arg0, arg1, arg2, arg3)
 }
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/api/response"
+	"github.com/minand-mohan/library-app-api/api/users/dto"
+	"github.com/minand-mohan/library-app-api/database/dberrors"
+	"github.com/minand-mohan/library-app-api/tracing"
+)
+
+// PatchByUserId applies a merge patch to a user, only the members present in
+// the patch are written
+func (service *UserServiceImpl) PatchByUserId(ctx context.Context, id uuid.UUID, patch *dto.UserPatchBody, ifMatch *response.IfMatch) (*response.HTTPResponse, error) {
+	ctx, span := tracing.Start(ctx, "UserService.PatchByUserId")
+	defer span.End()
+	service.logger.Info("User Service: Patch user by id")
+	userObj, err := service.repo.FindByUserId(ctx, id)
+	if err != nil {
+		service.logger.Error(fmt.Sprintf("UserService: Error while finding user by id: %s", err))
+		return response.GetRepositoryErrorHTTPResponseBody(err, "User not found."), err
+	}
+	if !ifMatch.Matches(*userObj.Version) {
+		service.logger.Error(fmt.Sprintf("UserService: Stale version %d of user %s", *userObj.Version, id))
+		return response.GetStaleVersionHTTPResponseBody(), dberrors.ErrStaleVersion
+	}
+
+	if len(patch.Fields) > 0 {
+		for _, field := range patch.Fields {
+			switch field {
+			case "username":
+				userObj.Username = patch.Username
+			case "email":
+				userObj.Email = patch.Email
+			case "phone":
+				userObj.Phone = patch.Phone
+			}
+		}
+		userObj, err = service.repo.UpdateByUserId(ctx, id, userObj, patch.Fields)
+		if err != nil {
+			service.logger.Error(fmt.Sprintf("UserService: Error while patching user: %s", err))
+			return response.GetRepositoryErrorHTTPResponseBody(err, "User not found."), err
+		}
+	}
+	responseContent := map[string]interface{}{
+		"id":       id,
+		"username": userObj.Username,
+		"email":    userObj.Email,
+		"phone":    userObj.Phone,
+		"version":  userObj.Version,
+	}
+	responseBody := response.HTTPResponse{
+		Code:    200,
+		Message: "User updated successfully",
+		Content: responseContent,
+		ETag:    response.ETag(*userObj.Version),
+	}
+	return &responseBody, nil
+}
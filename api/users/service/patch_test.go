@@ -0,0 +1,192 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	finerepomocks "github.com/minand-mohan/library-app-api/api/fines/repository/mocks"
+	"github.com/minand-mohan/library-app-api/api/response"
+	"github.com/minand-mohan/library-app-api/api/users/dto"
+	repomocks "github.com/minand-mohan/library-app-api/api/users/repository/mocks"
+	"github.com/minand-mohan/library-app-api/database/dberrors"
+	"github.com/minand-mohan/library-app-api/database/models"
+	"github.com/minand-mohan/library-app-api/utils"
+)
+
+func TestPatchUser(t *testing.T) {
+
+	test_email := "patched@example.com"
+
+	internalServerError := errors.New("Internal Server Error")
+	userNotFoundError := dberrors.ErrNotFound
+	duplicateKeyError := &dberrors.ConflictError{Field: "email", Constraint: "users_email_key", Err: errors.New("duplicate key value violates unique constraint \"users_email_key\"")}
+
+	test_cases_that_require_update_user := map[string]bool{
+		"Patch User sucessfully":         true,
+		"Patch User with error":          true,
+		"Patch User non-unique values":   true,
+		"Patch User at matching version": true,
+	}
+
+	tc := []struct {
+		name                string
+		patch               *dto.UserPatchBody
+		ifMatch             string
+		expectedResponse    *response.HTTPResponse
+		expectedError       error
+		mockFindUserError   error
+		mockUpdateUserError error
+	}{
+		{
+			name: "Patch User sucessfully",
+			patch: &dto.UserPatchBody{
+				Email:  &test_email,
+				Fields: []string{"email"},
+			},
+			expectedResponse: &response.HTTPResponse{
+				Code:    200,
+				Message: "User updated successfully",
+			},
+		},
+		{
+			name:  "Patch User with empty patch",
+			patch: &dto.UserPatchBody{},
+			expectedResponse: &response.HTTPResponse{
+				Code:    200,
+				Message: "User updated successfully",
+			},
+		},
+		{
+			name: "Patch User with error",
+			patch: &dto.UserPatchBody{
+				Email:  &test_email,
+				Fields: []string{"email"},
+			},
+			expectedResponse: &response.HTTPResponse{
+				Code:    500,
+				Message: "Internal Server Error",
+			},
+			expectedError:       internalServerError,
+			mockUpdateUserError: internalServerError,
+		},
+		{
+			name: "Patch User non-unique values",
+			patch: &dto.UserPatchBody{
+				Email:  &test_email,
+				Fields: []string{"email"},
+			},
+			expectedResponse: &response.HTTPResponse{
+				Code:    409,
+				Message: "Conflict, non-unique values",
+			},
+			expectedError:       duplicateKeyError,
+			mockUpdateUserError: duplicateKeyError,
+		},
+		{
+			name: "Patch User at matching version",
+			patch: &dto.UserPatchBody{
+				Email:  &test_email,
+				Fields: []string{"email"},
+			},
+			ifMatch: `"1"`,
+			expectedResponse: &response.HTTPResponse{
+				Code:    200,
+				Message: "User updated successfully",
+				ETag:    `"2"`,
+			},
+		},
+		{
+			name: "Patch User at stale version",
+			patch: &dto.UserPatchBody{
+				Email:  &test_email,
+				Fields: []string{"email"},
+			},
+			ifMatch: `"7"`,
+			expectedResponse: &response.HTTPResponse{
+				Code:    412,
+				Message: "Precondition failed, stale version",
+			},
+			expectedError: dberrors.ErrStaleVersion,
+		},
+		{
+			name: "Cannot find user",
+			patch: &dto.UserPatchBody{
+				Email:  &test_email,
+				Fields: []string{"email"},
+			},
+			expectedResponse: &response.HTTPResponse{
+				Code:    404,
+				Message: "User not found.",
+			},
+			expectedError:     userNotFoundError,
+			mockFindUserError: userNotFoundError,
+		},
+	}
+
+	for _, tt := range tc {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+
+		t.Run(tt.name, func(t *testing.T) {
+			test_user := generateRandomUser01()
+			test_id := *test_user.ID
+			original_username := *test_user.Username
+			original_phone := *test_user.Phone
+
+			mockUserRepo := repomocks.NewMockUserRepository(mockCtrl)
+			if tt.mockFindUserError != nil {
+				mockUserRepo.EXPECT().FindByUserId(gomock.Any(), test_id).Return(nil, tt.mockFindUserError)
+			} else {
+				mockUserRepo.EXPECT().FindByUserId(gomock.Any(), test_id).Return(&test_user, nil)
+			}
+			if test_cases_that_require_update_user[tt.name] {
+				mockUserRepo.EXPECT().UpdateByUserId(gomock.Any(), test_id, gomock.Any(), tt.patch.Fields).DoAndReturn(func(ctx context.Context, id uuid.UUID, user *models.User, fields []string) (*models.User, error) {
+					// members left out of the patch keep their stored values
+					if *user.Email != test_email || *user.Username != original_username || *user.Phone != original_phone {
+						t.Errorf("Expected only the email to be patched, got %v %v %v", *user.Username, *user.Email, *user.Phone)
+					}
+					if tt.mockUpdateUserError != nil {
+						return nil, tt.mockUpdateUserError
+					}
+					version := *user.Version + 1
+					user.Version = &version
+					return user, nil
+				})
+			}
+
+			service := NewUserService(mockUserRepo, finerepomocks.NewMockFineRepository(mockCtrl), *utils.NewLogger())
+			response, err := service.PatchByUserId(context.Background(), test_id, tt.patch, response.ParseIfMatch(tt.ifMatch))
+
+			if err != nil && tt.expectedError != nil {
+				if err.Error() != tt.expectedError.Error() {
+					t.Errorf("expected error %v, got %v", tt.expectedError, err)
+				}
+			}
+			if response.Code != tt.expectedResponse.Code {
+				t.Errorf("expected code %d, got %d", tt.expectedResponse.Code, response.Code)
+			}
+			if response.Message != tt.expectedResponse.Message {
+				t.Errorf("expected message %s, got %s", tt.expectedResponse.Message, response.Message)
+			}
+			if tt.expectedResponse.ETag != "" && response.ETag != tt.expectedResponse.ETag {
+				t.Errorf("expected etag %s, got %s", tt.expectedResponse.ETag, response.ETag)
+			}
+			if response.Code == 409 {
+				content := response.Content.(map[string]interface{})
+				if content["field"] != "email" {
+					t.Errorf("expected conflict on email, got %v", content["field"])
+				}
+			}
+			if response.Code == 200 {
+				content := response.Content.(map[string]interface{})
+				if !reflect.DeepEqual(content["username"], &original_username) {
+					t.Errorf("expected username %s, got %v", original_username, content["username"])
+				}
+			}
+		})
+	}
+}
@@ -1,24 +1,38 @@
 package service
 
 import (
+	"context"
 	"fmt"
+	"net/url"
+	"strconv"
 
 	"github.com/google/uuid"
 	"github.com/minand-mohan/library-app-api/api/response"
 	"github.com/minand-mohan/library-app-api/api/users/dto"
+	"github.com/minand-mohan/library-app-api/database/filters"
+	"github.com/minand-mohan/library-app-api/database/models"
+	"github.com/minand-mohan/library-app-api/tracing"
 )
 
-func (service *UserServiceImpl) FindAllUsers(queryParams *dto.UserQueryParams) (*response.HTTPResponse, error) {
+func (service *UserServiceImpl) FindAllUsers(ctx context.Context, queryParams *dto.UserQueryParams) (*response.HTTPResponse, error) {
+	ctx, span := tracing.Start(ctx, "UserService.FindAllUsers")
+	defer span.End()
 	service.logger.Info("User Service: Find all users")
-	users, err := service.repo.FindAllUsers(queryParams)
+	if queryParams.PageSize == 0 {
+		queryParams.PageSize = response.DefaultPageSize
+	}
+	if queryParams.Page == 0 && queryParams.After == "" {
+		queryParams.Page = 1
+	}
+	total, err := service.repo.CountUsers(ctx, queryParams)
+	if err != nil {
+		service.logger.Error(fmt.Sprintf("UserService: Error while counting users: %s", err))
+		return response.GetRepositoryErrorHTTPResponseBody(err, "User not found."), err
+	}
+	users, err := service.repo.FindAllUsers(ctx, queryParams)
 	if err != nil {
 		service.logger.Error(fmt.Sprintf("UserService: Error while finding all users: %s", err))
-		responseBody := response.HTTPResponse{
-			Code:    500,
-			Message: "Internal Server Error",
-			Content: map[string]interface{}{},
-		}
-		return &responseBody, err
+		return response.GetRepositoryErrorHTTPResponseBody(err, "User not found."), err
 	}
 	if len(users) == 0 {
 		service.logger.Error(fmt.Sprintf("UserService: No users found"))
@@ -29,20 +43,26 @@ func (service *UserServiceImpl) FindAllUsers(queryParams *dto.UserQueryParams) (
 		}
 		return &responseBody, nil
 	}
+	fields := filters.ParseFields(queryParams.Fields)
 	var usersMap []map[string]interface{}
 	for _, user := range users {
 		userMap := map[string]interface{}{
-			"id":       user.ID,
-			"username": user.Username,
-			"email":    user.Email,
-			"phone":    user.Phone,
+			"id":         user.ID,
+			"username":   user.Username,
+			"email":      user.Email,
+			"phone":      user.Phone,
+			"deleted_at": nil,
+		}
+		if user.DeletedAt.Valid {
+			userMap["deleted_at"] = user.DeletedAt.Time
 		}
-		usersMap = append(usersMap, userMap)
+		usersMap = append(usersMap, selectFields(userMap, fields))
 	}
+	previous, next := userPageLinks(queryParams, total, users)
 	responseContent := response.HTTPResponseContent{
-		Count:    len(users),
-		Previous: nil,
-		Next:     nil,
+		Count:    int(total),
+		Previous: previous,
+		Next:     next,
 		Results:  usersMap,
 	}
 	responseBody := response.HTTPResponse{
@@ -53,28 +73,86 @@ func (service *UserServiceImpl) FindAllUsers(queryParams *dto.UserQueryParams) (
 	return &responseBody, nil
 }
 
-func (service *UserServiceImpl) FindByUserId(id uuid.UUID) (*response.HTTPResponse, error) {
+// selectFields narrows a user to the requested fields, or returns it whole
+// when no fields were requested
+func selectFields(userMap map[string]interface{}, fields []string) map[string]interface{} {
+	if len(fields) == 0 {
+		return userMap
+	}
+	selected := make(map[string]interface{}, len(fields))
+	for _, field := range fields {
+		selected[field] = userMap[field]
+	}
+	return selected
+}
+
+// userPageLinks returns the links to the pages either side of users. Cursor
+// pages only link forward, since the cursor cannot be walked backwards.
+func userPageLinks(queryParams *dto.UserQueryParams, total int64, users []models.User) (*string, *string) {
+	query := url.Values{}
+	if queryParams.Username != "" {
+		query.Set("username", queryParams.Username)
+	}
+	if queryParams.Email != "" {
+		query.Set("email", queryParams.Email)
+	}
+	if queryParams.Sort != "" {
+		query.Set("sort", queryParams.Sort)
+	}
+	if queryParams.Fields != "" {
+		query.Set("fields", queryParams.Fields)
+	}
+	if queryParams.IncludeDeleted {
+		query.Set("include_deleted", "true")
+	}
+	query.Set("page_size", strconv.Itoa(queryParams.PageSize))
+
+	var previous, next *string
+	if queryParams.After != "" {
+		if len(users) == queryParams.PageSize {
+			query.Set("after", users[len(users)-1].ID.String())
+			next = response.PageURL(queryParams.BaseURL, query)
+		}
+		return previous, next
+	}
+	if queryParams.Page > 1 {
+		query.Set("page", strconv.Itoa(queryParams.Page-1))
+		previous = response.PageURL(queryParams.BaseURL, query)
+	}
+	if int64(queryParams.Page*queryParams.PageSize) < total {
+		query.Set("page", strconv.Itoa(queryParams.Page+1))
+		next = response.PageURL(queryParams.BaseURL, query)
+	}
+	return previous, next
+}
+
+func (service *UserServiceImpl) FindByUserId(ctx context.Context, id uuid.UUID) (*response.HTTPResponse, error) {
+	ctx, span := tracing.Start(ctx, "UserService.FindByUserId")
+	defer span.End()
 	service.logger.Info("User Service: Find user by id")
-	user, err := service.repo.FindByUserId(id)
+	user, err := service.repo.FindByUserId(ctx, id)
 	if err != nil {
 		service.logger.Error(fmt.Sprintf("UserService: Error while finding user by id: %s", err))
-		responseBody := response.HTTPResponse{
-			Code:    404,
-			Message: "User not found.",
-			Content: map[string]interface{}{},
-		}
-		return &responseBody, nil
+		return response.GetRepositoryErrorHTTPResponseBody(err, "User not found."), err
+	}
+	balance, err := service.fineRepo.SumByUserId(ctx, id)
+	if err != nil {
+		service.logger.Error(fmt.Sprintf("UserService: Error while finding fine balance: %s", err))
+		return response.GetRepositoryErrorHTTPResponseBody(err, "User not found."), err
 	}
 	responseContent := map[string]interface{}{
-		"id":       user.ID,
-		"username": user.Username,
-		"email":    user.Email,
-		"phone":    user.Phone,
+		"id":                 user.ID,
+		"username":           user.Username,
+		"email":              user.Email,
+		"phone":              user.Phone,
+		"version":            user.Version,
+		"fine_balance_cents": balance,
 	}
 	responseBody := response.HTTPResponse{
 		Code:    200,
 		Message: "User found",
 		Content: responseContent,
+		ETag:    response.ETag(*user.Version),
 	}
 	return &responseBody, nil
 }
@@ -1,13 +1,16 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	finerepomocks "github.com/minand-mohan/library-app-api/api/fines/repository/mocks"
 	"github.com/minand-mohan/library-app-api/api/response"
 	"github.com/minand-mohan/library-app-api/api/users/dto"
 	repomocks "github.com/minand-mohan/library-app-api/api/users/repository/mocks"
+	"github.com/minand-mohan/library-app-api/database/dberrors"
 	"github.com/minand-mohan/library-app-api/database/models"
 	"github.com/minand-mohan/library-app-api/utils"
 )
@@ -15,29 +18,74 @@ import (
 func TestListUser(t *testing.T) {
 
 	internalServerError := errors.New("Internal Server Error")
+	baseURL := "http://localhost/library-app/api/v1/users"
+	user1 := generateRandomUser01()
+	user2 := generateRandomUser02()
 
 	tc := []struct {
 		name                   string
 		queryParams            *dto.UserQueryParams
 		expectedResponse       *response.HTTPResponse
 		expectedError          error
+		expectedPrevious       string
+		expectedNext           string
+		mockCountUsersReturn   int64
+		mockCountUsersError    error
 		mockFindAllUsersReturn []models.User
 		mockFindAllUserError   error
 	}{
 		{
 			name:        "Find all users successfully",
-			queryParams: nil,
+			queryParams: &dto.UserQueryParams{BaseURL: baseURL},
 			expectedResponse: &response.HTTPResponse{
 				Code:    200,
 				Message: "Users found successfully",
 			},
-			expectedError: nil,
+			expectedError:        nil,
+			mockCountUsersReturn: 2,
 			mockFindAllUsersReturn: []models.User{
-				generateRandomUser01(),
-				generateRandomUser02(),
+				user1,
+				user2,
 			},
 			mockFindAllUserError: nil,
 		},
+		{
+			name:        "Find a middle page of users",
+			queryParams: &dto.UserQueryParams{Username: "test", Page: 2, PageSize: 2, BaseURL: baseURL},
+			expectedResponse: &response.HTTPResponse{
+				Code:    200,
+				Message: "Users found successfully",
+			},
+			expectedError:          nil,
+			expectedPrevious:       baseURL + "?page=1&page_size=2&username=test",
+			expectedNext:           baseURL + "?page=3&page_size=2&username=test",
+			mockCountUsersReturn:   5,
+			mockFindAllUsersReturn: []models.User{user1, user2},
+		},
+		{
+			name:        "Find a page of users after a cursor",
+			queryParams: &dto.UserQueryParams{After: user1.ID.String(), PageSize: 2, BaseURL: baseURL},
+			expectedResponse: &response.HTTPResponse{
+				Code:    200,
+				Message: "Users found successfully",
+			},
+			expectedError:          nil,
+			expectedNext:           baseURL + "?after=" + user2.ID.String() + "&page_size=2",
+			mockCountUsersReturn:   5,
+			mockFindAllUsersReturn: []models.User{user1, user2},
+		},
+		{
+			name:        "Find users with selected fields",
+			queryParams: &dto.UserQueryParams{Sort: "-username", Fields: "id,email", Page: 1, PageSize: 1, BaseURL: baseURL},
+			expectedResponse: &response.HTTPResponse{
+				Code:    200,
+				Message: "Users found successfully",
+			},
+			expectedError:          nil,
+			expectedNext:           baseURL + "?fields=id%2Cemail&page=2&page_size=1&sort=-username",
+			mockCountUsersReturn:   2,
+			mockFindAllUsersReturn: []models.User{user1},
+		},
 		{
 			name: "Find no users",
 			queryParams: &dto.UserQueryParams{
@@ -64,6 +112,18 @@ func TestListUser(t *testing.T) {
 			mockFindAllUsersReturn: nil,
 			mockFindAllUserError:   internalServerError,
 		},
+		{
+			name: "Count users with error",
+			queryParams: &dto.UserQueryParams{
+				Username: "test",
+			},
+			expectedResponse: &response.HTTPResponse{
+				Code:    500,
+				Message: "Internal Server Error",
+			},
+			expectedError:       internalServerError,
+			mockCountUsersError: internalServerError,
+		},
 	}
 
 	for _, tt := range tc {
@@ -72,10 +132,111 @@ func TestListUser(t *testing.T) {
 
 		t.Run(tt.name, func(t *testing.T) {
 			mockUserRepo := repomocks.NewMockUserRepository(mockCtrl)
-			mockUserRepo.EXPECT().FindAllUsers(tt.queryParams).Return(tt.mockFindAllUsersReturn, tt.mockFindAllUserError)
+			mockUserRepo.EXPECT().CountUsers(gomock.Any(), tt.queryParams).Return(tt.mockCountUsersReturn, tt.mockCountUsersError)
+			if tt.mockCountUsersError == nil {
+				mockUserRepo.EXPECT().FindAllUsers(gomock.Any(), tt.queryParams).Return(tt.mockFindAllUsersReturn, tt.mockFindAllUserError)
+			}
 
-			userService := NewUserService(mockUserRepo, *utils.NewLogger())
-			response, err := userService.FindAllUsers(tt.queryParams)
+			userService := NewUserService(mockUserRepo, finerepomocks.NewMockFineRepository(mockCtrl), *utils.NewLogger())
+			responseBody, err := userService.FindAllUsers(context.Background(), tt.queryParams)
+			if err != tt.expectedError {
+				t.Errorf("Expected error to be %v, but got %v", tt.expectedError, err)
+			}
+			if responseBody.Code != tt.expectedResponse.Code {
+				t.Errorf("Expected code to be %d, but got %d", tt.expectedResponse.Code, responseBody.Code)
+			}
+			if responseBody.Message != tt.expectedResponse.Message {
+				t.Errorf("Expected message to be %s, but got %s", tt.expectedResponse.Message, responseBody.Message)
+			}
+			if responseBody.Code != 200 {
+				return
+			}
+			content := responseBody.Content.(response.HTTPResponseContent)
+			if int64(content.Count) != tt.mockCountUsersReturn {
+				t.Errorf("Expected count to be %d, but got %d", tt.mockCountUsersReturn, content.Count)
+			}
+			if (content.Previous == nil && tt.expectedPrevious != "") || (content.Previous != nil && *content.Previous != tt.expectedPrevious) {
+				t.Errorf("Expected previous to be %q, but got %v", tt.expectedPrevious, content.Previous)
+			}
+			if tt.queryParams.Fields != "" {
+				result := content.Results.([]map[string]interface{})[0]
+				if len(result) != 2 || result["id"] == nil || result["email"] == nil {
+					t.Errorf("Expected only id and email, but got %v", result)
+				}
+			}
+			if (content.Next == nil && tt.expectedNext != "") || (content.Next != nil && *content.Next != tt.expectedNext) {
+				t.Errorf("Expected next to be %q, but got %v", tt.expectedNext, content.Next)
+			}
+		})
+	}
+}
+
+func TestFindUserById(t *testing.T) {
+
+	internalServerError := errors.New("Internal Server Error")
+	recordNotFoundError := dberrors.ErrNotFound
+	test_user := generateRandomUser01()
+
+	tc := []struct {
+		name                 string
+		expectedResponse     *response.HTTPResponse
+		expectedError        error
+		mockFindUserError    error
+		mockFineBalance      int64
+		mockFineBalanceError error
+	}{
+		{
+			name: "Find user by id successfully",
+			expectedResponse: &response.HTTPResponse{
+				Code:    200,
+				Message: "User found",
+			},
+			expectedError:   nil,
+			mockFineBalance: 250,
+		},
+		{
+			name: "Cannot find user",
+			expectedResponse: &response.HTTPResponse{
+				Code:    404,
+				Message: "User not found.",
+			},
+			expectedError:     recordNotFoundError,
+			mockFindUserError: recordNotFoundError,
+		},
+		{
+			name: "Find user by id with database unavailable",
+			expectedResponse: &response.HTTPResponse{
+				Code:    503,
+				Message: "Service unavailable",
+			},
+			expectedError:     dberrors.ErrUnavailable,
+			mockFindUserError: dberrors.ErrUnavailable,
+		},
+		{
+			name: "Find user by id with fine balance error",
+			expectedResponse: &response.HTTPResponse{
+				Code:    500,
+				Message: "Internal Server Error",
+			},
+			expectedError:        internalServerError,
+			mockFineBalanceError: internalServerError,
+		},
+	}
+
+	for _, tt := range tc {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+
+		t.Run(tt.name, func(t *testing.T) {
+			mockUserRepo := repomocks.NewMockUserRepository(mockCtrl)
+			mockUserRepo.EXPECT().FindByUserId(gomock.Any(), *test_user.ID).Return(&test_user, tt.mockFindUserError)
+			mockFineRepo := finerepomocks.NewMockFineRepository(mockCtrl)
+			if tt.mockFindUserError == nil {
+				mockFineRepo.EXPECT().SumByUserId(gomock.Any(), *test_user.ID).Return(tt.mockFineBalance, tt.mockFineBalanceError)
+			}
+
+			userService := NewUserService(mockUserRepo, mockFineRepo, *utils.NewLogger())
+			response, err := userService.FindByUserId(context.Background(), *test_user.ID)
 			if err != tt.expectedError {
 				t.Errorf("Expected error to be %v, but got %v", tt.expectedError, err)
 			}
@@ -85,6 +246,15 @@ func TestListUser(t *testing.T) {
 			if response.Message != tt.expectedResponse.Message {
 				t.Errorf("Expected message to be %s, but got %s", tt.expectedResponse.Message, response.Message)
 			}
+			if response.Code == 200 {
+				content := response.Content.(map[string]interface{})
+				if content["fine_balance_cents"].(int64) != tt.mockFineBalance {
+					t.Errorf("Expected fine balance to be %d, but got %d", tt.mockFineBalance, content["fine_balance_cents"].(int64))
+				}
+				if response.ETag != `"1"` {
+					t.Errorf("Expected ETag to be %s, but got %s", `"1"`, response.ETag)
+				}
+			}
 		})
 	}
 }
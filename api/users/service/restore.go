@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/api/response"
+	"github.com/minand-mohan/library-app-api/metrics"
+	"github.com/minand-mohan/library-app-api/tracing"
+)
+
+func (service *UserServiceImpl) RestoreByUserId(ctx context.Context, id uuid.UUID) (*response.HTTPResponse, error) {
+	ctx, span := tracing.Start(ctx, "UserService.RestoreByUserId")
+	defer span.End()
+	service.logger.Info("User Service: Restore user by id")
+	user, err := service.repo.FindByUserIdIncludingDeleted(ctx, id)
+	if err != nil {
+		service.logger.Error(fmt.Sprintf("UserService: Error while finding user by id: %s", err))
+		return response.GetRepositoryErrorHTTPResponseBody(err, "User not found."), err
+	}
+	if !user.DeletedAt.Valid {
+		service.logger.Error(fmt.Sprintf("UserService: User %s is not deleted", id))
+		responseBody := response.HTTPResponse{
+			Code:    400,
+			Message: "Bad request, user not deleted",
+			Content: map[string]interface{}{},
+		}
+		return &responseBody, errors.New("user not deleted")
+	}
+	err = service.repo.RestoreByUserId(ctx, id)
+	if err != nil {
+		service.logger.Error(fmt.Sprintf("UserService: Error while restoring user: %s", err))
+		return response.GetRepositoryErrorHTTPResponseBody(err, "User not found."), err
+	}
+	metrics.UsersRestored.Inc()
+	// restoring bumps the version, so that the old ETag no longer matches
+	version := *user.Version + 1
+	responseContent := map[string]interface{}{
+		"id":       user.ID,
+		"username": user.Username,
+		"email":    user.Email,
+		"phone":    user.Phone,
+		"version":  &version,
+	}
+	responseBody := response.HTTPResponse{
+		Code:    200,
+		Message: "User restored successfully",
+		Content: responseContent,
+		ETag:    response.ETag(version),
+	}
+	return &responseBody, nil
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/api/response"
+	repomocks "github.com/minand-mohan/library-app-api/api/users/repository/mocks"
+	"github.com/minand-mohan/library-app-api/database/dberrors"
+	"github.com/minand-mohan/library-app-api/utils"
+	"gorm.io/gorm"
+)
+
+func TestRestoreUser(t *testing.T) {
+
+	test_cases_that_require_find_user := map[string]bool{
+		"Restore User by id successfully": true,
+		"Cannot find user":                true,
+		"Restore User not deleted":        true,
+		"Restore User by id with error":   true,
+	}
+	test_cases_that_require_restore_user := map[string]bool{
+		"Restore User by id successfully": true,
+		"Restore User by id with error":   true,
+	}
+	deleted_user := generateRandomUser01()
+	deleted_user.DeletedAt = gorm.DeletedAt{Time: time.Now(), Valid: true}
+	active_user := generateRandomUser01()
+	restored_version := *deleted_user.Version + 1
+
+	tc := []struct {
+		name                 string
+		id                   string
+		expectedResponse     *response.HTTPResponse
+		expectedError        error
+		mockRestoreUserError error
+		mockFindUserError    error
+	}{
+		{
+			name: "Restore User by id successfully",
+			id:   "d3b3b3b3-3b3b-3b3b-3b3b-3b3b3b3b3b3b",
+			expectedResponse: &response.HTTPResponse{
+				Code:    200,
+				Message: "User restored successfully",
+				Content: map[string]interface{}{
+					"id":       deleted_user.ID,
+					"username": deleted_user.Username,
+					"email":    deleted_user.Email,
+					"phone":    deleted_user.Phone,
+					"version":  &restored_version,
+				},
+				ETag: response.ETag(restored_version),
+			},
+			expectedError:        nil,
+			mockRestoreUserError: nil,
+			mockFindUserError:    nil,
+		},
+		{
+			name: "Cannot find user",
+			id:   "d3b3b3b3-3b3b-3b3b-3b3b-3b3b3b3b3b3b",
+			expectedResponse: &response.HTTPResponse{
+				Code:    404,
+				Message: "User not found.",
+				Content: map[string]interface{}{},
+			},
+			expectedError:        nil,
+			mockRestoreUserError: nil,
+			mockFindUserError:    dberrors.ErrNotFound,
+		},
+		{
+			name: "Restore User not deleted",
+			id:   "d3b3b3b3-3b3b-3b3b-3b3b-3b3b3b3b3b3b",
+			expectedResponse: &response.HTTPResponse{
+				Code:    400,
+				Message: "Bad request, user not deleted",
+				Content: map[string]interface{}{},
+			},
+			expectedError:        errors.New("user not deleted"),
+			mockRestoreUserError: nil,
+			mockFindUserError:    nil,
+		},
+		{
+			name: "Restore User by id with error",
+			id:   "d3b3b3b3-3b3b-3b3b-3b3b-3b3b3b3b3b3b",
+			expectedResponse: &response.HTTPResponse{
+				Code:    500,
+				Message: "Internal Server Error",
+				Content: map[string]interface{}{},
+			},
+			expectedError:        errors.New("Internal Server Error"),
+			mockRestoreUserError: errors.New("Internal Server Error"),
+			mockFindUserError:    nil,
+		},
+	}
+
+	for _, tc := range tc {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+
+		t.Run(tc.name, func(t *testing.T) {
+			id, _ := uuid.Parse(tc.id)
+			mockRepo := repomocks.NewMockUserRepository(mockCtrl)
+
+			if test_cases_that_require_find_user[tc.name] {
+				foundUser := &deleted_user
+				if tc.name == "Restore User not deleted" {
+					foundUser = &active_user
+				}
+				mockRepo.EXPECT().FindByUserIdIncludingDeleted(gomock.Any(), id).Return(foundUser, tc.mockFindUserError)
+			}
+			if test_cases_that_require_restore_user[tc.name] {
+				mockRepo.EXPECT().RestoreByUserId(gomock.Any(), id).Return(tc.mockRestoreUserError)
+			}
+			service := UserServiceImpl{
+				repo:   mockRepo,
+				logger: utils.NewLogger(),
+			}
+
+			response, err := service.RestoreByUserId(context.Background(), id)
+			if err != nil && tc.expectedError != nil {
+				if err.Error() != tc.expectedError.Error() {
+					t.Errorf("Expected error: %v, got: %v", tc.expectedError, err)
+				}
+			}
+
+			if !reflect.DeepEqual(response, tc.expectedResponse) {
+				t.Errorf("Expected response: %v, got: %v", tc.expectedResponse, response)
+			}
+		})
+	}
+}
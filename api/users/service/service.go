@@ -1,7 +1,10 @@
 package service
 
 import (
+	"context"
+
 	"github.com/google/uuid"
+	fineRepository "github.com/minand-mohan/library-app-api/api/fines/repository"
 	"github.com/minand-mohan/library-app-api/api/response"
 	"github.com/minand-mohan/library-app-api/api/users/dto"
 	"github.com/minand-mohan/library-app-api/api/users/repository"
@@ -9,21 +12,25 @@ import (
 )
 
 type UserService interface {
-	CreateUser(userReqBody *dto.UserRequestBody) (*response.HTTPResponse, error)
-	FindAllUsers(queryParams *dto.UserQueryParams) (*response.HTTPResponse, error)
-	FindByUserId(id uuid.UUID) (*response.HTTPResponse, error)
-	UpdateByUserId(id uuid.UUID, userReqBody *dto.UserRequestBody) (*response.HTTPResponse, error)
-	DeleteByUserId(id uuid.UUID) (*response.HTTPResponse, error)
+	CreateUser(ctx context.Context, userReqBody *dto.UserRequestBody) (*response.HTTPResponse, error)
+	FindAllUsers(ctx context.Context, queryParams *dto.UserQueryParams) (*response.HTTPResponse, error)
+	FindByUserId(ctx context.Context, id uuid.UUID) (*response.HTTPResponse, error)
+	UpdateByUserId(ctx context.Context, id uuid.UUID, userReqBody *dto.UserRequestBody, ifMatch *response.IfMatch) (*response.HTTPResponse, error)
+	PatchByUserId(ctx context.Context, id uuid.UUID, patch *dto.UserPatchBody, ifMatch *response.IfMatch) (*response.HTTPResponse, error)
+	DeleteByUserId(ctx context.Context, id uuid.UUID, ifMatch *response.IfMatch) (*response.HTTPResponse, error)
+	RestoreByUserId(ctx context.Context, id uuid.UUID) (*response.HTTPResponse, error)
 }
 
 type UserServiceImpl struct {
-	repo   repository.UserRepository
-	logger *utils.AppLogger
+	repo     repository.UserRepository
+	fineRepo fineRepository.FineRepository
+	logger   *utils.AppLogger
 }
 
-func NewUserService(repo repository.UserRepository, logger utils.AppLogger) UserService {
+func NewUserService(repo repository.UserRepository, fineRepo fineRepository.FineRepository, logger utils.AppLogger) UserService {
 	return &UserServiceImpl{
-		repo:   repo,
-		logger: &logger,
+		repo:     repo,
+		fineRepo: fineRepo,
+		logger:   &logger,
 	}
 }
@@ -11,11 +11,13 @@ func generateRandomUser01() models.User {
 	test_username := "test1"
 	test_phone := "1234567890"
 	test_id := uuid.New()
+	test_version := int64(1)
 	return models.User{
 		ID:       &test_id,
 		Email:    &test_email,
 		Username: &test_username,
 		Phone:    &test_phone,
+		Version:  &test_version,
 	}
 }
 
@@ -25,10 +27,12 @@ func generateRandomUser02() models.User {
 	test_username := "test2"
 	test_phone := "1234567810"
 	test_id := uuid.New()
+	test_version := int64(1)
 	return models.User{
 		ID:       &test_id,
 		Email:    &test_email,
 		Username: &test_username,
 		Phone:    &test_phone,
+		Version:  &test_version,
 	}
 }
@@ -1,64 +1,54 @@
 package service
 
 import (
+	"context"
 	"fmt"
-	"strings"
 
 	"github.com/google/uuid"
 	"github.com/minand-mohan/library-app-api/api/response"
 	"github.com/minand-mohan/library-app-api/api/users/dto"
+	"github.com/minand-mohan/library-app-api/database/dberrors"
 	"github.com/minand-mohan/library-app-api/database/models"
+	"github.com/minand-mohan/library-app-api/tracing"
 )
 
-func (service *UserServiceImpl) UpdateByUserId(id uuid.UUID, userReqBody *dto.UserRequestBody) (*response.HTTPResponse, error) {
+func (service *UserServiceImpl) UpdateByUserId(ctx context.Context, id uuid.UUID, userReqBody *dto.UserRequestBody, ifMatch *response.IfMatch) (*response.HTTPResponse, error) {
+	ctx, span := tracing.Start(ctx, "UserService.UpdateByUserId")
+	defer span.End()
 	service.logger.Info("User Service: Update user by id")
 	userObj := &models.User{
 		Username: &userReqBody.Username,
 		Email:    &userReqBody.Email,
 		Phone:    &userReqBody.Phone,
 	}
-	_, err := service.repo.FindByUserId(id)
+	user, err := service.repo.FindByUserId(ctx, id)
 	if err != nil {
 		service.logger.Error(fmt.Sprintf("UserService: Error while finding user by id: %s", err))
-		responseBody := response.HTTPResponse{
-			Code:    404,
-			Message: "User not found.",
-			Content: map[string]interface{}{},
-		}
-		return &responseBody, err
+		return response.GetRepositoryErrorHTTPResponseBody(err, "User not found."), err
 	}
+	if !ifMatch.Matches(*user.Version) {
+		service.logger.Error(fmt.Sprintf("UserService: Stale version %d of user %s", *user.Version, id))
+		return response.GetStaleVersionHTTPResponseBody(), dberrors.ErrStaleVersion
+	}
+	userObj.Version = user.Version
 
-	updatedUserObj, err := service.repo.UpdateByUserId(id, userObj)
+	updatedUserObj, err := service.repo.UpdateByUserId(ctx, id, userObj, dto.UserPatchFields)
 	if err != nil {
-		// if duplicate key value error return 400
-		if strings.Contains(err.Error(), "duplicate key value violates unique constraint") {
-			service.logger.Error(fmt.Sprintf("UserService: Error while updating user: %s", err))
-			responseBody := response.HTTPResponse{
-				Code:    400,
-				Message: "Bad request, non-unique values",
-				Content: map[string]interface{}{},
-			}
-			return &responseBody, err
-		}
-
 		service.logger.Error(fmt.Sprintf("UserService: Error while updating user: %s", err))
-		responseBody := response.HTTPResponse{
-			Code:    500,
-			Message: "Internal Server Error",
-			Content: map[string]interface{}{},
-		}
-		return &responseBody, err
+		return response.GetRepositoryErrorHTTPResponseBody(err, "User not found."), err
 	}
 	responseContent := map[string]interface{}{
 		"id":       id,
 		"username": updatedUserObj.Username,
 		"email":    updatedUserObj.Email,
 		"phone":    updatedUserObj.Phone,
+		"version":  updatedUserObj.Version,
 	}
 	responseBody := response.HTTPResponse{
 		Code:    200,
 		Message: "User updated successfully",
 		Content: responseContent,
+		ETag:    response.ETag(*updatedUserObj.Version),
 	}
 	return &responseBody, nil
 }
@@ -1,13 +1,16 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	finerepomocks "github.com/minand-mohan/library-app-api/api/fines/repository/mocks"
 	"github.com/minand-mohan/library-app-api/api/response"
 	"github.com/minand-mohan/library-app-api/api/users/dto"
 	repomocks "github.com/minand-mohan/library-app-api/api/users/repository/mocks"
+	"github.com/minand-mohan/library-app-api/database/dberrors"
 	"github.com/minand-mohan/library-app-api/database/models"
 	"github.com/minand-mohan/library-app-api/utils"
 )
@@ -17,28 +20,32 @@ func TestUpdateUser(t *testing.T) {
 	test_email := "test@example.com"
 
 	internalServerError := errors.New("Internal Server Error")
-	userNotFoundError := errors.New("User not found")
-	duplicateKeyError := errors.New("pq: duplicate key value violates unique constraint \"users_email_key\"")
+	userNotFoundError := dberrors.ErrNotFound
+	duplicateKeyError := &dberrors.ConflictError{Field: "email", Constraint: "users_email_key", Err: errors.New("duplicate key value violates unique constraint \"users_email_key\"")}
 
 	test_user := generateRandomUser01()
 	test_id := *test_user.ID
 
 	test_cases_that_require_find_user := map[string]bool{
-		"Update User sucessfully":       true,
-		"Update User with error":        true,
-		"Cannot find user":              true,
-		"Update User non-unique values": true,
+		"Update User sucessfully":           true,
+		"Update User with error":            true,
+		"Cannot find user":                  true,
+		"Update User non-unique values":     true,
+		"Update User at stale version":      true,
+		"Update User modified concurrently": true,
 	}
 
 	test_cases_that_require_update_user := map[string]bool{
-		"Update User sucessfully":       true,
-		"Update User with error":        true,
-		"Update User non-unique values": true,
+		"Update User sucessfully":           true,
+		"Update User with error":            true,
+		"Update User non-unique values":     true,
+		"Update User modified concurrently": true,
 	}
 
 	tc := []struct {
 		name                 string
 		requestbody          *dto.UserRequestBody
+		ifMatch              string
 		expectedResponse     *response.HTTPResponse
 		expectedError        error
 		mockFindUserReturn   *models.User
@@ -64,6 +71,7 @@ func TestUpdateUser(t *testing.T) {
 				Email:    &test_email,
 				Username: test_user.Username,
 				Phone:    test_user.Phone,
+				Version:  test_user.Version,
 			},
 			mockUpdateUserError: nil,
 		},
@@ -105,8 +113,8 @@ func TestUpdateUser(t *testing.T) {
 				Email: test_email,
 			},
 			expectedResponse: &response.HTTPResponse{
-				Code:    400,
-				Message: "Bad request, non-unique values",
+				Code:    409,
+				Message: "Conflict, non-unique values",
 				Content: map[string]interface{}{},
 			},
 			expectedError:        duplicateKeyError,
@@ -115,6 +123,35 @@ func TestUpdateUser(t *testing.T) {
 			mockUpdateUserReturn: nil,
 			mockUpdateUserError:  duplicateKeyError,
 		},
+		{
+			name: "Update User at stale version",
+			requestbody: &dto.UserRequestBody{
+				Email: test_email,
+			},
+			ifMatch: `"2"`,
+			expectedResponse: &response.HTTPResponse{
+				Code:    412,
+				Message: "Precondition failed, stale version",
+				Content: map[string]interface{}{},
+			},
+			expectedError:      dberrors.ErrStaleVersion,
+			mockFindUserReturn: &test_user,
+		},
+		{
+			name: "Update User modified concurrently",
+			requestbody: &dto.UserRequestBody{
+				Email: test_email,
+			},
+			ifMatch: `"1"`,
+			expectedResponse: &response.HTTPResponse{
+				Code:    412,
+				Message: "Precondition failed, stale version",
+				Content: map[string]interface{}{},
+			},
+			expectedError:       dberrors.ErrStaleVersion,
+			mockFindUserReturn:  &test_user,
+			mockUpdateUserError: dberrors.ErrStaleVersion,
+		},
 	}
 
 	for _, tt := range tc {
@@ -129,19 +166,20 @@ func TestUpdateUser(t *testing.T) {
 				Username: &tt.requestbody.Username,
 				Email:    &tt.requestbody.Email,
 				Phone:    &tt.requestbody.Phone,
+				Version:  test_user.Version,
 			}
 
 			// Arrange
 			mockUserRepo := repomocks.NewMockUserRepository(mockCtrl)
-			service := NewUserService(mockUserRepo, *utils.NewLogger())
+			service := NewUserService(mockUserRepo, finerepomocks.NewMockFineRepository(mockCtrl), *utils.NewLogger())
 			if test_cases_that_require_find_user[tt.name] {
-				mockUserRepo.EXPECT().FindByUserId(test_id).Return(tt.mockFindUserReturn, tt.mockFindUserError)
+				mockUserRepo.EXPECT().FindByUserId(gomock.Any(), test_id).Return(tt.mockFindUserReturn, tt.mockFindUserError)
 			}
 			if test_cases_that_require_update_user[tt.name] {
-				mockUserRepo.EXPECT().UpdateByUserId(test_id, test_input).Return(tt.mockUpdateUserReturn, tt.mockUpdateUserError)
+				mockUserRepo.EXPECT().UpdateByUserId(gomock.Any(), test_id, test_input, dto.UserPatchFields).Return(tt.mockUpdateUserReturn, tt.mockUpdateUserError)
 			}
 			// Act
-			response, err := service.UpdateByUserId(test_id, tt.requestbody)
+			response, err := service.UpdateByUserId(context.Background(), test_id, tt.requestbody, response.ParseIfMatch(tt.ifMatch))
 			logger.Info("Response: " + response.Message)
 			// Assert
 			// if !reflect.DeepEqual(response, tt.expectedResponse) {
@@ -50,3 +50,15 @@ func (mr *MockUserValidatorMockRecorder) ValidateUserQueryParams(arg0 interface{
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateUserQueryParams", reflect.TypeOf((*MockUserValidator)(nil).ValidateUserQueryParams), arg0)
 }
+
+func (m *MockUserValidator) ValidateUserPatch(arg0 *dto.UserPatchBody) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateUserPatch", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (mr *MockUserValidatorMockRecorder) ValidateUserPatch(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateUserPatch", reflect.TypeOf((*MockUserValidator)(nil).ValidateUserPatch), arg0)
+}
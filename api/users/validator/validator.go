@@ -2,54 +2,154 @@ package validator
 
 import (
 	"errors"
+	"fmt"
 	"net/mail"
+	"regexp"
+	"strings"
+	"unicode/utf8"
 
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/api/response"
 	"github.com/minand-mohan/library-app-api/api/users/dto"
+	"github.com/minand-mohan/library-app-api/database/filters"
+	"github.com/minand-mohan/library-app-api/system"
 	"github.com/minand-mohan/library-app-api/utils"
+	"github.com/minand-mohan/library-app-api/utils/phone"
 )
 
 type UserValidator interface {
 	ValidateUser(requestBody *dto.UserRequestBody) error
 	ValidateUserQueryParams(queryParams *dto.UserQueryParams) error
+	ValidateUserPatch(patch *dto.UserPatchBody) error
 	// ValidateUpdate(user *model.User) error
 	// ValidateDelete(user *model.User) error
 }
 
 type UserValidatorImpl struct {
+	config *system.UserConfig
 	logger *utils.AppLogger
 }
 
-func NewUserValidator(logger utils.AppLogger) UserValidator {
+func NewUserValidator(config *system.UserConfig, logger utils.AppLogger) UserValidator {
 	return &UserValidatorImpl{
+		config: config,
 		logger: &logger,
 	}
 }
 
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
 func isValidEmail(email string) bool {
 	_, err := mail.ParseAddress(email)
 	return err == nil
 }
 
+// Length limits of the members of a user
+const (
+	UsernameMinLength = 3
+	UsernameMaxLength = 32
+	EmailMaxLength    = 254
+	PhoneMaxLength    = 32
+)
+
+// Letters, digits, dots, underscores and hyphens, starting with a letter or
+// digit. Usernames are unique regardless of case.
+var usernamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+// Display names of the members of a user, used in messages
+var userFieldNames = map[string]string{
+	"username": "Username",
+	"email":    "Email",
+	"phone":    "Phone",
+}
+
+// validateUserField adds the problems with one member of a user to errs and
+// returns the value in the form it is stored in: emails are lowercased and
+// phone numbers are E.164
+func (validator *UserValidatorImpl) validateUserField(errs *response.ValidationError, field string, value string) string {
+	name := userFieldNames[field]
+	if value == "" {
+		errs.Add(field, response.FieldErrorRequired, name+" is empty")
+		return value
+	}
+	length := utf8.RuneCountInString(value)
+	switch field {
+	case "username":
+		if length < UsernameMinLength || length > UsernameMaxLength {
+			errs.Add(field, response.FieldErrorLength, fmt.Sprintf("Username must be between %d and %d characters", UsernameMinLength, UsernameMaxLength))
+		} else if !usernamePattern.MatchString(value) {
+			errs.Add(field, response.FieldErrorFormat, "Username may only contain letters, digits, dots, underscores and hyphens, and must start with a letter or digit")
+		}
+	case "email":
+		if length > EmailMaxLength {
+			errs.Add(field, response.FieldErrorLength, fmt.Sprintf("Email must be at most %d characters", EmailMaxLength))
+		} else if !isValidEmail(value) {
+			errs.Add(field, response.FieldErrorFormat, "Email is invalid")
+		}
+		return strings.ToLower(value)
+	case "phone":
+		if length > PhoneMaxLength {
+			errs.Add(field, response.FieldErrorLength, fmt.Sprintf("Phone must be at most %d characters", PhoneMaxLength))
+			break
+		}
+		normalized, err := phone.Normalize(value, validator.config.PhoneDefaultRegion)
+		if err != nil {
+			errs.Add(field, response.FieldErrorFormat, "Phone is invalid")
+			break
+		}
+		return normalized
+	}
+	return value
+}
+
+// ValidateUser returns every problem with the request body at once in a
+// *response.ValidationError. The email and phone number are rewritten to
+// the form they are stored in.
 func (validator *UserValidatorImpl) ValidateUser(userReq *dto.UserRequestBody) error {
 	validator.logger.Info("Validate user")
-	if userReq.Username == "" {
-		validator.logger.Error("Username is empty")
-		return errors.New("Username is empty")
+	errs := &response.ValidationError{}
+	userReq.Username = validator.validateUserField(errs, "username", userReq.Username)
+	userReq.Email = validator.validateUserField(errs, "email", userReq.Email)
+	userReq.Phone = validator.validateUserField(errs, "phone", userReq.Phone)
+	if len(errs.Errors) > 0 {
+		validator.logger.Error(errs.Error())
 	}
-	if userReq.Email == "" {
-		validator.logger.Error("Email is empty")
-		return errors.New("Email is empty")
+	return errs.Err()
+}
+
+// ValidateUserPatch checks only the members present in the patch. None of
+// them can be removed, so a null member is rejected like an empty one. The
+// email and phone number are rewritten to the form they are stored in.
+func (validator *UserValidatorImpl) ValidateUserPatch(patch *dto.UserPatchBody) error {
+	validator.logger.Info("Validate user patch")
+	errs := &response.ValidationError{}
+	values := map[string]*string{
+		"username": patch.Username,
+		"email":    patch.Email,
+		"phone":    patch.Phone,
 	}
-	if userReq.Phone == "" {
-		validator.logger.Error("Phone is empty")
-		return errors.New("Phone is empty")
+	for _, field := range patch.Fields {
+		if !contains(dto.UserPatchFields, field) {
+			errs.Add(field, response.FieldErrorUnknown, fmt.Sprintf("Field %s is unknown", field))
+			continue
+		}
+		if values[field] == nil {
+			validator.validateUserField(errs, field, "")
+			continue
+		}
+		*values[field] = validator.validateUserField(errs, field, *values[field])
 	}
-	if !isValidEmail(userReq.Email) {
-		validator.logger.Error("Email is invalid")
-		return errors.New("Email is invalid")
+	if len(errs.Errors) > 0 {
+		validator.logger.Error(errs.Error())
 	}
-
-	return nil
+	return errs.Err()
 }
 
 func (validator *UserValidatorImpl) ValidateUserQueryParams(queryParams *dto.UserQueryParams) error {
@@ -57,6 +157,42 @@ func (validator *UserValidatorImpl) ValidateUserQueryParams(queryParams *dto.Use
 		validator.logger.Error("Email is invalid")
 		return errors.New("Email is invalid")
 	}
+	if queryParams.Page < 0 {
+		validator.logger.Error("Page is invalid")
+		return errors.New("Page is invalid")
+	}
+	if queryParams.PageSize < 0 || queryParams.PageSize > response.MaxPageSize {
+		validator.logger.Error("Page size is invalid")
+		return errors.New("Page size is invalid")
+	}
+	for _, field := range filters.ParseSort(queryParams.Sort) {
+		if !contains(dto.UserSortFields, field.Column) {
+			validator.logger.Error("Sort is invalid")
+			return errors.New("Sort is invalid")
+		}
+	}
+	for _, field := range filters.ParseFields(queryParams.Fields) {
+		if !contains(dto.UserFields, field) {
+			validator.logger.Error("Fields are invalid")
+			return errors.New("Fields are invalid")
+		}
+	}
+	if queryParams.After != "" {
+		if queryParams.Sort != "" {
+			// the cursor is an id, so it can only walk the default order
+			validator.logger.Error("Sort and after are both set")
+			return errors.New("Sort and after are both set")
+		}
+		if queryParams.Page != 0 {
+			validator.logger.Error("Page and after are both set")
+			return errors.New("Page and after are both set")
+		}
+		_, err := uuid.Parse(queryParams.After)
+		if err != nil {
+			validator.logger.Error("After is invalid")
+			return errors.New("After is invalid")
+		}
+	}
 
 	return nil
 }
@@ -0,0 +1,157 @@
+package validator
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/minand-mohan/library-app-api/api/response"
+	"github.com/minand-mohan/library-app-api/api/users/dto"
+	"github.com/minand-mohan/library-app-api/system"
+	"github.com/minand-mohan/library-app-api/utils"
+)
+
+var testUserConfig = &system.UserConfig{
+	PhoneDefaultRegion: "US",
+}
+
+func TestValidateUser(t *testing.T) {
+
+	tc := []struct {
+		name           string
+		requestBody    dto.UserRequestBody
+		expectedEmail  string
+		expectedPhone  string
+		expectedErrors []response.FieldError
+	}{
+		{
+			name: "Valid user",
+			requestBody: dto.UserRequestBody{
+				Username: "test",
+				Email:    "test@example.com",
+				Phone:    "+1 (201) 555-0123",
+			},
+			expectedPhone: "+12015550123",
+		},
+		{
+			name: "Email is lowercased",
+			requestBody: dto.UserRequestBody{
+				Username: "Alice",
+				Email:    "Alice@Example.org",
+				Phone:    "+12015550100",
+			},
+			expectedEmail: "alice@example.org",
+		},
+		{
+			name: "Username with disallowed characters",
+			requestBody: dto.UserRequestBody{
+				Username: "_alice smith",
+				Email:    "alice@example.org",
+				Phone:    "+12015550100",
+			},
+			expectedErrors: []response.FieldError{
+				{Field: "username", Code: response.FieldErrorFormat, Message: "Username may only contain letters, digits, dots, underscores and hyphens, and must start with a letter or digit"},
+			},
+		},
+		{
+			name: "National phone number in the default region",
+			requestBody: dto.UserRequestBody{
+				Username: "test",
+				Email:    "test@example.com",
+				Phone:    "1 201-555-0123",
+			},
+			expectedPhone: "+12015550123",
+		},
+		{
+			name:        "Empty user reports every member",
+			requestBody: dto.UserRequestBody{},
+			expectedErrors: []response.FieldError{
+				{Field: "username", Code: response.FieldErrorRequired, Message: "Username is empty"},
+				{Field: "email", Code: response.FieldErrorRequired, Message: "Email is empty"},
+				{Field: "phone", Code: response.FieldErrorRequired, Message: "Phone is empty"},
+			},
+		},
+		{
+			name: "Length and format violations",
+			requestBody: dto.UserRequestBody{
+				Username: strings.Repeat("a", UsernameMaxLength+1),
+				Email:    "not an email",
+				Phone:    "call me",
+			},
+			expectedErrors: []response.FieldError{
+				{Field: "username", Code: response.FieldErrorLength, Message: "Username must be between 3 and 32 characters"},
+				{Field: "email", Code: response.FieldErrorFormat, Message: "Email is invalid"},
+				{Field: "phone", Code: response.FieldErrorFormat, Message: "Phone is invalid"},
+			},
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			validator := NewUserValidator(testUserConfig, *utils.NewLogger())
+			err := validator.ValidateUser(&tt.requestBody)
+			assertFieldErrors(t, err, tt.expectedErrors)
+			if tt.expectedEmail != "" && tt.requestBody.Email != tt.expectedEmail {
+				t.Errorf("Expected email %s, got %s", tt.expectedEmail, tt.requestBody.Email)
+			}
+			if tt.expectedPhone != "" && tt.requestBody.Phone != tt.expectedPhone {
+				t.Errorf("Expected phone %s, got %s", tt.expectedPhone, tt.requestBody.Phone)
+			}
+		})
+	}
+}
+
+func TestValidateUserPatch(t *testing.T) {
+	email := "not an email"
+	phone := "2015550123"
+
+	tc := []struct {
+		name           string
+		patch          dto.UserPatchBody
+		expectedErrors []response.FieldError
+	}{
+		{
+			name:  "Valid patch",
+			patch: dto.UserPatchBody{Phone: &phone, Fields: []string{"phone"}},
+		},
+		{
+			name:  "Unknown, null and invalid members",
+			patch: dto.UserPatchBody{Email: &email, Fields: []string{"role", "username", "email"}},
+			expectedErrors: []response.FieldError{
+				{Field: "role", Code: response.FieldErrorUnknown, Message: "Field role is unknown"},
+				{Field: "username", Code: response.FieldErrorRequired, Message: "Username is empty"},
+				{Field: "email", Code: response.FieldErrorFormat, Message: "Email is invalid"},
+			},
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			validator := NewUserValidator(testUserConfig, *utils.NewLogger())
+			err := validator.ValidateUserPatch(&tt.patch)
+			assertFieldErrors(t, err, tt.expectedErrors)
+		})
+	}
+}
+
+func assertFieldErrors(t *testing.T, err error, expectedErrors []response.FieldError) {
+	t.Helper()
+	if expectedErrors == nil {
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+		return
+	}
+	var validationError *response.ValidationError
+	if !errors.As(err, &validationError) {
+		t.Fatalf("Expected a validation error, got %v", err)
+	}
+	if len(validationError.Errors) != len(expectedErrors) {
+		t.Fatalf("Expected %d field errors, got %v", len(expectedErrors), validationError.Errors)
+	}
+	for i, expected := range expectedErrors {
+		if validationError.Errors[i] != expected {
+			t.Errorf("Expected field error %v, got %v", expected, validationError.Errors[i])
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// How often ready holds are checked for a lapsed pickup window
+const holdExpiryInterval = 15 * time.Minute
+
+// runHoldExpiry periodically expires ready holds that were not picked up in
+// time, passing their copies on to the next user in the queue. It returns
+// when ctx is cancelled.
+func (server *APIServer) runHoldExpiry(ctx context.Context) {
+	log := server.logger
+	service := getDefaultHoldService(server, server.dataSource.DB, log)
+	ticker := time.NewTicker(holdExpiryInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("Stopping hold expiry worker")
+			return
+		case <-ticker.C:
+			expired, err := service.ExpireHolds()
+			if err != nil {
+				log.Error(fmt.Sprintf("Error while expiring holds %v", err))
+				continue
+			}
+			if expired > 0 {
+				log.Info(fmt.Sprintf("Expired %d holds", expired))
+			}
+		}
+	}
+}
+
+// How often overdue loans are charged for the days late so far
+const fineAccrualInterval = time.Hour
+
+// runFineAccrual periodically tops up the overdue fines of loans that are
+// still out past their due date. It returns when ctx is cancelled.
+func (server *APIServer) runFineAccrual(ctx context.Context) {
+	log := server.logger
+	service := getDefaultFineService(server, server.dataSource.DB, log)
+	ticker := time.NewTicker(fineAccrualInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("Stopping fine accrual worker")
+			return
+		case <-ticker.C:
+			posted, err := service.AccrueOverdueFines(ctx)
+			if err != nil {
+				log.Error(fmt.Sprintf("Error while accruing overdue fines %v", err))
+				continue
+			}
+			if posted > 0 {
+				log.Info(fmt.Sprintf("Posted %d overdue fines", posted))
+			}
+		}
+	}
+}
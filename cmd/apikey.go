@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/minand-mohan/library-app-api/api/apikeys"
+	"github.com/minand-mohan/library-app-api/api/apikeys/repository"
+	"github.com/minand-mohan/library-app-api/database/models"
+	"github.com/minand-mohan/library-app-api/system"
+)
+
+func runAPIKey(configFlags *system.ConfigFlags, args []string) int {
+	if len(args) == 0 || args[0] != "create" {
+		return usageError("apikey needs create")
+	}
+	flags := flag.NewFlagSet("apikey create", flag.ContinueOnError)
+	name := flags.String("name", "", "what the key is for")
+	if err := flags.Parse(args[1:]); err != nil {
+		return exitUsage
+	}
+	if *name == "" {
+		return usageError("apikey create needs -name")
+	}
+
+	config, err := loadConfig(configFlags)
+	if err != nil {
+		return failure(err)
+	}
+	key, prefix, err := apikeys.GenerateKey()
+	if err != nil {
+		return failure(err)
+	}
+	keyHash := apikeys.HashKey(key)
+	createdAt := time.Now().UTC()
+	dataSource := system.NewDataSource(&config.DB, &config.Users)
+	repo := repository.NewAPIKeyRepository(dataSource.DB)
+	err = repo.CreateAPIKey(&models.APIKey{
+		Name:      name,
+		Prefix:    &prefix,
+		KeyHash:   &keyHash,
+		CreatedAt: &createdAt,
+	})
+	if err != nil {
+		return failure(err)
+	}
+	// the key is not stored, so this is the only time it can be shown
+	fmt.Println(key)
+	return exitOK
+}
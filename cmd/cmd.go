@@ -0,0 +1,96 @@
+// Package cmd implements the subcommands of the library-app-api binary.
+// Started without a subcommand the binary serves the API.
+package cmd
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/minand-mohan/library-app-api/system"
+	"github.com/minand-mohan/library-app-api/utils"
+)
+
+// Exit codes
+const (
+	exitOK      = 0
+	exitFailure = 1
+	exitUsage   = 2
+)
+
+const usage = `usage: library-app-api [config flags] <command> [arguments]
+
+commands:
+  serve                               start the API server
+  migrate up                          apply every pending migration
+  migrate down [steps]                roll back the latest migrations, 1 by default
+  migrate status                      list migrations and when they were applied
+  seed                                load sample books, items and users
+  users import <file.csv>             create users from a CSV of username,email,phone
+  users export [-include-deleted] <file.csv>
+                                      write every user to a CSV
+  apikey create -name <name>          create an API key and print it
+  healthcheck [live|ready]            probe the local server, ready by default
+
+Settings are read from the file named by -config or CONFIG_FILE, then the
+environment, then the config flags; run with -h to list them.`
+
+type command func(configFlags *system.ConfigFlags, args []string) int
+
+var commands = map[string]command{
+	"serve":       runServe,
+	"migrate":     runMigrate,
+	"seed":        runSeed,
+	"users":       runUsers,
+	"apikey":      runAPIKey,
+	"healthcheck": runHealthcheck,
+}
+
+// Run parses the config flags, runs the subcommand named by the first
+// remaining argument and returns the exit code
+func Run(args []string) int {
+	flags := flag.NewFlagSet("library-app-api", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), usage)
+		fmt.Fprintln(flags.Output(), "\nconfig flags:")
+		flags.PrintDefaults()
+	}
+	configFlags := system.NewConfigFlags(flags)
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+	args = flags.Args()
+	if len(args) == 0 {
+		return runServe(configFlags, nil)
+	}
+	command, ok := commands[args[0]]
+	if !ok {
+		return usageError(fmt.Sprintf("unknown command %s", args[0]))
+	}
+	return command(configFlags, args[1:])
+}
+
+// loadConfig reads the configuration and configures logging. Commands
+// load it once their arguments are checked, so that usage errors are
+// reported without it.
+func loadConfig(configFlags *system.ConfigFlags) (*system.Config, error) {
+	config, err := configFlags.Load()
+	if err != nil {
+		return nil, err
+	}
+	if err := utils.ConfigureLogger(config.Log.Level, config.Log.Format); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+func usageError(message string) int {
+	fmt.Fprintln(os.Stderr, message)
+	fmt.Fprintln(os.Stderr, usage)
+	return exitUsage
+}
+
+func failure(err error) int {
+	fmt.Fprintln(os.Stderr, err)
+	return exitFailure
+}
@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"testing"
+)
+
+func TestRunUsage(t *testing.T) {
+
+	tc := []struct {
+		name string
+		args []string
+	}{
+		{name: "Unknown command", args: []string{"frobnicate"}},
+		{name: "Migrate without direction", args: []string{"migrate"}},
+		{name: "Users without action", args: []string{"users"}},
+		{name: "Users import without file", args: []string{"users", "import"}},
+		{name: "Users export without file", args: []string{"users", "export"}},
+		{name: "API key without action", args: []string{"apikey"}},
+		{name: "API key without name", args: []string{"apikey", "create"}},
+		{name: "Seed with arguments", args: []string{"seed", "extra"}},
+		{name: "Healthcheck of unknown probe", args: []string{"healthcheck", "deep"}},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			code := Run(tt.args)
+			if code != exitUsage {
+				t.Errorf("Expected exit code %d, got %d", exitUsage, code)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/minand-mohan/library-app-api/system"
+)
+
+// The probe fails if the server takes longer than this to answer
+const healthcheckTimeout = 5 * time.Second
+
+// runHealthcheck probes the local server, for container healthchecks in
+// images without curl. It exits 0 when the probe answers 200.
+func runHealthcheck(configFlags *system.ConfigFlags, args []string) int {
+	probe := "ready"
+	if len(args) > 1 {
+		return usageError("healthcheck takes at most one probe")
+	}
+	if len(args) == 1 {
+		probe = args[0]
+	}
+	if probe != "live" && probe != "ready" {
+		return usageError(fmt.Sprintf("unknown probe %s", probe))
+	}
+	config, err := loadConfig(configFlags)
+	if err != nil {
+		return failure(err)
+	}
+	url, err := localURL(config.Server.Address)
+	if err != nil {
+		return failure(err)
+	}
+
+	client := &http.Client{Timeout: healthcheckTimeout}
+	res, err := client.Get(url + "/health/" + probe)
+	if err != nil {
+		return failure(err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return failure(fmt.Errorf("%s probe answered %s", probe, res.Status))
+	}
+	return exitOK
+}
+
+// localURL returns the URL of a server listening on address from the same
+// host
+func localURL(address string) (string, error) {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return "", err
+	}
+	if host == "" || host == "0.0.0.0" || host == "::" {
+		host = "127.0.0.1"
+	}
+	return "http://" + net.JoinHostPort(host, port), nil
+}
@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/minand-mohan/library-app-api/database"
+	"github.com/minand-mohan/library-app-api/system"
+	"github.com/minand-mohan/library-app-api/utils"
+)
+
+func runMigrate(configFlags *system.ConfigFlags, args []string) int {
+	if len(args) == 0 {
+		return usageError("migrate needs up, down or status")
+	}
+	config, err := loadConfig(configFlags)
+	if err != nil {
+		return failure(err)
+	}
+	migrations, err := database.Migrations(config.Users.PhoneDefaultRegion)
+	if err != nil {
+		return failure(err)
+	}
+	// migrating on connect would defeat down and status
+	dataSource := system.ConnectDataSource(&config.DB)
+	migrator := database.NewMigrator(dataSource.DB, migrations, *utils.NewLogger())
+
+	switch args[0] {
+	case "up":
+		err = migrator.Up()
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			steps, err = strconv.Atoi(args[1])
+			if err != nil || steps < 1 {
+				return usageError("steps must be a positive integer")
+			}
+		}
+		err = migrator.Down(steps)
+	case "status":
+		var states []database.MigrationState
+		states, err = migrator.Status()
+		for _, state := range states {
+			appliedAt := "pending"
+			if state.AppliedAt != nil {
+				appliedAt = state.AppliedAt.Format("2006-01-02 15:04:05 MST")
+			}
+			fmt.Printf("%04d_%s\t%s\n", state.Version, state.Name, appliedAt)
+		}
+	default:
+		return usageError(fmt.Sprintf("unknown migrate command %s", args[0]))
+	}
+	if err != nil {
+		return failure(err)
+	}
+	return exitOK
+}
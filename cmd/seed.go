@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	bookDto "github.com/minand-mohan/library-app-api/api/books/dto"
+	bookRepository "github.com/minand-mohan/library-app-api/api/books/repository"
+	bookService "github.com/minand-mohan/library-app-api/api/books/service"
+	fineRepository "github.com/minand-mohan/library-app-api/api/fines/repository"
+	itemDto "github.com/minand-mohan/library-app-api/api/items/dto"
+	itemRepository "github.com/minand-mohan/library-app-api/api/items/repository"
+	itemService "github.com/minand-mohan/library-app-api/api/items/service"
+	"github.com/minand-mohan/library-app-api/api/response"
+	userDto "github.com/minand-mohan/library-app-api/api/users/dto"
+	userRepository "github.com/minand-mohan/library-app-api/api/users/repository"
+	userService "github.com/minand-mohan/library-app-api/api/users/service"
+	"github.com/minand-mohan/library-app-api/system"
+	"github.com/minand-mohan/library-app-api/utils"
+)
+
+// Copies seeded for every book
+const seedItemsPerBook = 2
+
+var seedBooks = []bookDto.BookRequestBody{
+	{Title: "The Pragmatic Programmer", Subtitle: "Your Journey to Mastery", ISBN13: "9780135957059", PublicationYear: 2019, Language: "en", PageCount: 352, Edition: "2nd"},
+	{Title: "Designing Data-Intensive Applications", ISBN13: "9781449373320", PublicationYear: 2017, Language: "en", PageCount: 616, Edition: "1st"},
+	{Title: "The Go Programming Language", ISBN13: "9780134190440", PublicationYear: 2015, Language: "en", PageCount: 380, Edition: "1st"},
+}
+
+var seedUsers = []userDto.UserRequestBody{
+	{Username: "alice", Email: "alice@example.org", Phone: "+12015550100"},
+	{Username: "bob", Email: "bob@example.org", Phone: "+12015550101"},
+}
+
+// runSeed loads sample data through the services. Records that already
+// exist are left alone, so seeding twice is harmless.
+func runSeed(configFlags *system.ConfigFlags, args []string) int {
+	if len(args) > 0 {
+		return usageError("seed takes no arguments")
+	}
+	config, err := loadConfig(configFlags)
+	if err != nil {
+		return failure(err)
+	}
+	logger := utils.NewLogger()
+	dataSource := system.NewDataSource(&config.DB, &config.Users)
+	books := bookService.NewBookService(bookRepository.NewBookRepository(dataSource.DB), *logger)
+	items := itemService.NewItemService(itemRepository.NewItemRepository(dataSource.DB), bookRepository.NewBookRepository(dataSource.DB), *logger)
+	users := userService.NewUserService(userRepository.NewUserRepository(dataSource.DB), fineRepository.NewFineRepository(dataSource.DB), *logger)
+
+	for i := range seedBooks {
+		responseBody, err := books.CreateBook(&seedBooks[i])
+		if err != nil && !isAlreadyExists(responseBody) {
+			return failure(fmt.Errorf("book %s: %s", seedBooks[i].Title, responseBody.Message))
+		}
+		bookId := *responseBody.Content.(map[string]interface{})["id"].(*uuid.UUID)
+		for copyNumber := 1; copyNumber <= seedItemsPerBook; copyNumber++ {
+			itemReq := &itemDto.ItemRequestBody{
+				Barcode:       fmt.Sprintf("SEED-%d-%d", i+1, copyNumber),
+				ShelfLocation: fmt.Sprintf("A%d", i+1),
+				Condition:     "good",
+			}
+			responseBody, err := items.CreateItem(bookId, itemReq)
+			if err != nil && !isAlreadyExists(responseBody) {
+				return failure(fmt.Errorf("item %s: %s", itemReq.Barcode, responseBody.Message))
+			}
+		}
+	}
+	for i := range seedUsers {
+		responseBody, err := users.CreateUser(context.Background(), &seedUsers[i])
+		if err != nil && !isAlreadyExists(responseBody) {
+			return failure(fmt.Errorf("user %s: %s", seedUsers[i].Username, responseBody.Message))
+		}
+	}
+	fmt.Printf("Seeded %d books with %d copies each and %d users\n", len(seedBooks), seedItemsPerBook, len(seedUsers))
+	return exitOK
+}
+
+// isAlreadyExists reports whether a create was refused because the record
+// was seeded before
+func isAlreadyExists(responseBody *response.HTTPResponse) bool {
+	return responseBody != nil && responseBody.Code == 400 && strings.HasSuffix(responseBody.Message, "already exists")
+}
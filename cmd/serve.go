@@ -0,0 +1,25 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/minand-mohan/library-app-api/api"
+	"github.com/minand-mohan/library-app-api/system"
+)
+
+func runServe(configFlags *system.ConfigFlags, args []string) int {
+	if len(args) > 0 {
+		return usageError("serve takes no arguments")
+	}
+	config, err := loadConfig(configFlags)
+	if err != nil {
+		return failure(err)
+	}
+	apiServer := api.NewServer(config)
+	fmt.Println("Starting server..")
+	if err := apiServer.StartServer(); err != nil {
+		return failure(err)
+	}
+	fmt.Println("Server shutdown gracefully")
+	return exitOK
+}
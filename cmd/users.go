@@ -0,0 +1,175 @@
+package cmd
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	fineRepository "github.com/minand-mohan/library-app-api/api/fines/repository"
+	"github.com/minand-mohan/library-app-api/api/response"
+	"github.com/minand-mohan/library-app-api/api/users/dto"
+	"github.com/minand-mohan/library-app-api/api/users/repository"
+	"github.com/minand-mohan/library-app-api/api/users/service"
+	"github.com/minand-mohan/library-app-api/api/users/validator"
+	"github.com/minand-mohan/library-app-api/system"
+	"github.com/minand-mohan/library-app-api/utils"
+)
+
+// Users are exported a page at a time
+const exportPageSize = 500
+
+var userExportHeader = []string{"id", "username", "email", "phone", "version", "deleted_at"}
+
+func runUsers(configFlags *system.ConfigFlags, args []string) int {
+	if len(args) == 0 {
+		return usageError("users needs import or export")
+	}
+	switch args[0] {
+	case "import":
+		return runUsersImport(configFlags, args[1:])
+	case "export":
+		return runUsersExport(configFlags, args[1:])
+	}
+	return usageError(fmt.Sprintf("unknown users command %s", args[0]))
+}
+
+// runUsersImport creates a user for every row of a CSV with a header naming
+// the username, email and phone columns. Rows go through the same
+// validation and duplicate checks as POST /users; failed rows are reported
+// and skipped.
+func runUsersImport(configFlags *system.ConfigFlags, args []string) int {
+	if len(args) != 1 {
+		return usageError("users import needs a file")
+	}
+	file, err := os.Open(args[0])
+	if err != nil {
+		return failure(err)
+	}
+	defer file.Close()
+	reader := csv.NewReader(file)
+	header, err := reader.Read()
+	if err != nil {
+		return failure(fmt.Errorf("reading header: %w", err))
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[name] = i
+	}
+	for _, name := range dto.UserPatchFields {
+		if _, ok := columns[name]; !ok {
+			return failure(fmt.Errorf("header has no %s column", name))
+		}
+	}
+
+	config, err := loadConfig(configFlags)
+	if err != nil {
+		return failure(err)
+	}
+	logger := utils.NewLogger()
+	dataSource := system.NewDataSource(&config.DB, &config.Users)
+	userValidator := validator.NewUserValidator(&config.Users, *logger)
+	userService := service.NewUserService(repository.NewUserRepository(dataSource.DB), fineRepository.NewFineRepository(dataSource.DB), *logger)
+
+	created, failed := 0, 0
+	for row := 2; ; row++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return failure(fmt.Errorf("row %d: %w", row, err))
+		}
+		userReq := &dto.UserRequestBody{
+			Username: record[columns["username"]],
+			Email:    record[columns["email"]],
+			Phone:    record[columns["phone"]],
+		}
+		err = userValidator.ValidateUser(userReq)
+		if err == nil {
+			var responseBody *response.HTTPResponse
+			responseBody, err = userService.CreateUser(context.Background(), userReq)
+			if err != nil {
+				err = errors.New(responseBody.Message)
+			}
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "row %d: %v\n", row, err)
+			failed++
+			continue
+		}
+		created++
+	}
+	fmt.Printf("%d users created, %d rows failed\n", created, failed)
+	if failed > 0 {
+		return exitFailure
+	}
+	return exitOK
+}
+
+// runUsersExport writes every user to a CSV, in id order
+func runUsersExport(configFlags *system.ConfigFlags, args []string) int {
+	flags := flag.NewFlagSet("users export", flag.ContinueOnError)
+	includeDeleted := flags.Bool("include-deleted", false, "export soft deleted users too")
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+	if flags.NArg() != 1 {
+		return usageError("users export needs a file")
+	}
+	config, err := loadConfig(configFlags)
+	if err != nil {
+		return failure(err)
+	}
+	file, err := os.Create(flags.Arg(0))
+	if err != nil {
+		return failure(err)
+	}
+	defer file.Close()
+
+	dataSource := system.NewDataSource(&config.DB, &config.Users)
+	repo := repository.NewUserRepository(dataSource.DB)
+	writer := csv.NewWriter(file)
+	writer.Write(userExportHeader)
+	queryParams := &dto.UserQueryParams{
+		PageSize:       exportPageSize,
+		IncludeDeleted: *includeDeleted,
+	}
+	exported := 0
+	for {
+		users, err := repo.FindAllUsers(context.Background(), queryParams)
+		if err != nil {
+			return failure(err)
+		}
+		for _, user := range users {
+			deletedAt := ""
+			if user.DeletedAt.Valid {
+				deletedAt = user.DeletedAt.Time.Format(time.RFC3339)
+			}
+			writer.Write([]string{
+				user.ID.String(),
+				*user.Username,
+				*user.Email,
+				*user.Phone,
+				strconv.FormatInt(*user.Version, 10),
+				deletedAt,
+			})
+		}
+		exported += len(users)
+		if len(users) < exportPageSize {
+			break
+		}
+		queryParams.After = users[len(users)-1].ID.String()
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return failure(err)
+	}
+	fmt.Printf("%d users exported\n", exported)
+	return exitOK
+}
@@ -0,0 +1,138 @@
+// Package dberrors is the set of errors the repositories report, so that the
+// layers above can tell a missing row from a constraint violation or an
+// outage without looking at driver messages.
+package dberrors
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"regexp"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+)
+
+var (
+	// No row matched the lookup
+	ErrNotFound = errors.New("record not found")
+	// A write broke a unique or foreign key constraint, see ConflictError
+	ErrConflict = errors.New("conflicting record")
+	// The database could not be reached or is not accepting work
+	ErrUnavailable = errors.New("database unavailable")
+	// A write guarded by a row version found the row at another version
+	ErrStaleVersion = errors.New("stale version")
+)
+
+// ConflictError names the constraint a write broke and, when it can be told,
+// the column behind it. ForeignKey tells a row that is still referenced, or
+// references a missing one, from a duplicate value.
+type ConflictError struct {
+	Field      string
+	Constraint string
+	ForeignKey bool
+	Err        error
+}
+
+func (err *ConflictError) Error() string {
+	if err.Field == "" {
+		return fmt.Sprintf("%s: %v", ErrConflict, err.Err)
+	}
+	return fmt.Sprintf("%s on %s: %v", ErrConflict, err.Field, err.Err)
+}
+
+func (err *ConflictError) Unwrap() error {
+	return err.Err
+}
+
+func (err *ConflictError) Is(target error) bool {
+	return target == ErrConflict
+}
+
+// Postgres error codes, see https://www.postgresql.org/docs/current/errcodes-appendix.html
+const (
+	codeUniqueViolation     = "23505"
+	codeForeignKeyViolation = "23503"
+	codeTooManyConnections  = "53300"
+	codeAdminShutdown       = "57P01"
+	codeCrashShutdown       = "57P02"
+	codeCannotConnectNow    = "57P03"
+	// Class 08, connection exceptions
+	classConnectionException = "08"
+)
+
+// Postgres details a key violation as `Key (email)=(a@example.com) already exists.`
+var keyDetail = regexp.MustCompile(`^Key \((.+?)\)=\(`)
+
+// The column of an expression index key, such as `lower(email)`
+var keyExpressionColumn = regexp.MustCompile(`\(+(\w+)`)
+
+// Translate maps an error from gorm or the postgres driver onto the errors of
+// this package, wrapping the original. Errors it does not know about and ones
+// it already translated are returned as they are.
+func Translate(err error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, ErrNotFound) || errors.Is(err, ErrConflict) ||
+		errors.Is(err, ErrUnavailable) || errors.Is(err, ErrStaleVersion) {
+		return err
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return fmt.Errorf("%w: %w", ErrNotFound, err)
+	}
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch {
+		case pgErr.Code == codeUniqueViolation || pgErr.Code == codeForeignKeyViolation:
+			return &ConflictError{
+				Field:      conflictField(pgErr),
+				Constraint: pgErr.ConstraintName,
+				ForeignKey: pgErr.Code == codeForeignKeyViolation,
+				Err:        err,
+			}
+		case strings.HasPrefix(pgErr.Code, classConnectionException),
+			pgErr.Code == codeTooManyConnections,
+			pgErr.Code == codeAdminShutdown,
+			pgErr.Code == codeCrashShutdown,
+			pgErr.Code == codeCannotConnectNow:
+			return fmt.Errorf("%w: %w", ErrUnavailable, err)
+		}
+		return err
+	}
+	if isConnectionError(err) {
+		return fmt.Errorf("%w: %w", ErrUnavailable, err)
+	}
+	return err
+}
+
+// conflictField reads the column out of the violation detail, falling back
+// on the constraint name gorm and postgres give unique columns,
+// <table>_<column>_key
+func conflictField(pgErr *pgconn.PgError) string {
+	if match := keyDetail.FindStringSubmatch(pgErr.Detail); match != nil {
+		if column := keyExpressionColumn.FindStringSubmatch(match[1]); column != nil {
+			return column[1]
+		}
+		return match[1]
+	}
+	field := strings.TrimPrefix(pgErr.ConstraintName, pgErr.TableName+"_")
+	field = strings.TrimSuffix(field, "_fkey")
+	return strings.TrimSuffix(field, "_key")
+}
+
+func isConnectionError(err error) bool {
+	var netErr net.Error
+	return errors.As(err, &netErr) ||
+		errors.Is(err, driver.ErrBadConn) ||
+		errors.Is(err, sql.ErrConnDone) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, context.DeadlineExceeded) ||
+		pgconn.Timeout(err) ||
+		pgconn.SafeToRetry(err)
+}
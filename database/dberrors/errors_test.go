@@ -0,0 +1,143 @@
+package dberrors
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+)
+
+func TestTranslate(t *testing.T) {
+	otherError := errors.New("syntax error")
+
+	tc := []struct {
+		name          string
+		err           error
+		expectedError error
+		expectedField string
+		expectedFK    bool
+	}{
+		{
+			name: "Nil stays nil",
+			err:  nil,
+		},
+		{
+			name:          "Record not found",
+			err:           gorm.ErrRecordNotFound,
+			expectedError: ErrNotFound,
+		},
+		{
+			name: "Unique violation on an expression index",
+			err: &pgconn.PgError{
+				Code:           "23505",
+				Message:        `duplicate key value violates unique constraint "idx_users_username_lower"`,
+				Detail:         "Key (lower(username))=(alice) already exists.",
+				TableName:      "users",
+				ConstraintName: "idx_users_username_lower",
+			},
+			expectedError: ErrConflict,
+			expectedField: "username",
+		},
+		{
+			name: "Unique violation",
+			err: &pgconn.PgError{
+				Code:           "23505",
+				Message:        `duplicate key value violates unique constraint "users_email_key"`,
+				Detail:         "Key (email)=(test@example.com) already exists.",
+				TableName:      "users",
+				ConstraintName: "users_email_key",
+			},
+			expectedError: ErrConflict,
+			expectedField: "email",
+		},
+		{
+			name: "Unique violation without detail",
+			err: &pgconn.PgError{
+				Code:           "23505",
+				TableName:      "users",
+				ConstraintName: "users_username_key",
+			},
+			expectedError: ErrConflict,
+			expectedField: "username",
+		},
+		{
+			name: "Foreign key violation",
+			err: &pgconn.PgError{
+				Code:           "23503",
+				Detail:         `Key (user_id)=(d3b3b3b3-3b3b-3b3b-3b3b-3b3b3b3b3b3b) is not present in table "users".`,
+				TableName:      "loans",
+				ConstraintName: "fk_loans_user",
+			},
+			expectedError: ErrConflict,
+			expectedField: "user_id",
+			expectedFK:    true,
+		},
+		{
+			name:          "Connection exception",
+			err:           &pgconn.PgError{Code: "08006"},
+			expectedError: ErrUnavailable,
+		},
+		{
+			name:          "Server shutting down",
+			err:           &pgconn.PgError{Code: "57P01"},
+			expectedError: ErrUnavailable,
+		},
+		{
+			name:          "Connection refused",
+			err:           fmt.Errorf("failed to connect: %w", &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}),
+			expectedError: ErrUnavailable,
+		},
+		{
+			name:          "Bad connection",
+			err:           driver.ErrBadConn,
+			expectedError: ErrUnavailable,
+		},
+		{
+			name:          "Other postgres error",
+			err:           &pgconn.PgError{Code: "42601"},
+			expectedError: nil,
+		},
+		{
+			name:          "Other error",
+			err:           otherError,
+			expectedError: otherError,
+		},
+		{
+			name:          "Already translated",
+			err:           ErrStaleVersion,
+			expectedError: ErrStaleVersion,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Translate(tt.err)
+			if tt.err == nil {
+				if err != nil {
+					t.Errorf("Expected nil, got %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.err) {
+				t.Errorf("Expected %v to wrap %v", err, tt.err)
+			}
+			if tt.expectedError != nil && !errors.Is(err, tt.expectedError) {
+				t.Errorf("Expected %v to be %v", err, tt.expectedError)
+			}
+			if tt.expectedError == nil && (errors.Is(err, ErrNotFound) || errors.Is(err, ErrConflict) || errors.Is(err, ErrUnavailable)) {
+				t.Errorf("Expected %v to be left alone", err)
+			}
+			var conflict *ConflictError
+			if errors.As(err, &conflict) && conflict.Field != tt.expectedField {
+				t.Errorf("Expected conflict on %s, got %s", tt.expectedField, conflict.Field)
+			}
+			if conflict != nil && conflict.ForeignKey != tt.expectedFK {
+				t.Errorf("Expected foreign key conflict %v, got %v", tt.expectedFK, conflict.ForeignKey)
+			}
+		})
+	}
+}
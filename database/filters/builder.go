@@ -0,0 +1,221 @@
+package filters
+
+import (
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Builder collects filter and sort conditions for a query. Conditions are
+// only accepted on a whitelist of columns and every value is passed to the
+// database as a bind parameter, so request input never ends up in the SQL.
+// Conditions are ANDed together; use Or for alternatives.
+type Builder struct {
+	columns    map[string]bool
+	conditions []clause.Expression
+	orders     []clause.OrderByColumn
+	err        error
+}
+
+// NewBuilder returns a builder accepting conditions on the given columns
+func NewBuilder(columns ...string) *Builder {
+	allowed := make(map[string]bool, len(columns))
+	for _, column := range columns {
+		allowed[column] = true
+	}
+	return &Builder{columns: allowed}
+}
+
+// New returns an empty builder with the same column whitelist, for use as an
+// alternative passed to Or
+func (builder *Builder) New() *Builder {
+	return &Builder{columns: builder.columns}
+}
+
+// Allows reports whether column is on the builder's whitelist
+func (builder *Builder) Allows(column string) bool {
+	return builder.columns[column]
+}
+
+// Err returns the first error hit while building, such as a condition on a
+// column that is not on the whitelist
+func (builder *Builder) Err() error {
+	return builder.err
+}
+
+func (builder *Builder) column(name string) (clause.Column, bool) {
+	if !builder.columns[name] {
+		if builder.err == nil {
+			builder.err = fmt.Errorf("column %q cannot be filtered or sorted on", name)
+		}
+		return clause.Column{}, false
+	}
+	return clause.Column{Name: name}, true
+}
+
+func (builder *Builder) add(name string, condition func(column clause.Column) clause.Expression) *Builder {
+	column, ok := builder.column(name)
+	if ok {
+		builder.conditions = append(builder.conditions, condition(column))
+	}
+	return builder
+}
+
+// Eq matches rows where column equals value
+func (builder *Builder) Eq(column string, value interface{}) *Builder {
+	return builder.add(column, func(column clause.Column) clause.Expression {
+		return clause.Eq{Column: column, Value: value}
+	})
+}
+
+// Ne matches rows where column does not equal value
+func (builder *Builder) Ne(column string, value interface{}) *Builder {
+	return builder.add(column, func(column clause.Column) clause.Expression {
+		return clause.Neq{Column: column, Value: value}
+	})
+}
+
+// In matches rows where column equals any of values
+func (builder *Builder) In(column string, values ...interface{}) *Builder {
+	return builder.add(column, func(column clause.Column) clause.Expression {
+		return clause.IN{Column: column, Values: values}
+	})
+}
+
+// Prefix matches rows where column starts with value, ignoring case
+func (builder *Builder) Prefix(column string, value string) *Builder {
+	return builder.add(column, func(column clause.Column) clause.Expression {
+		return clause.Expr{SQL: "? ILIKE ?", Vars: []interface{}{column, escapeLike(value) + "%"}}
+	})
+}
+
+// Contains matches rows where column contains value, ignoring case
+func (builder *Builder) Contains(column string, value string) *Builder {
+	return builder.add(column, func(column clause.Column) clause.Expression {
+		return clause.Expr{SQL: "? ILIKE ?", Vars: []interface{}{column, "%" + escapeLike(value) + "%"}}
+	})
+}
+
+// Range matches rows where column lies between min and max, inclusive. A nil
+// bound leaves that end of the range open.
+func (builder *Builder) Range(column string, min interface{}, max interface{}) *Builder {
+	if min != nil {
+		builder.add(column, func(column clause.Column) clause.Expression {
+			return clause.Gte{Column: column, Value: min}
+		})
+	}
+	if max != nil {
+		builder.add(column, func(column clause.Column) clause.Expression {
+			return clause.Lte{Column: column, Value: max}
+		})
+	}
+	return builder
+}
+
+// IsNull matches rows where column is NULL
+func (builder *Builder) IsNull(column string) *Builder {
+	return builder.add(column, func(column clause.Column) clause.Expression {
+		return clause.Eq{Column: column, Value: nil}
+	})
+}
+
+// NotNull matches rows where column is not NULL
+func (builder *Builder) NotNull(column string) *Builder {
+	return builder.add(column, func(column clause.Column) clause.Expression {
+		return clause.Neq{Column: column, Value: nil}
+	})
+}
+
+// Or matches rows matching any of the alternatives, each of which is built
+// with New
+func (builder *Builder) Or(alternatives ...*Builder) *Builder {
+	var exprs []clause.Expression
+	for _, alternative := range alternatives {
+		if alternative.err != nil && builder.err == nil {
+			builder.err = alternative.err
+		}
+		if len(alternative.conditions) > 0 {
+			exprs = append(exprs, clause.And(alternative.conditions...))
+		}
+	}
+	if len(exprs) > 0 {
+		builder.conditions = append(builder.conditions, clause.Or(exprs...))
+	}
+	return builder
+}
+
+// OrderBy sorts by column, after any earlier sort columns
+func (builder *Builder) OrderBy(column string, desc bool) *Builder {
+	orderColumn, ok := builder.column(column)
+	if ok {
+		builder.orders = append(builder.orders, clause.OrderByColumn{Column: orderColumn, Desc: desc})
+	}
+	return builder
+}
+
+// Apply adds the conditions and sort order to db. If building failed, the
+// error is added to db so the query is not run.
+func (builder *Builder) Apply(db *gorm.DB) *gorm.DB {
+	if builder.err != nil {
+		db = db.Session(&gorm.Session{})
+		db.AddError(builder.err)
+		return db
+	}
+	if len(builder.conditions) > 0 {
+		db = db.Clauses(clause.Where{Exprs: builder.conditions})
+	}
+	for _, order := range builder.orders {
+		db = db.Order(order)
+	}
+	return db
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// escapeLike escapes the LIKE wildcards in value so it matches literally
+func escapeLike(value string) string {
+	return likeEscaper.Replace(value)
+}
+
+// SortField is one column of a sort spec
+type SortField struct {
+	Column string
+	Desc   bool
+}
+
+// ParseSort parses a comma separated sort spec such as "-username,email",
+// where a leading "-" sorts that column descending
+func ParseSort(spec string) []SortField {
+	var fields []SortField
+	for _, column := range ParseFields(spec) {
+		field := SortField{Column: column}
+		if strings.HasPrefix(column, "-") {
+			field.Column = column[1:]
+			field.Desc = true
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// ParseFields splits a comma separated list such as "id,email"
+func ParseFields(spec string) []string {
+	if spec == "" {
+		return nil
+	}
+	fields := strings.Split(spec, ",")
+	for i := range fields {
+		fields[i] = strings.TrimSpace(fields[i])
+	}
+	return fields
+}
+
+// Sort sorts by each of fields in turn, after any earlier sort columns
+func (builder *Builder) Sort(fields []SortField) *Builder {
+	for _, field := range fields {
+		builder.OrderBy(field.Column, field.Desc)
+	}
+	return builder
+}
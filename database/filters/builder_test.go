@@ -0,0 +1,164 @@
+package filters
+
+import (
+	"reflect"
+	"testing"
+
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func createDryRunDB() *gorm.DB {
+	db, _, _ := sqlmock.New()
+	dialector := postgres.New(postgres.Config{
+		DSN:                  "sqlmock_db_0",
+		DriverName:           "postgres",
+		Conn:                 db,
+		PreferSimpleProtocol: true,
+	})
+	sDb, _ := gorm.Open(dialector, &gorm.Config{DryRun: true})
+	return sDb
+}
+
+func TestBuilder(t *testing.T) {
+
+	tc := []struct {
+		name          string
+		build         func(builder *Builder) *Builder
+		expectedSQL   string
+		expectedVars  []interface{}
+		expectedError bool
+	}{
+		{
+			name: "No conditions",
+			build: func(builder *Builder) *Builder {
+				return builder
+			},
+			expectedSQL: `SELECT * FROM "users"`,
+		},
+		{
+			name: "Equality and inequality",
+			build: func(builder *Builder) *Builder {
+				return builder.Eq("email", "test1@example.com").Ne("username", "test2")
+			},
+			expectedSQL:  `SELECT * FROM "users" WHERE "email" = $1 AND "username" <> $2`,
+			expectedVars: []interface{}{"test1@example.com", "test2"},
+		},
+		{
+			name: "In",
+			build: func(builder *Builder) *Builder {
+				return builder.In("username", "test1", "test2")
+			},
+			expectedSQL:  `SELECT * FROM "users" WHERE "username" IN ($1,$2)`,
+			expectedVars: []interface{}{"test1", "test2"},
+		},
+		{
+			name: "Prefix and contains escape wildcards",
+			build: func(builder *Builder) *Builder {
+				return builder.Prefix("username", "te_st").Contains("email", "100%")
+			},
+			expectedSQL:  `SELECT * FROM "users" WHERE "username" ILIKE $1 AND "email" ILIKE $2`,
+			expectedVars: []interface{}{`te\_st%`, `%100\%%`},
+		},
+		{
+			name: "Open ended range",
+			build: func(builder *Builder) *Builder {
+				return builder.Range("phone", "100", nil).Range("username", nil, "m")
+			},
+			expectedSQL:  `SELECT * FROM "users" WHERE "phone" >= $1 AND "username" <= $2`,
+			expectedVars: []interface{}{"100", "m"},
+		},
+		{
+			name: "Null checks",
+			build: func(builder *Builder) *Builder {
+				return builder.IsNull("phone").NotNull("email")
+			},
+			expectedSQL: `SELECT * FROM "users" WHERE "phone" IS NULL AND "email" IS NOT NULL`,
+		},
+		{
+			name: "Or of alternatives",
+			build: func(builder *Builder) *Builder {
+				return builder.Eq("username", "test1").Or(
+					builder.New().Eq("email", "test1@example.com"),
+					builder.New().Eq("phone", "1234567890"),
+				)
+			},
+			expectedSQL:  `SELECT * FROM "users" WHERE "username" = $1 AND ("email" = $2 OR "phone" = $3)`,
+			expectedVars: []interface{}{"test1", "test1@example.com", "1234567890"},
+		},
+		{
+			name: "Sort order",
+			build: func(builder *Builder) *Builder {
+				return builder.OrderBy("username", true).OrderBy("email", false)
+			},
+			expectedSQL: `SELECT * FROM "users" ORDER BY "username" DESC,"email"`,
+		},
+		{
+			name: "Parsed sort spec",
+			build: func(builder *Builder) *Builder {
+				return builder.Sort(ParseSort("-username, email"))
+			},
+			expectedSQL: `SELECT * FROM "users" ORDER BY "username" DESC,"email"`,
+		},
+		{
+			name: "Column not on whitelist",
+			build: func(builder *Builder) *Builder {
+				return builder.Eq("password; DROP TABLE users", "x")
+			},
+			expectedError: true,
+		},
+		{
+			name: "Sort column not on whitelist",
+			build: func(builder *Builder) *Builder {
+				return builder.OrderBy("created_at", false)
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			builder := tt.build(NewBuilder("id", "username", "email", "phone"))
+			var rows []map[string]interface{}
+			result := builder.Apply(createDryRunDB().Table("users")).Find(&rows)
+			if tt.expectedError {
+				if result.Error == nil || builder.Err() == nil {
+					t.Errorf("Expected an error, got none")
+				}
+				return
+			}
+			if result.Error != nil {
+				t.Errorf("Expected no error, got %v", result.Error)
+			}
+			if sql := result.Statement.SQL.String(); sql != tt.expectedSQL {
+				t.Errorf("Expected SQL %s, got %s", tt.expectedSQL, sql)
+			}
+			if len(tt.expectedVars) > 0 && !reflect.DeepEqual(result.Statement.Vars, tt.expectedVars) {
+				t.Errorf("Expected vars %v, got %v", tt.expectedVars, result.Statement.Vars)
+			}
+		})
+	}
+}
+
+func TestParseFields(t *testing.T) {
+	tc := []struct {
+		name     string
+		spec     string
+		expected []string
+	}{
+		{name: "Empty spec", spec: "", expected: nil},
+		{name: "Single field", spec: "id", expected: []string{"id"}},
+		{name: "Several fields", spec: "id, email ,phone", expected: []string{"id", "email", "phone"}},
+		{name: "Empty field", spec: "id,,email", expected: []string{"id", "", "email"}},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			fields := ParseFields(tt.spec)
+			if !reflect.DeepEqual(fields, tt.expected) {
+				t.Errorf("Expected fields %v, got %v", tt.expected, fields)
+			}
+		})
+	}
+}
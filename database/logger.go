@@ -0,0 +1,79 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/minand-mohan/library-app-api/utils"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+)
+
+// Queries slower than this are logged as warnings
+const slowQueryThreshold = 200 * time.Millisecond
+
+// GormLogger writes gorm's messages and queries through an AppLogger, so
+// that queries made for a request carry its fields. Failed queries are
+// errors, slow ones warnings and the rest debug lines. Queries are logged
+// with their placeholders rather than the values bound to them, which may
+// be personal data or secrets.
+type GormLogger struct {
+	logger *utils.AppLogger
+}
+
+func NewGormLogger(logger *utils.AppLogger) *GormLogger {
+	return &GormLogger{logger: logger}
+}
+
+// LogMode is ignored, the level of the AppLogger applies
+func (gormLogger *GormLogger) LogMode(level gormlogger.LogLevel) gormlogger.Interface {
+	return gormLogger
+}
+
+func (gormLogger *GormLogger) Info(ctx context.Context, message string, data ...interface{}) {
+	gormLogger.logger.Info(fmt.Sprintf(message, data...))
+}
+
+func (gormLogger *GormLogger) Warn(ctx context.Context, message string, data ...interface{}) {
+	gormLogger.logger.Warn(fmt.Sprintf(message, data...))
+}
+
+func (gormLogger *GormLogger) Error(ctx context.Context, message string, data ...interface{}) {
+	gormLogger.logger.Error(fmt.Sprintf(message, data...))
+}
+
+// ParamsFilter drops the values bound to a query, so that gorm hands Trace
+// the query with its placeholders
+func (gormLogger *GormLogger) ParamsFilter(ctx context.Context, sql string, params ...interface{}) (string, []interface{}) {
+	return sql, nil
+}
+
+func (gormLogger *GormLogger) Trace(ctx context.Context, begin time.Time, fc func() (sql string, rowsAffected int64), err error) {
+	elapsed := time.Since(begin)
+	failed := err != nil && !errors.Is(err, gorm.ErrRecordNotFound)
+	level := utils.LevelDebug
+	if failed {
+		level = utils.LevelError
+	} else if elapsed > slowQueryThreshold {
+		level = utils.LevelWarn
+	}
+	if !gormLogger.logger.Enabled(level) {
+		return
+	}
+	sql, rows := fc()
+	fields := []utils.Field{
+		utils.F("sql", sql),
+		utils.F("rows", rows),
+		utils.F("duration_ms", float64(elapsed.Microseconds())/1000),
+	}
+	switch level {
+	case utils.LevelError:
+		gormLogger.logger.Error("Query failed", append(fields, utils.F("error", err))...)
+	case utils.LevelWarn:
+		gormLogger.logger.Warn("Slow query", fields...)
+	default:
+		gormLogger.logger.Debug("Query", fields...)
+	}
+}
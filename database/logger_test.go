@@ -0,0 +1,66 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/minand-mohan/library-app-api/utils"
+	"gorm.io/gorm"
+)
+
+func TestGormLoggerTrace(t *testing.T) {
+
+	tc := []struct {
+		name           string
+		elapsed        time.Duration
+		err            error
+		expectRendered bool
+	}{
+		{
+			name:           "Query below the log level",
+			expectRendered: false,
+		},
+		{
+			name:           "Record not found below the log level",
+			err:            gorm.ErrRecordNotFound,
+			expectRendered: false,
+		},
+		{
+			name:           "Slow query",
+			elapsed:        time.Second,
+			expectRendered: true,
+		},
+		{
+			name:           "Failed query",
+			err:            errors.New("boom"),
+			expectRendered: true,
+		},
+	}
+
+	// the base logger writes info and above
+	gormLogger := NewGormLogger(utils.NewLogger())
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			rendered := false
+			gormLogger.Trace(context.Background(), time.Now().Add(-tt.elapsed), func() (string, int64) {
+				rendered = true
+				return `SELECT * FROM "users" WHERE email = $1`, 0
+			}, tt.err)
+			if rendered != tt.expectRendered {
+				t.Errorf("Expected the query to be rendered: %v, got %v", tt.expectRendered, rendered)
+			}
+		})
+	}
+}
+
+func TestGormLoggerParamsFilter(t *testing.T) {
+	sql, params := NewGormLogger(utils.NewLogger()).ParamsFilter(context.Background(), `SELECT * FROM "users" WHERE email = $1`, "alice@example.org")
+	if sql != `SELECT * FROM "users" WHERE email = $1` {
+		t.Errorf("Expected the query to be kept, got %s", sql)
+	}
+	if len(params) != 0 {
+		t.Errorf("Expected the bound values to be dropped, got %v", params)
+	}
+}
@@ -1,13 +1,296 @@
 package database
 
 import (
-	"github.com/minand-mohan/library-app-api/database/models"
+	"context"
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
 	"github.com/minand-mohan/library-app-api/utils"
 	"gorm.io/gorm"
 )
 
-func Migrate(repo *gorm.DB) {
-	log := utils.NewLogger()
-	log.Info("Migrating database")
-	repo.AutoMigrate(&models.User{})
+// Migration is one versioned change to the schema. Each step runs in its own
+// transaction along with the schema_migrations row recording it.
+type Migration struct {
+	Version int64
+	Name    string
+	Up      func(tx *gorm.DB) error
+	Down    func(tx *gorm.DB) error
+}
+
+// MigrationState is a migration and when it was applied, nil if pending
+type MigrationState struct {
+	Version   int64
+	Name      string
+	AppliedAt *time.Time
+}
+
+// Key of the postgres advisory lock held while migrating, so that replicas
+// starting together apply each migration once
+const migrationLockID = 72834019
+
+//go:embed migrations/*.sql
+var sqlMigrationFiles embed.FS
+
+// SQL migrations are named <version>_<name>.up.sql and <version>_<name>.down.sql
+var sqlMigrationName = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// goMigrations are the migrations that need more than SQL. National phone
+// numbers already stored are read as numbers of phoneRegion.
+func goMigrations(phoneRegion string) []Migration {
+	return []Migration{
+		{
+			Version: 2,
+			Name:    "normalize_users",
+			Up: func(tx *gorm.DB) error {
+				log := utils.NewLogger()
+				conflicts, err := NormalizeUsers(tx)
+				for _, conflict := range conflicts {
+					log.Error(fmt.Sprintf("User conflict: %s", conflict))
+				}
+				return err
+			},
+			Down: func(tx *gorm.DB) error {
+				return tx.Exec(`DROP INDEX IF EXISTS idx_users_email_lower, idx_users_username_lower`).Error
+			},
+		},
+		{
+			Version: 4,
+			Name:    "normalize_phones",
+			Up: func(tx *gorm.DB) error {
+				log := utils.NewLogger()
+				conflicts, err := NormalizePhones(tx, phoneRegion)
+				for _, conflict := range conflicts {
+					log.Error(fmt.Sprintf("Phone conflict: %s", conflict))
+				}
+				return err
+			},
+			// the original formatting of a number is not kept
+			Down: func(tx *gorm.DB) error {
+				return nil
+			},
+		},
+	}
+}
+
+// Migrations returns every migration in version order. phoneRegion is the
+// region of national phone numbers, see system.UserConfig.
+func Migrations(phoneRegion string) ([]Migration, error) {
+	byVersion := map[int64]*Migration{}
+	goSteps := goMigrations(phoneRegion)
+	for i := range goSteps {
+		byVersion[goSteps[i].Version] = &goSteps[i]
+	}
+	entries, err := fs.ReadDir(sqlMigrationFiles, "migrations")
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		match := sqlMigrationName.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("migration file %s is misnamed", entry.Name())
+		}
+		version, _ := strconv.ParseInt(match[1], 10, 64)
+		content, err := sqlMigrationFiles.ReadFile(path.Join("migrations", entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		}
+		if migration.Name != match[2] {
+			return nil, fmt.Errorf("migration version %d is used by both %s and %s", version, migration.Name, match[2])
+		}
+		step := sqlMigration(string(content))
+		if match[3] == "up" {
+			migration.Up = step
+		} else {
+			migration.Down = step
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == nil || migration.Down == nil {
+			return nil, fmt.Errorf("migration %d_%s needs both an up and a down step", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+func sqlMigration(statements string) func(tx *gorm.DB) error {
+	return func(tx *gorm.DB) error {
+		return tx.Exec(statements).Error
+	}
+}
+
+// Migrator applies and rolls back migrations, tracking them in the
+// schema_migrations table
+type Migrator struct {
+	db         *gorm.DB
+	migrations []Migration
+	logger     *utils.AppLogger
+}
+
+func NewMigrator(db *gorm.DB, migrations []Migration, logger utils.AppLogger) *Migrator {
+	return &Migrator{
+		db:         db,
+		migrations: migrations,
+		logger:     &logger,
+	}
+}
+
+// Migrate applies every pending migration
+func Migrate(db *gorm.DB, phoneRegion string) error {
+	migrations, err := Migrations(phoneRegion)
+	if err != nil {
+		return err
+	}
+	return NewMigrator(db, migrations, *utils.NewLogger()).Up()
+}
+
+// Up applies every pending migration in version order
+func (migrator *Migrator) Up() error {
+	return migrator.locked(func(conn *gorm.DB) error {
+		applied, err := migrator.applied(conn)
+		if err != nil {
+			return err
+		}
+		for _, migration := range migrator.migrations {
+			if _, ok := applied[migration.Version]; ok {
+				continue
+			}
+			migrator.logger.Info(fmt.Sprintf("Applying migration %d_%s", migration.Version, migration.Name))
+			err := conn.Transaction(func(tx *gorm.DB) error {
+				if err := migration.Up(tx); err != nil {
+					return err
+				}
+				return tx.Exec(`INSERT INTO schema_migrations (version, name) VALUES (?, ?)`, migration.Version, migration.Name).Error
+			})
+			if err != nil {
+				return fmt.Errorf("migration %d_%s: %w", migration.Version, migration.Name, err)
+			}
+		}
+		return nil
+	})
+}
+
+// Down rolls back the latest steps applied migrations
+func (migrator *Migrator) Down(steps int) error {
+	return migrator.locked(func(conn *gorm.DB) error {
+		applied, err := migrator.applied(conn)
+		if err != nil {
+			return err
+		}
+		for i := len(migrator.migrations) - 1; i >= 0 && steps > 0; i-- {
+			migration := migrator.migrations[i]
+			if _, ok := applied[migration.Version]; !ok {
+				continue
+			}
+			migrator.logger.Info(fmt.Sprintf("Rolling back migration %d_%s", migration.Version, migration.Name))
+			err := conn.Transaction(func(tx *gorm.DB) error {
+				if err := migration.Down(tx); err != nil {
+					return err
+				}
+				return tx.Exec(`DELETE FROM schema_migrations WHERE version = ?`, migration.Version).Error
+			})
+			if err != nil {
+				return fmt.Errorf("migration %d_%s: %w", migration.Version, migration.Name, err)
+			}
+			steps--
+		}
+		return nil
+	})
+}
+
+// Status lists every migration and when it was applied
+func (migrator *Migrator) Status() ([]MigrationState, error) {
+	var states []MigrationState
+	err := migrator.db.Connection(func(conn *gorm.DB) error {
+		if err := createMigrationsTable(conn); err != nil {
+			return err
+		}
+		applied, err := migrator.applied(conn)
+		if err != nil {
+			return err
+		}
+		for _, migration := range migrator.migrations {
+			state := MigrationState{Version: migration.Version, Name: migration.Name}
+			if appliedAt, ok := applied[migration.Version]; ok {
+				state.AppliedAt = &appliedAt
+			}
+			states = append(states, state)
+		}
+		return nil
+	})
+	return states, err
+}
+
+// Pending lists the migrations that are not applied yet. Unlike Status it
+// neither creates schema_migrations nor waits for the lock, so it is safe to
+// call from a readiness probe, with a deadline on ctx.
+func (migrator *Migrator) Pending(ctx context.Context) ([]Migration, error) {
+	applied, err := migrator.applied(migrator.db.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	var pending []Migration
+	for _, migration := range migrator.migrations {
+		if _, ok := applied[migration.Version]; !ok {
+			pending = append(pending, migration)
+		}
+	}
+	return pending, nil
+}
+
+// locked runs fn on a single connection holding the migration lock, waiting
+// for any other migrator to finish first
+func (migrator *Migrator) locked(fn func(conn *gorm.DB) error) error {
+	return migrator.db.Connection(func(conn *gorm.DB) error {
+		if err := conn.Exec(`SELECT pg_advisory_lock(?)`, migrationLockID).Error; err != nil {
+			return err
+		}
+		defer conn.Exec(`SELECT pg_advisory_unlock(?)`, migrationLockID)
+		if err := createMigrationsTable(conn); err != nil {
+			return err
+		}
+		return fn(conn)
+	})
+}
+
+func createMigrationsTable(conn *gorm.DB) error {
+	return conn.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+		version bigint PRIMARY KEY,
+		name text NOT NULL,
+		applied_at timestamptz NOT NULL DEFAULT now()
+	)`).Error
+}
+
+// applied maps the versions of applied migrations to when they were applied
+func (migrator *Migrator) applied(conn *gorm.DB) (map[int64]time.Time, error) {
+	var rows []struct {
+		Version   int64
+		AppliedAt time.Time
+	}
+	result := conn.Raw(`SELECT version, applied_at FROM schema_migrations`).Scan(&rows)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	applied := make(map[int64]time.Time, len(rows))
+	for _, row := range rows {
+		applied[row.Version] = row.AppliedAt
+	}
+	return applied, nil
 }
@@ -0,0 +1,156 @@
+package database
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/minand-mohan/library-app-api/utils"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func createTestDB() (sqlmock.Sqlmock, *gorm.DB) {
+	db, mock, _ := sqlmock.New()
+	sDb, _ := gorm.Open(postgres.New(postgres.Config{
+		DSN:                  "sqlmock_db_0",
+		DriverName:           "postgres",
+		Conn:                 db,
+		PreferSimpleProtocol: true,
+	}), &gorm.Config{})
+	return mock, sDb
+}
+
+func testMigrations() []Migration {
+	return []Migration{
+		{Version: 1, Name: "create_a", Up: sqlMigration("CREATE TABLE a ()"), Down: sqlMigration("DROP TABLE a")},
+		{Version: 2, Name: "create_b", Up: sqlMigration("CREATE TABLE b ()"), Down: sqlMigration("DROP TABLE b")},
+	}
+}
+
+func expectLocked(mock sqlmock.Sqlmock, appliedVersions ...int64) {
+	mock.ExpectExec(regexp.QuoteMeta(`SELECT pg_advisory_lock($1)`)).
+		WithArgs(migrationLockID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(`CREATE TABLE IF NOT EXISTS schema_migrations`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	rows := sqlmock.NewRows([]string{"version", "applied_at"})
+	for _, version := range appliedVersions {
+		rows.AddRow(version, time.Now())
+	}
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT version, applied_at FROM schema_migrations`)).
+		WillReturnRows(rows)
+}
+
+func expectUnlocked(mock sqlmock.Sqlmock) {
+	mock.ExpectExec(regexp.QuoteMeta(`SELECT pg_advisory_unlock($1)`)).
+		WithArgs(migrationLockID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+}
+
+func TestMigrations(t *testing.T) {
+	migrations, err := Migrations("US")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	for i, migration := range migrations {
+		if migration.Version != int64(i+1) {
+			t.Errorf("Expected migration %d to have version %d, got %d", i, i+1, migration.Version)
+		}
+	}
+	if len(migrations) < 2 || migrations[0].Name != "baseline" || migrations[1].Name != "normalize_users" {
+		t.Errorf("Unexpected migrations %v", migrations)
+	}
+}
+
+func TestBaselineUpgradesReleasedUsersTable(t *testing.T) {
+	migrations, err := Migrations("US")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	mock, db := createTestDB()
+	expectLocked(mock)
+	mock.ExpectBegin()
+	// the first release built users with only id, username, email and phone,
+	// so CREATE TABLE is skipped and the columns are added before the index
+	mock.ExpectExec(`(?s)CREATE TABLE IF NOT EXISTS users \(.*\);\s*` +
+		regexp.QuoteMeta(`ALTER TABLE users ADD COLUMN IF NOT EXISTS version bigint NOT NULL DEFAULT 1;`) + `\s*` +
+		regexp.QuoteMeta(`ALTER TABLE users ADD COLUMN IF NOT EXISTS deleted_at timestamptz;`) + `\s*` +
+		regexp.QuoteMeta(`CREATE INDEX IF NOT EXISTS idx_users_deleted_at ON users (deleted_at);`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO schema_migrations (version, name) VALUES ($1, $2)`)).
+		WithArgs(1, "baseline").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	expectUnlocked(mock)
+
+	err = NewMigrator(db, migrations[:1], *utils.NewLogger()).Up()
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Unmet expectations: %v", err)
+	}
+}
+
+func TestMigratorUp(t *testing.T) {
+	mock, db := createTestDB()
+	expectLocked(mock, 1)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`CREATE TABLE b ()`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO schema_migrations (version, name) VALUES ($1, $2)`)).
+		WithArgs(2, "create_b").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	expectUnlocked(mock)
+
+	err := NewMigrator(db, testMigrations(), *utils.NewLogger()).Up()
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Unmet expectations: %v", err)
+	}
+}
+
+func TestMigratorDown(t *testing.T) {
+	mock, db := createTestDB()
+	expectLocked(mock, 1, 2)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`DROP TABLE b`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM schema_migrations WHERE version = $1`)).
+		WithArgs(2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	expectUnlocked(mock)
+
+	err := NewMigrator(db, testMigrations(), *utils.NewLogger()).Down(1)
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Unmet expectations: %v", err)
+	}
+}
+
+func TestMigratorPending(t *testing.T) {
+	mock, db := createTestDB()
+	rows := sqlmock.NewRows([]string{"version", "applied_at"}).AddRow(1, time.Now())
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT version, applied_at FROM schema_migrations`)).
+		WillReturnRows(rows)
+
+	pending, err := NewMigrator(db, testMigrations(), *utils.NewLogger()).Pending(context.Background())
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if len(pending) != 1 || pending[0].Version != 2 {
+		t.Errorf("Expected migration 2 to be pending, got %v", pending)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Unmet expectations: %v", err)
+	}
+}
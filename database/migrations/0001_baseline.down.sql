@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS fine_entries;
+DROP TABLE IF EXISTS holds;
+DROP TABLE IF EXISTS loans;
+DROP TABLE IF EXISTS items;
+DROP TABLE IF EXISTS books;
+DROP TABLE IF EXISTS users;
@@ -0,0 +1,96 @@
+-- The schema AutoMigrate used to build. Every statement is guarded so that
+-- databases it already built are brought up to it: tables are only created
+-- when missing, and the users table of the first release, which has only id,
+-- username, email and phone, gains the columns added since.
+
+CREATE TABLE IF NOT EXISTS users (
+    id uuid DEFAULT gen_random_uuid(),
+    username text NOT NULL UNIQUE,
+    email text NOT NULL UNIQUE,
+    phone text NOT NULL UNIQUE,
+    version bigint NOT NULL DEFAULT 1,
+    deleted_at timestamptz,
+    PRIMARY KEY (id)
+);
+ALTER TABLE users ADD COLUMN IF NOT EXISTS version bigint NOT NULL DEFAULT 1;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS deleted_at timestamptz;
+CREATE INDEX IF NOT EXISTS idx_users_deleted_at ON users (deleted_at);
+
+CREATE TABLE IF NOT EXISTS books (
+    id uuid DEFAULT gen_random_uuid(),
+    title text NOT NULL,
+    subtitle text,
+    isbn10 text UNIQUE,
+    isbn13 text UNIQUE,
+    publication_year bigint,
+    language text,
+    page_count bigint,
+    edition text,
+    PRIMARY KEY (id)
+);
+
+CREATE TABLE IF NOT EXISTS items (
+    id uuid DEFAULT gen_random_uuid(),
+    book_id uuid NOT NULL,
+    barcode text NOT NULL UNIQUE,
+    shelf_location text,
+    condition text,
+    status text NOT NULL DEFAULT 'available',
+    acquired_at date,
+    retired_at timestamptz,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_items_book FOREIGN KEY (book_id) REFERENCES books (id) ON DELETE RESTRICT
+);
+CREATE INDEX IF NOT EXISTS idx_items_book_id ON items (book_id);
+
+CREATE TABLE IF NOT EXISTS loans (
+    id uuid DEFAULT gen_random_uuid(),
+    user_id uuid NOT NULL,
+    item_id uuid NOT NULL,
+    checked_out_at timestamptz NOT NULL,
+    due_at timestamptz NOT NULL,
+    returned_at timestamptz,
+    renewal_count bigint NOT NULL,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_loans_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE RESTRICT,
+    CONSTRAINT fk_loans_item FOREIGN KEY (item_id) REFERENCES items (id) ON DELETE RESTRICT
+);
+CREATE INDEX IF NOT EXISTS idx_loans_user_id ON loans (user_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_loans_active_item ON loans (item_id) WHERE returned_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS holds (
+    id uuid DEFAULT gen_random_uuid(),
+    user_id uuid NOT NULL,
+    book_id uuid NOT NULL,
+    item_id uuid,
+    status text NOT NULL,
+    placed_at timestamptz NOT NULL,
+    ready_at timestamptz,
+    expires_at timestamptz,
+    closed_at timestamptz,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_holds_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE RESTRICT,
+    CONSTRAINT fk_holds_book FOREIGN KEY (book_id) REFERENCES books (id) ON DELETE RESTRICT,
+    CONSTRAINT fk_holds_item FOREIGN KEY (item_id) REFERENCES items (id) ON DELETE RESTRICT
+);
+CREATE INDEX IF NOT EXISTS idx_holds_user_id ON holds (user_id);
+CREATE INDEX IF NOT EXISTS idx_holds_item_id ON holds (item_id);
+CREATE INDEX IF NOT EXISTS idx_holds_queue ON holds (book_id, status, placed_at);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_holds_active_user_book ON holds (user_id, book_id) WHERE closed_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS fine_entries (
+    id uuid DEFAULT gen_random_uuid(),
+    user_id uuid NOT NULL,
+    loan_id uuid,
+    item_id uuid,
+    kind text NOT NULL,
+    amount_cents bigint NOT NULL,
+    note text,
+    created_at timestamptz NOT NULL,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_fine_entries_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE RESTRICT,
+    CONSTRAINT fk_fine_entries_loan FOREIGN KEY (loan_id) REFERENCES loans (id) ON DELETE RESTRICT,
+    CONSTRAINT fk_fine_entries_item FOREIGN KEY (item_id) REFERENCES items (id) ON DELETE RESTRICT
+);
+CREATE INDEX IF NOT EXISTS idx_fine_entries_user_id ON fine_entries (user_id);
+CREATE INDEX IF NOT EXISTS idx_fine_entries_loan_id ON fine_entries (loan_id);
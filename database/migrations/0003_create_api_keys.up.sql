@@ -0,0 +1,9 @@
+CREATE TABLE api_keys (
+    id uuid DEFAULT gen_random_uuid(),
+    name text NOT NULL,
+    prefix text NOT NULL,
+    key_hash text NOT NULL UNIQUE,
+    created_at timestamptz NOT NULL,
+    revoked_at timestamptz,
+    PRIMARY KEY (id)
+);
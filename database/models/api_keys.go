@@ -0,0 +1,18 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// APIKey authenticates clients of the API. Only a SHA-256 hash of the key is
+// stored; Prefix is the start of the key, kept to tell keys apart.
+type APIKey struct {
+	ID        *uuid.UUID `gorm:"primary_key;type:uuid;default:gen_random_uuid();"`
+	Name      *string    `gorm:"not null" json:"name"`
+	Prefix    *string    `gorm:"not null" json:"prefix"`
+	KeyHash   *string    `gorm:"unique;not null" json:"-"`
+	CreatedAt *time.Time `gorm:"not null" json:"created_at"`
+	RevokedAt *time.Time `json:"revoked_at"`
+}
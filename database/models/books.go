@@ -0,0 +1,15 @@
+package models
+
+import "github.com/google/uuid"
+
+type Book struct {
+	ID              *uuid.UUID `gorm:"primary_key;type:uuid;default:gen_random_uuid();"`
+	Title           *string    `gorm:"not null" json:"title"`
+	Subtitle        *string    `json:"subtitle"`
+	ISBN10          *string    `gorm:"column:isbn10;unique" json:"isbn10"`
+	ISBN13          *string    `gorm:"column:isbn13;unique" json:"isbn13"`
+	PublicationYear *int       `json:"publication_year"`
+	Language        *string    `json:"language"`
+	PageCount       *int       `json:"page_count"`
+	Edition         *string    `json:"edition"`
+}
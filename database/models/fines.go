@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	FineKindOverdue = "overdue"
+	FineKindLost    = "lost"
+	FineKindDamaged = "damaged"
+	FineKindPayment = "payment"
+	FineKindWaiver  = "waiver"
+)
+
+// FineEntry is a line in a user's fines ledger. Charges are recorded with a
+// positive amount and payments and waivers with a negative one, so a user's
+// outstanding balance is the sum of their entries. Entries are never
+// updated or deleted.
+type FineEntry struct {
+	ID          *uuid.UUID `gorm:"primary_key;type:uuid;default:gen_random_uuid();"`
+	UserID      *uuid.UUID `gorm:"type:uuid;not null;index" json:"user_id"`
+	User        *User      `gorm:"constraint:OnDelete:RESTRICT;" json:"-"`
+	LoanID      *uuid.UUID `gorm:"type:uuid;index" json:"loan_id"`
+	Loan        *Loan      `gorm:"constraint:OnDelete:RESTRICT;" json:"-"`
+	ItemID      *uuid.UUID `gorm:"type:uuid" json:"item_id"`
+	Item        *Item      `gorm:"constraint:OnDelete:RESTRICT;" json:"-"`
+	Kind        *string    `gorm:"not null" json:"kind"`
+	AmountCents *int64     `gorm:"not null" json:"amount_cents"`
+	Note        *string    `json:"note"`
+	CreatedAt   *time.Time `gorm:"not null" json:"created_at"`
+}
@@ -0,0 +1,37 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// HoldStatusPending holds are queued waiting for a copy
+	HoldStatusPending = "pending"
+	// HoldStatusReady holds have a copy set aside until ExpiresAt
+	HoldStatusReady     = "ready"
+	HoldStatusFulfilled = "fulfilled"
+	HoldStatusCancelled = "cancelled"
+	HoldStatusExpired   = "expired"
+)
+
+// HoldActiveStatuses are the statuses of holds that are still in the queue
+var HoldActiveStatuses = []string{HoldStatusPending, HoldStatusReady}
+
+// Hold is a user's request to borrow the next available copy of a book.
+// Pending holds on a book are served first in, first out by PlacedAt.
+type Hold struct {
+	ID        *uuid.UUID `gorm:"primary_key;type:uuid;default:gen_random_uuid();"`
+	UserID    *uuid.UUID `gorm:"type:uuid;not null;index;uniqueIndex:idx_holds_active_user_book,where:closed_at IS NULL" json:"user_id"`
+	User      *User      `gorm:"constraint:OnDelete:RESTRICT;" json:"-"`
+	BookID    *uuid.UUID `gorm:"type:uuid;not null;index:idx_holds_queue,priority:1;uniqueIndex:idx_holds_active_user_book" json:"book_id"`
+	Book      *Book      `gorm:"constraint:OnDelete:RESTRICT;" json:"-"`
+	ItemID    *uuid.UUID `gorm:"type:uuid;index" json:"item_id"`
+	Item      *Item      `gorm:"constraint:OnDelete:RESTRICT;" json:"-"`
+	Status    *string    `gorm:"not null;index:idx_holds_queue,priority:2" json:"status"`
+	PlacedAt  *time.Time `gorm:"not null;index:idx_holds_queue,priority:3" json:"placed_at"`
+	ReadyAt   *time.Time `json:"ready_at"`
+	ExpiresAt *time.Time `json:"expires_at"`
+	ClosedAt  *time.Time `json:"closed_at"`
+}
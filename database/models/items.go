@@ -0,0 +1,27 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ItemStatusAvailable = "available"
+	ItemStatusOnLoan    = "on_loan"
+	ItemStatusOnHold    = "on_hold"
+	ItemStatusRetired   = "retired"
+)
+
+// Item is a physical copy of a book
+type Item struct {
+	ID            *uuid.UUID `gorm:"primary_key;type:uuid;default:gen_random_uuid();"`
+	BookID        *uuid.UUID `gorm:"type:uuid;not null;index" json:"book_id"`
+	Book          *Book      `gorm:"constraint:OnDelete:RESTRICT;" json:"-"`
+	Barcode       *string    `gorm:"unique;not null" json:"barcode"`
+	ShelfLocation *string    `json:"shelf_location"`
+	Condition     *string    `json:"condition"`
+	Status        *string    `gorm:"not null;default:available" json:"status"`
+	AcquiredAt    *time.Time `gorm:"type:date" json:"acquired_at"`
+	RetiredAt     *time.Time `json:"retired_at"`
+}
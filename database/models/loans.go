@@ -0,0 +1,22 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Loan is the checkout of a single item to a user. A loan is active until
+// ReturnedAt is set; the partial unique index guarantees at most one active
+// loan per item.
+type Loan struct {
+	ID           *uuid.UUID `gorm:"primary_key;type:uuid;default:gen_random_uuid();"`
+	UserID       *uuid.UUID `gorm:"type:uuid;not null;index" json:"user_id"`
+	User         *User      `gorm:"constraint:OnDelete:RESTRICT;" json:"-"`
+	ItemID       *uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_loans_active_item,where:returned_at IS NULL" json:"item_id"`
+	Item         *Item      `gorm:"constraint:OnDelete:RESTRICT;" json:"-"`
+	CheckedOutAt *time.Time `gorm:"not null" json:"checked_out_at"`
+	DueAt        *time.Time `gorm:"not null" json:"due_at"`
+	ReturnedAt   *time.Time `json:"returned_at"`
+	RenewalCount *int       `gorm:"not null" json:"renewal_count"`
+}
@@ -1,10 +1,25 @@
 package models
 
-import "github.com/google/uuid"
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
 
+// User is soft deleted: deleting one sets DeletedAt and gorm leaves it out
+// of queries unless they are Unscoped. A deleted user keeps their username,
+// email and phone reserved so that they can be restored.
+//
+// Emails are stored lowercase and usernames keep their case, both are unique
+// regardless of case through the lower() indexes built by the
+// normalize_users migration.
+//
+// Version is bumped on every update, delete and restore and guards writes
+// against concurrent edits, it is exposed to clients as the ETag of the user.
 type User struct {
-	ID       *uuid.UUID `gorm:"primary_key;type:uuid;default:gen_random_uuid();"`
-	Username *string    `gorm:"unique;not null" json:"username"`
-	Email    *string    `gorm:"unique;not null" json:"email"`
-	Phone    *string    `gorm:"unique;not null" json:"phone"`
+	ID        *uuid.UUID     `gorm:"primary_key;type:uuid;default:gen_random_uuid();"`
+	Username  *string        `gorm:"unique;not null" json:"username"`
+	Email     *string        `gorm:"unique;not null" json:"email"`
+	Phone     *string        `gorm:"unique;not null" json:"phone"`
+	Version   *int64         `gorm:"not null;default:1" json:"version"`
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"deleted_at"`
 }
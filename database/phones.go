@@ -0,0 +1,86 @@
+package database
+
+import (
+	"fmt"
+
+	"github.com/minand-mohan/library-app-api/database/models"
+	"github.com/minand-mohan/library-app-api/utils/phone"
+	"gorm.io/gorm"
+)
+
+// PhoneConflict records a stored phone number that could not be normalized,
+// either because it is not a valid number or because its E.164 form is
+// already held by another user. The phone was left as it is and needs an
+// admin to correct it.
+type PhoneConflict struct {
+	UserID string
+	Phone  string
+	// E.164 form of Phone, empty if it is not a valid number
+	Normalized string
+	// User holding Normalized, empty if Phone is not a valid number
+	KeptID string
+}
+
+func (conflict PhoneConflict) String() string {
+	if conflict.Normalized == "" {
+		return fmt.Sprintf("phone %q of user %s is not a valid number, left unchanged", conflict.Phone, conflict.UserID)
+	}
+	return fmt.Sprintf("phone %q of user %s normalizes to %q, held by user %s, left unchanged", conflict.Phone, conflict.UserID, conflict.Normalized, conflict.KeptID)
+}
+
+// NormalizePhones rewrites stored phone numbers to E.164, reading numbers
+// without a country code as national numbers of defaultRegion. A user that
+// already stores a number in E.164 keeps it; otherwise an active user is
+// kept over a deleted one, then the lowest id. Phones that are invalid or
+// clash are returned and left unchanged, so running it again changes
+// nothing.
+func NormalizePhones(db *gorm.DB, defaultRegion string) ([]PhoneConflict, error) {
+	var conflicts []PhoneConflict
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var users []models.User
+		result := tx.Unscoped().Select("id", "phone").Order("deleted_at IS NOT NULL, id").Find(&users)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		holders := make(map[string]string, len(users))
+		normalized := make([]string, len(users))
+		for i := range users {
+			normalized[i], _ = phone.Normalize(*users[i].Phone, defaultRegion)
+			if normalized[i] == *users[i].Phone {
+				holders[normalized[i]] = users[i].ID.String()
+			}
+		}
+		for i := range users {
+			user := &users[i]
+			if normalized[i] == *user.Phone {
+				continue
+			}
+			if normalized[i] == "" {
+				conflicts = append(conflicts, PhoneConflict{UserID: user.ID.String(), Phone: *user.Phone})
+				continue
+			}
+			if kept, ok := holders[normalized[i]]; ok {
+				conflicts = append(conflicts, PhoneConflict{
+					UserID:     user.ID.String(),
+					Phone:      *user.Phone,
+					Normalized: normalized[i],
+					KeptID:     kept,
+				})
+				continue
+			}
+			result := tx.Unscoped().Model(&models.User{}).
+				Where("id = ?", user.ID).
+				Updates(map[string]interface{}{"phone": normalized[i], "version": gorm.Expr("version + 1")})
+			if result.Error != nil {
+				return result.Error
+			}
+			holders[normalized[i]] = user.ID.String()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return conflicts, nil
+}
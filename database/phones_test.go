@@ -0,0 +1,50 @@
+package database
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/google/uuid"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+func TestNormalizePhones(t *testing.T) {
+	mock, sDb := createTestDB()
+
+	keptID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	updatedID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	clashingID := uuid.MustParse("00000000-0000-0000-0000-000000000003")
+	invalidID := uuid.MustParse("00000000-0000-0000-0000-000000000004")
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "id","phone" FROM "users" ORDER BY deleted_at IS NOT NULL, id`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "phone"}).
+			AddRow(keptID.String(), "+12015550123").
+			AddRow(updatedID.String(), "(201) 555-0124").
+			AddRow(clashingID.String(), "201-555-0123").
+			AddRow(invalidID.String(), "call me"))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "users" SET "phone"=$1,"version"=version + 1 WHERE id = $2`)).
+		WithArgs("+12015550124", updatedID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	conflicts, err := NormalizePhones(sDb, "US")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	expected := []PhoneConflict{
+		{UserID: clashingID.String(), Phone: "201-555-0123", Normalized: "+12015550123", KeptID: keptID.String()},
+		{UserID: invalidID.String(), Phone: "call me"},
+	}
+	if len(conflicts) != len(expected) {
+		t.Fatalf("Expected conflicts %v, got %v", expected, conflicts)
+	}
+	for i := range expected {
+		if conflicts[i] != expected[i] {
+			t.Errorf("Expected conflict %v, got %v", expected[i], conflicts[i])
+		}
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Unmet expectations: %v", err)
+	}
+}
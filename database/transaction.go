@@ -0,0 +1,14 @@
+package database
+
+import (
+	"github.com/minand-mohan/library-app-api/database/dberrors"
+	"gorm.io/gorm"
+)
+
+// Transaction runs fn in a single database transaction, rolled back if fn
+// returns an error and committed otherwise. Errors from fn are translated by
+// the repository calls that raised them, this covers beginning and
+// committing the transaction.
+func Transaction(db *gorm.DB, fn func(tx *gorm.DB) error) error {
+	return dberrors.Translate(db.Transaction(fn))
+}
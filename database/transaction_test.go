@@ -0,0 +1,70 @@
+package database
+
+import (
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	"github.com/minand-mohan/library-app-api/database/dberrors"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+	"gorm.io/gorm"
+)
+
+func TestTransaction(t *testing.T) {
+	fnError := errors.New("fn failed")
+
+	tc := []struct {
+		name          string
+		fn            func(tx *gorm.DB) error
+		mockFunction  func(mock sqlmock.Sqlmock)
+		expectedError error
+	}{
+		{
+			name: "Transaction committed",
+			fn: func(tx *gorm.DB) error {
+				return nil
+			},
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectCommit()
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Transaction rolled back",
+			fn: func(tx *gorm.DB) error {
+				return fnError
+			},
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectRollback()
+			},
+			expectedError: fnError,
+		},
+		{
+			name: "Transaction failed to commit",
+			fn: func(tx *gorm.DB) error {
+				return nil
+			},
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectCommit().WillReturnError(driver.ErrBadConn)
+			},
+			expectedError: dberrors.ErrUnavailable,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, db := createTestDB()
+			tt.mockFunction(mock)
+			err := Transaction(db, tt.fn)
+			if !errors.Is(err, tt.expectedError) || (err == nil) != (tt.expectedError == nil) {
+				t.Errorf("Expected error: %v, got: %v", tt.expectedError, err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Unmet expectations: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package database
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/minand-mohan/library-app-api/database/models"
+	"gorm.io/gorm"
+)
+
+// UserConflict records a user whose email or username clashed with another
+// user's once case is ignored. The user was renamed to RenamedTo so that the
+// case-insensitive unique indexes can be built, and needs an admin to pick
+// its real value.
+type UserConflict struct {
+	Field     string
+	Value     string
+	KeptID    string
+	RenamedID string
+	RenamedTo string
+}
+
+func (conflict UserConflict) String() string {
+	return fmt.Sprintf("%s %q of user %s clashes with user %s, renamed to %q", conflict.Field, conflict.Value, conflict.RenamedID, conflict.KeptID, conflict.RenamedTo)
+}
+
+// NormalizeUsers lowercases stored emails and makes emails and usernames
+// unique regardless of case. Within a group of clashing users an active user
+// is kept over a deleted one, then the lowest id; the rest are renamed and
+// returned. Running it again is a no-op.
+func NormalizeUsers(db *gorm.DB) ([]UserConflict, error) {
+	var conflicts []UserConflict
+	err := db.Transaction(func(tx *gorm.DB) error {
+		for _, field := range []string{"email", "username"} {
+			fieldConflicts, err := renameCaseInsensitiveDuplicates(tx, field)
+			if err != nil {
+				return err
+			}
+			conflicts = append(conflicts, fieldConflicts...)
+		}
+		err := tx.Exec(`UPDATE users SET email = lower(email) WHERE email <> lower(email)`).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Exec(`CREATE UNIQUE INDEX IF NOT EXISTS idx_users_email_lower ON users (lower(email))`).Error
+		if err != nil {
+			return err
+		}
+		return tx.Exec(`CREATE UNIQUE INDEX IF NOT EXISTS idx_users_username_lower ON users (lower(username))`).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return conflicts, nil
+}
+
+func renameCaseInsensitiveDuplicates(tx *gorm.DB, field string) ([]UserConflict, error) {
+	var users []models.User
+	result := tx.Unscoped().
+		Where(fmt.Sprintf("lower(%[1]s) IN (SELECT lower(%[1]s) FROM users GROUP BY lower(%[1]s) HAVING COUNT(*) > 1)", field)).
+		Order(fmt.Sprintf("lower(%s), deleted_at IS NOT NULL, id", field)).
+		Find(&users)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	var conflicts []UserConflict
+	var kept *models.User
+	for i := range users {
+		user := &users[i]
+		value := userField(user, field)
+		if kept == nil || !strings.EqualFold(userField(kept, field), value) {
+			kept = user
+			continue
+		}
+		renamed := renamedValue(field, value, user.ID.String())
+		result := tx.Unscoped().Model(&models.User{}).
+			Where("id = ?", user.ID).
+			Updates(map[string]interface{}{field: renamed, "version": gorm.Expr("version + 1")})
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		conflicts = append(conflicts, UserConflict{
+			Field:     field,
+			Value:     value,
+			KeptID:    kept.ID.String(),
+			RenamedID: user.ID.String(),
+			RenamedTo: renamed,
+		})
+	}
+	return conflicts, nil
+}
+
+func userField(user *models.User, field string) string {
+	if field == "email" {
+		return *user.Email
+	}
+	return *user.Username
+}
+
+// Longest username the validator accepts, see validator.UsernameMaxLength,
+// which cannot be imported here without a cycle
+const usernameMaxLength = 32
+
+// renamedValue tags a clashing value with the user's id, keeping an email
+// deliverable through plus addressing. Usernames are tagged with the end of
+// the id instead, shortening the name if needed, so that the renamed user
+// still passes validation.
+func renamedValue(field string, value string, id string) string {
+	if field == "email" {
+		at := strings.LastIndex(value, "@")
+		if at > 0 {
+			return value[:at] + "+conflict-" + id + value[at:]
+		}
+		return value + "-conflict-" + id
+	}
+	tag := "-" + id[len(id)-8:]
+	name := []rune(value)
+	if keep := usernameMaxLength - len(tag); len(name) > keep {
+		name = name[:keep]
+	}
+	return string(name) + tag
+}
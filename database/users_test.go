@@ -0,0 +1,69 @@
+package database
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+func TestNormalizeUsers(t *testing.T) {
+	mock, sDb := createTestDB()
+
+	keptID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	renamedID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	renamedEmail := "alice+conflict-" + renamedID.String() + "@example.org"
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "users" WHERE lower(email) IN (SELECT lower(email) FROM users GROUP BY lower(email) HAVING COUNT(*) > 1) ORDER BY lower(email), deleted_at IS NOT NULL, id`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "email", "phone"}).
+			AddRow(keptID.String(), "alice", "Alice@example.org", "+15550100").
+			AddRow(renamedID.String(), "alice2", "alice@example.org", "+15550101"))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "users" SET "email"=$1,"version"=version + 1 WHERE id = $2`)).
+		WithArgs(renamedEmail, renamedID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "users" WHERE lower(username) IN`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "email", "phone"}))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE users SET email = lower(email) WHERE email <> lower(email)`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`CREATE UNIQUE INDEX IF NOT EXISTS idx_users_email_lower ON users (lower(email))`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(`CREATE UNIQUE INDEX IF NOT EXISTS idx_users_username_lower ON users (lower(username))`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	conflicts, err := NormalizeUsers(sDb)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	expected := UserConflict{
+		Field:     "email",
+		Value:     "alice@example.org",
+		KeptID:    keptID.String(),
+		RenamedID: renamedID.String(),
+		RenamedTo: renamedEmail,
+	}
+	if len(conflicts) != 1 || conflicts[0] != expected {
+		t.Errorf("Expected conflicts %v, got %v", []UserConflict{expected}, conflicts)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Unmet expectations: %v", err)
+	}
+}
+
+func TestRenamedValue(t *testing.T) {
+	id := "00000000-0000-0000-0000-000000000002"
+	if renamed := renamedValue("email", "bob@example.org", id); renamed != "bob+conflict-"+id+"@example.org" {
+		t.Errorf("Unexpected renamed email %s", renamed)
+	}
+	if renamed := renamedValue("username", "Bob", id); renamed != "Bob-00000002" {
+		t.Errorf("Unexpected renamed username %s", renamed)
+	}
+	long := strings.Repeat("b", usernameMaxLength)
+	renamed := renamedValue("username", long, id)
+	if renamed != strings.Repeat("b", usernameMaxLength-9)+"-00000002" || len(renamed) != usernameMaxLength {
+		t.Errorf("Expected the renamed username to fit in %d characters, got %s", usernameMaxLength, renamed)
+	}
+}
@@ -1,26 +1,11 @@
 package main
 
 import (
-	"context"
-	"fmt"
-	"sync"
+	"os"
 
-	"github.com/minand-mohan/library-app-api/api"
+	"github.com/minand-mohan/library-app-api/cmd"
 )
 
 func main() {
-	_, cancel := context.WithCancel(context.Background())
-	wg := &sync.WaitGroup{}
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		apiServer := api.NewServer()
-		print("Starting server..")
-		apiServer.StartServer()
-	}()
-	// Wait for the server to shutdown
-	cancel()
-	wg.Wait()
-	fmt.Println("Server shutdown gracefully")
-
+	os.Exit(cmd.Run(os.Args[1:]))
 }
@@ -0,0 +1,62 @@
+// Package metrics holds the Prometheus metrics of the API, served in the
+// text format at /metrics
+package metrics
+
+import (
+	"database/sql"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+)
+
+const namespace = "library_app"
+
+// Registry holds every metric of the API, along with the Go runtime and
+// process metrics
+var Registry = prometheus.NewRegistry()
+
+// HTTPRequestDuration is labelled by the route pattern a request matched,
+// e.g. /library-app/api/v1/users/:id, rather than its path, so that the
+// number of series stays bounded
+var HTTPRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: namespace,
+	Name:      "http_request_duration_seconds",
+	Help:      "Time taken to handle HTTP requests.",
+	Buckets:   prometheus.DefBuckets,
+}, []string{"method", "route", "status"})
+
+// Domain counters
+var (
+	UsersCreated = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "users_created_total",
+		Help:      "Users created.",
+	})
+	UsersDeleted = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "users_deleted_total",
+		Help:      "Users soft deleted.",
+	})
+	UsersRestored = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "users_restored_total",
+		Help:      "Soft deleted users restored.",
+	})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		HTTPRequestDuration,
+		UsersCreated,
+		UsersDeleted,
+		UsersRestored,
+	)
+}
+
+// RegisterDB exports the connection pool statistics of db, such as open,
+// in use and idle connections and time spent waiting for one
+func RegisterDB(db *sql.DB, name string) error {
+	return Registry.Register(collectors.NewDBStatsCollector(db, name))
+}
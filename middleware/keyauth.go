@@ -1,30 +1,69 @@
 package middleware
 
 import (
-	"os"
+	"crypto/subtle"
+	"errors"
+	"fmt"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/keyauth/v2"
+	"github.com/minand-mohan/library-app-api/api/apikeys"
+	"github.com/minand-mohan/library-app-api/api/apikeys/repository"
 	"github.com/minand-mohan/library-app-api/api/response"
+	"github.com/minand-mohan/library-app-api/database/dberrors"
+	"github.com/minand-mohan/library-app-api/utils"
 )
 
-func validateAPIKey(c *fiber.Ctx, key string) (bool, error) {
+// Key of the authenticated principal in the locals of a Fiber context
+const principalKey = "principal"
 
-	apiAuthToken, ok := os.LookupEnv("API_AUTH_TOKEN")
-	if !ok {
-		return false, keyauth.ErrMissingOrMalformedAPIKey
+// validateAPIKey accepts the configured token, if any, or an unrevoked key
+// created with `apikey create`, and returns who the key belongs to: "token"
+// or "apikey:" and the prefix of the stored key. A key that matches neither
+// is ErrMissingOrMalformedAPIKey; a failed lookup is returned as it is, so
+// that an outage is not reported as bad credentials.
+func validateAPIKey(token string, repo repository.APIKeyRepository, key string) (string, error) {
+	if token != "" && subtle.ConstantTimeCompare([]byte(key), []byte(token)) == 1 {
+		return "token", nil
 	}
-	if key == apiAuthToken {
-		return true, nil
+	storedKey, err := repo.FindActiveByKeyHash(apikeys.HashKey(key))
+	if err == nil {
+		return "apikey:" + *storedKey.Prefix, nil
 	}
-	return false, keyauth.ErrMissingOrMalformedAPIKey
+	if errors.Is(err, dberrors.ErrNotFound) {
+		return "", keyauth.ErrMissingOrMalformedAPIKey
+	}
+	return "", err
+}
+
+// Principal returns who authenticated the request, empty if nobody did
+func Principal(c *fiber.Ctx) string {
+	principal, _ := c.Locals(principalKey).(string)
+	return principal
 }
+
 func errorHandler(c *fiber.Ctx, err error) error {
+	if !errors.Is(err, keyauth.ErrMissingOrMalformedAPIKey) {
+		utils.ContextLogger(c).Error(fmt.Sprintf("Error while looking up API key %v", err))
+		errorBody := response.GetRepositoryErrorHTTPResponseBody(err, "Missing or invalid Auth Token")
+		return response.WriteHTTPResponse(c, errorBody.Code, errorBody)
+	}
 	errorBody := response.GetErrorHTTPResponseBody(401, "Missing or invalid Auth Token")
 	return response.WriteHTTPResponse(c, 401, errorBody)
 }
 
-var KeyAuth = keyauth.New(keyauth.Config{
-	Validator:    validateAPIKey,
-	ErrorHandler: errorHandler,
-})
+// NewKeyAuth authenticates requests by API key: token, unless empty, or a
+// stored key looked up in repo
+func NewKeyAuth(token string, repo repository.APIKeyRepository) fiber.Handler {
+	return keyauth.New(keyauth.Config{
+		Validator: func(c *fiber.Ctx, key string) (bool, error) {
+			principal, err := validateAPIKey(token, repo, key)
+			if err != nil {
+				return false, err
+			}
+			c.Locals(principalKey, principal)
+			return true, nil
+		},
+		ErrorHandler: errorHandler,
+	})
+}
@@ -0,0 +1,90 @@
+package middleware
+
+import (
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/minand-mohan/library-app-api/api/apikeys"
+	"github.com/minand-mohan/library-app-api/api/apikeys/repository/mocks"
+	"github.com/minand-mohan/library-app-api/database/dberrors"
+	"github.com/minand-mohan/library-app-api/database/models"
+)
+
+func TestKeyAuth(t *testing.T) {
+	tc := []struct {
+		name              string
+		key               string
+		mockFindKey       bool
+		mockFindError     error
+		expectedStatus    int
+		expectedPrincipal string
+	}{
+		{
+			name:              "Configured token",
+			key:               "envtoken",
+			expectedStatus:    200,
+			expectedPrincipal: "token",
+		},
+		{
+			name:              "Stored key",
+			key:               "lib_stored",
+			mockFindKey:       true,
+			expectedStatus:    200,
+			expectedPrincipal: "apikey:lib_stor",
+		},
+		{
+			name:           "Unknown or revoked key",
+			key:            "lib_unknown",
+			mockFindKey:    true,
+			mockFindError:  dberrors.ErrNotFound,
+			expectedStatus: 401,
+		},
+		{
+			name:           "Database unavailable",
+			key:            "lib_stored",
+			mockFindKey:    true,
+			mockFindError:  dberrors.ErrUnavailable,
+			expectedStatus: 503,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockRepo := mocks.NewMockAPIKeyRepository(mockCtrl)
+			if tt.mockFindKey {
+				var key *models.APIKey
+				if tt.mockFindError == nil {
+					prefix := "lib_stor"
+					key = &models.APIKey{Prefix: &prefix}
+				}
+				mockRepo.EXPECT().FindActiveByKeyHash(apikeys.HashKey(tt.key)).Return(key, tt.mockFindError)
+			}
+			app := fiber.New()
+			app.Get("/", NewKeyAuth("envtoken", mockRepo), func(c *fiber.Ctx) error {
+				return c.SendString(Principal(c))
+			})
+			request := httptest.NewRequest("GET", "/", nil)
+			request.Header.Set("Authorization", "Bearer "+tt.key)
+
+			response, err := app.Test(request)
+			if err != nil {
+				t.Errorf("Error while making request %v", err)
+			}
+			if response.StatusCode != tt.expectedStatus {
+				t.Errorf("Expected status code %d, got %d", tt.expectedStatus, response.StatusCode)
+			}
+			if tt.expectedPrincipal != "" {
+				body, _ := io.ReadAll(response.Body)
+				if string(body) != tt.expectedPrincipal {
+					t.Errorf("Expected principal %s, got %s", tt.expectedPrincipal, body)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/minand-mohan/library-app-api/utils"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// NewRequestLogger attaches a logger carrying the request ID, the method
+// and, when the request is traced, the trace ID to every request, for
+// handlers, services and repositories to log with, and writes one access
+// log line per request once it is handled. It must come after NewRequestID
+// and NewTracing.
+func NewRequestLogger(logger *utils.AppLogger) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		start := time.Now()
+		requestLogger := logger.With(
+			utils.F("request_id", RequestID(c)),
+			utils.F("method", c.Method()),
+		)
+		if spanContext := trace.SpanContextFromContext(c.UserContext()); spanContext.IsValid() {
+			requestLogger = requestLogger.With(utils.F("trace_id", spanContext.TraceID().String()))
+		}
+		utils.SetContextLogger(c, requestLogger)
+		err := c.Next()
+		if err != nil {
+			// write the error response now so that its status is logged
+			if err := c.App().Config().ErrorHandler(c, err); err != nil {
+				c.SendStatus(fiber.StatusInternalServerError)
+			}
+		}
+		// the route is only known once the request is routed
+		requestLogger.Info("Request",
+			utils.F("route", c.Route().Path),
+			utils.F("path", c.Path()),
+			utils.F("status", c.Response().StatusCode()),
+			utils.F("bytes", len(c.Response().Body())),
+			utils.F("latency_ms", float64(time.Since(start).Microseconds())/1000),
+			utils.F("principal", Principal(c)),
+		)
+		return nil
+	}
+}
@@ -0,0 +1,25 @@
+package middleware
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/minand-mohan/library-app-api/metrics"
+)
+
+// NewMetrics records how long each request took by method, route pattern
+// and status. It must come before NewRequestLogger, which writes the
+// response of a failed request, so that the final status is recorded.
+func NewMetrics() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		start := time.Now()
+		err := c.Next()
+		metrics.HTTPRequestDuration.WithLabelValues(
+			c.Method(),
+			c.Route().Path,
+			strconv.Itoa(c.Response().StatusCode()),
+		).Observe(time.Since(start).Seconds())
+		return err
+	}
+}
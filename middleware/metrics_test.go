@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/minand-mohan/library-app-api/metrics"
+)
+
+func TestMetrics(t *testing.T) {
+	app := fiber.New()
+	app.Use(NewMetrics())
+	app.Get("/things/:id", func(c *fiber.Ctx) error {
+		return c.SendStatus(204)
+	})
+
+	for _, id := range []string{"1", "2"} {
+		if _, err := app.Test(httptest.NewRequest("GET", "/things/"+id, nil)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	families, err := metrics.Registry.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var count uint64
+	for _, family := range families {
+		if family.GetName() != "library_app_http_request_duration_seconds" {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			labels := map[string]string{}
+			for _, label := range metric.GetLabel() {
+				labels[label.GetName()] = label.GetValue()
+			}
+			if labels["route"] == "/things/:id" && labels["method"] == "GET" && labels["status"] == "204" {
+				count += metric.GetHistogram().GetSampleCount()
+			}
+		}
+	}
+	if count != 2 {
+		t.Errorf("Expected 2 requests recorded under the route pattern, got %d", count)
+	}
+}
@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"regexp"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+// Incoming request IDs are kept only if they are this safe to log and echo
+var requestIDPattern = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+
+// NewRequestID gives every request an ID: the X-Request-ID header sent by
+// the client or a proxy, or a new UUID when it is missing or malformed. The
+// ID is echoed in the X-Request-ID response header.
+func NewRequestID() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		requestID := c.Get(fiber.HeaderXRequestID)
+		if !requestIDPattern.MatchString(requestID) {
+			requestID = uuid.New().String()
+		}
+		c.Set(fiber.HeaderXRequestID, requestID)
+		return c.Next()
+	}
+}
+
+// RequestID returns the ID given to the request by NewRequestID
+func RequestID(c *fiber.Ctx) string {
+	return c.GetRespHeader(fiber.HeaderXRequestID)
+}
@@ -0,0 +1,91 @@
+package middleware
+
+import (
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/minand-mohan/library-app-api/api/response"
+	"github.com/minand-mohan/library-app-api/utils"
+)
+
+func TestRequestID(t *testing.T) {
+
+	tc := []struct {
+		name              string
+		incomingID        string
+		expectGenerated   bool
+		expectedRequestID string
+	}{
+		{
+			name:              "Incoming ID",
+			incomingID:        "edge-1234.abcd",
+			expectedRequestID: "edge-1234.abcd",
+		},
+		{
+			name:            "Missing ID",
+			expectGenerated: true,
+		},
+		{
+			name:            "Malformed ID",
+			incomingID:      "abc\" level=error",
+			expectGenerated: true,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			app := fiber.New()
+			app.Use(NewRequestID(), NewRequestLogger(utils.NewLogger()))
+			app.Get("/", func(c *fiber.Ctx) error {
+				body := response.GetErrorHTTPResponseBody(404, "Not found")
+				return response.WriteHTTPResponse(c, 404, body)
+			})
+			request := httptest.NewRequest("GET", "/", nil)
+			if tt.incomingID != "" {
+				request.Header.Set(fiber.HeaderXRequestID, tt.incomingID)
+			}
+
+			res, err := app.Test(request)
+			if err != nil {
+				t.Fatal(err)
+			}
+			requestID := res.Header.Get(fiber.HeaderXRequestID)
+			if tt.expectGenerated {
+				if _, err := uuid.Parse(requestID); err != nil {
+					t.Errorf("Expected a generated UUID, got %q", requestID)
+				}
+			} else if requestID != tt.expectedRequestID {
+				t.Errorf("Expected request ID %s, got %s", tt.expectedRequestID, requestID)
+			}
+
+			body, _ := io.ReadAll(res.Body)
+			var responseBody response.HTTPResponse
+			if err := json.Unmarshal(body, &responseBody); err != nil {
+				t.Fatalf("Expected a JSON body, got %s", body)
+			}
+			if responseBody.RequestID != requestID {
+				t.Errorf("Expected the error body to carry request ID %s, got %s", requestID, responseBody.RequestID)
+			}
+		})
+	}
+}
+
+func TestRequestLoggerHandlesErrors(t *testing.T) {
+	app := fiber.New()
+	app.Use(NewRequestID(), NewRequestLogger(utils.NewLogger()))
+	app.Get("/", func(c *fiber.Ctx) error {
+		return fiber.ErrTeapot
+	})
+
+	res, err := app.Test(httptest.NewRequest("GET", "/", nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode != fiber.StatusTeapot {
+		t.Errorf("Expected status code %d, got %d", fiber.StatusTeapot, res.StatusCode)
+	}
+}
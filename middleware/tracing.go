@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/minand-mohan/library-app-api/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// NewTracing starts a server span for every request, continuing the trace
+// of an incoming traceparent header, and sets it on the user context for
+// handlers to pass down. It must come after NewRequestID and before
+// NewRequestLogger, which writes the response of a failed request, so that
+// the final status is recorded.
+func NewTracing() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		ctx := otel.GetTextMapPropagator().Extract(c.UserContext(), propagation.HeaderCarrier(c.GetReqHeaders()))
+		ctx, span := tracing.Start(ctx, "HTTP "+c.Method(), trace.WithSpanKind(trace.SpanKindServer))
+		defer span.End()
+		c.SetUserContext(ctx)
+
+		err := c.Next()
+		// the route is only known once the request is routed
+		route := c.Route().Path
+		status := c.Response().StatusCode()
+		span.SetName(c.Method() + " " + route)
+		span.SetAttributes(
+			attribute.String("http.method", c.Method()),
+			attribute.String("http.route", route),
+			attribute.String("http.target", c.OriginalURL()),
+			attribute.Int("http.status_code", status),
+			attribute.String("request_id", RequestID(c)),
+		)
+		if status >= fiber.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(status))
+		}
+		return err
+	}
+}
@@ -0,0 +1,91 @@
+package middleware
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/minand-mohan/library-app-api/tracing"
+	"github.com/minand-mohan/library-app-api/utils"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTracing(t *testing.T) {
+	traceID := "4bf92f3577b34da6a3ce929d0e0e4736"
+
+	tc := []struct {
+		name           string
+		traceparent    string
+		handlerError   error
+		expectedStatus codes.Code
+	}{
+		{
+			name:           "Continues incoming trace",
+			traceparent:    "00-" + traceID + "-00f067aa0ba902b7-01",
+			expectedStatus: codes.Unset,
+		},
+		{
+			name:           "Starts a trace",
+			expectedStatus: codes.Unset,
+		},
+		{
+			name:           "Server error",
+			handlerError:   fiber.ErrInternalServerError,
+			expectedStatus: codes.Error,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := tracetest.NewSpanRecorder()
+			otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+			otel.SetTextMapPropagator(propagation.TraceContext{})
+
+			app := fiber.New()
+			app.Use(NewRequestID(), NewTracing(), NewRequestLogger(utils.NewLogger()))
+			var handlerSpan trace.SpanContext
+			app.Get("/users/:id", func(c *fiber.Ctx) error {
+				_, span := tracing.Start(c.UserContext(), "UserHandler.FindByUserId")
+				defer span.End()
+				handlerSpan = span.SpanContext()
+				if tt.handlerError != nil {
+					return tt.handlerError
+				}
+				return c.SendStatus(fiber.StatusOK)
+			})
+			request := httptest.NewRequest("GET", "/users/1", nil)
+			if tt.traceparent != "" {
+				request.Header.Set("traceparent", tt.traceparent)
+			}
+
+			if _, err := app.Test(request); err != nil {
+				t.Fatal(err)
+			}
+			spans := recorder.Ended()
+			if len(spans) != 2 {
+				t.Fatalf("Expected 2 spans, got %d", len(spans))
+			}
+			server := spans[1]
+			if server.Name() != "GET /users/:id" {
+				t.Errorf("Expected span GET /users/:id, got %s", server.Name())
+			}
+			if server.SpanKind() != trace.SpanKindServer {
+				t.Errorf("Expected a server span, got %v", server.SpanKind())
+			}
+			if tt.traceparent != "" && server.SpanContext().TraceID().String() != traceID {
+				t.Errorf("Expected trace %s, got %s", traceID, server.SpanContext().TraceID())
+			}
+			if handlerSpan.TraceID() != server.SpanContext().TraceID() {
+				t.Errorf("Expected the handler span to be in the request trace")
+			}
+			if server.Status().Code != tt.expectedStatus {
+				t.Errorf("Expected status %v, got %v", tt.expectedStatus, server.Status().Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package system
+
+import (
+	"time"
+)
+
+const (
+	defaultLoanPeriodDays          = 14
+	defaultMaxRenewals             = 2
+	defaultHoldPickupDays          = 3
+	defaultFineDailyCents          = 25
+	defaultFineGraceDays           = 1
+	defaultFineMaxPerItemCents     = 1000
+	defaultFineBlockThresholdCents = 500
+)
+
+type CirculationConfig struct {
+	LoanPeriodDays int `json:"loan_period_days" yaml:"loan_period_days"`
+	MaxRenewals    int `json:"max_renewals" yaml:"max_renewals"`
+	HoldPickupDays int `json:"hold_pickup_days" yaml:"hold_pickup_days"`
+	// Overdue fines are charged per full day late beyond the grace period,
+	// up to a maximum per loan
+	FineDailyCents      int `json:"fine_daily_cents" yaml:"fine_daily_cents"`
+	FineGraceDays       int `json:"fine_grace_days" yaml:"fine_grace_days"`
+	FineMaxPerItemCents int `json:"fine_max_per_item_cents" yaml:"fine_max_per_item_cents"`
+	// Users owing more than this cannot check out items
+	FineBlockThresholdCents int `json:"fine_block_threshold_cents" yaml:"fine_block_threshold_cents"`
+}
+
+// OverdueFineCents returns the fine owed on a loan due at dueAt when it is
+// assessed at until
+func (config *CirculationConfig) OverdueFineCents(dueAt time.Time, until time.Time) int64 {
+	daysLate := int(until.Sub(dueAt).Hours() / 24)
+	if daysLate <= config.FineGraceDays {
+		return 0
+	}
+	fine := int64(daysLate-config.FineGraceDays) * int64(config.FineDailyCents)
+	if fine > int64(config.FineMaxPerItemCents) {
+		return int64(config.FineMaxPerItemCents)
+	}
+	return fine
+}
@@ -0,0 +1,56 @@
+package system
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOverdueFineCents(t *testing.T) {
+	config := &CirculationConfig{
+		FineDailyCents:      25,
+		FineGraceDays:       1,
+		FineMaxPerItemCents: 100,
+	}
+	dueAt := time.Date(2023, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	tc := []struct {
+		name         string
+		until        time.Time
+		expectedFine int64
+	}{
+		{
+			name:         "Returned before due date",
+			until:        dueAt.AddDate(0, 0, -2),
+			expectedFine: 0,
+		},
+		{
+			name:         "Returned less than a day late",
+			until:        dueAt.Add(20 * time.Hour),
+			expectedFine: 0,
+		},
+		{
+			name:         "Returned within grace period",
+			until:        dueAt.AddDate(0, 0, 1),
+			expectedFine: 0,
+		},
+		{
+			name:         "Returned after grace period",
+			until:        dueAt.AddDate(0, 0, 3),
+			expectedFine: 50,
+		},
+		{
+			name:         "Fine capped at maximum",
+			until:        dueAt.AddDate(0, 0, 30),
+			expectedFine: 100,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			fine := config.OverdueFineCents(dueAt, tt.until)
+			if fine != tt.expectedFine {
+				t.Errorf("Expected fine %d, got %d", tt.expectedFine, fine)
+			}
+		})
+	}
+}
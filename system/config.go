@@ -0,0 +1,378 @@
+package system
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/minand-mohan/library-app-api/utils"
+	"github.com/minand-mohan/library-app-api/utils/phone"
+	"gopkg.in/yaml.v3"
+)
+
+// Config holds every setting of the API. It is built from defaults, then an
+// optional YAML or JSON file, then environment variables, then command line
+// flags, each overriding the one before.
+type Config struct {
+	Server      ServerConfig      `json:"server" yaml:"server"`
+	DB          DbConfig          `json:"db" yaml:"db"`
+	Auth        AuthConfig        `json:"auth" yaml:"auth"`
+	Log         LogConfig         `json:"log" yaml:"log"`
+	Tracing     TracingConfig     `json:"tracing" yaml:"tracing"`
+	Circulation CirculationConfig `json:"circulation" yaml:"circulation"`
+	Users       UserConfig        `json:"users" yaml:"users"`
+}
+
+type ServerConfig struct {
+	// Address the server listens on, host:port
+	Address string `json:"address" yaml:"address"`
+	// Maximum number of concurrent connections
+	Concurrency int `json:"concurrency" yaml:"concurrency"`
+	// On shutdown the readiness probe fails for ShutdownDelaySeconds, so
+	// that load balancers stop sending requests, then in-flight requests are
+	// given ShutdownTimeoutSeconds to finish
+	ShutdownDelaySeconds   int `json:"shutdown_delay_seconds" yaml:"shutdown_delay_seconds"`
+	ShutdownTimeoutSeconds int `json:"shutdown_timeout_seconds" yaml:"shutdown_timeout_seconds"`
+}
+
+type AuthConfig struct {
+	// Token accepted besides the keys created with `apikey create`, none
+	// when empty
+	Token string `json:"token" yaml:"token"`
+}
+
+type LogConfig struct {
+	// One of utils.LogLevels
+	Level string `json:"level" yaml:"level"`
+	// One of utils.LogFormats
+	Format string `json:"format" yaml:"format"`
+}
+
+type TracingConfig struct {
+	// One of TracingExporters; none turns tracing off
+	Exporter string `json:"exporter" yaml:"exporter"`
+	// host:port of the OTLP/HTTP collector
+	OTLPEndpoint string `json:"otlp_endpoint" yaml:"otlp_endpoint"`
+	// Whether to reach the collector over plain HTTP rather than HTTPS
+	OTLPInsecure bool `json:"otlp_insecure" yaml:"otlp_insecure"`
+}
+
+// Where spans are sent: nowhere, to stdout or to an OTLP collector
+var TracingExporters = []string{"none", "stdout", "otlp"}
+
+const (
+	defaultServerAddress     = ":8080"
+	defaultServerConcurrency = 1024
+	defaultShutdownTimeout   = 30
+	defaultDbPort            = 5432
+	defaultDbMaxOpenConns    = 100
+	defaultDbMaxIdleConns    = 10
+	defaultLogLevel          = "info"
+	defaultLogFormat         = "json"
+	defaultTracingExporter   = "none"
+	defaultOTLPEndpoint      = "localhost:4318"
+)
+
+// DefaultConfig returns the settings used when nothing overrides them. The
+// database host, user and name have no default.
+func DefaultConfig() *Config {
+	return &Config{
+		Server: ServerConfig{
+			Address:                defaultServerAddress,
+			Concurrency:            defaultServerConcurrency,
+			ShutdownTimeoutSeconds: defaultShutdownTimeout,
+		},
+		DB: DbConfig{
+			Port:           defaultDbPort,
+			MaxOpenConns:   defaultDbMaxOpenConns,
+			MaxIdleConns:   defaultDbMaxIdleConns,
+			MigrateOnStart: true,
+		},
+		Log: LogConfig{
+			Level:  defaultLogLevel,
+			Format: defaultLogFormat,
+		},
+		Tracing: TracingConfig{
+			Exporter:     defaultTracingExporter,
+			OTLPEndpoint: defaultOTLPEndpoint,
+		},
+		Circulation: CirculationConfig{
+			LoanPeriodDays:          defaultLoanPeriodDays,
+			MaxRenewals:             defaultMaxRenewals,
+			HoldPickupDays:          defaultHoldPickupDays,
+			FineDailyCents:          defaultFineDailyCents,
+			FineGraceDays:           defaultFineGraceDays,
+			FineMaxPerItemCents:     defaultFineMaxPerItemCents,
+			FineBlockThresholdCents: defaultFineBlockThresholdCents,
+		},
+		Users: UserConfig{
+			PhoneDefaultRegion: defaultPhoneRegion,
+		},
+	}
+}
+
+// ConfigError lists every problem found while loading the configuration
+type ConfigError struct {
+	Problems []string
+}
+
+func (configError *ConfigError) Error() string {
+	return "invalid configuration:\n  " + strings.Join(configError.Problems, "\n  ")
+}
+
+func (configError *ConfigError) add(format string, args ...interface{}) {
+	configError.Problems = append(configError.Problems, fmt.Sprintf(format, args...))
+}
+
+func (configError *ConfigError) err() error {
+	if len(configError.Problems) == 0 {
+		return nil
+	}
+	return configError
+}
+
+// setting is a value that can be set by an environment variable and a flag.
+// Key is its path in a config file.
+type setting struct {
+	key   string
+	env   string
+	flag  string
+	usage string
+	set   func(config *Config, value string) error
+}
+
+func stringSetting(field func(config *Config) *string) func(config *Config, value string) error {
+	return func(config *Config, value string) error {
+		*field(config) = value
+		return nil
+	}
+}
+
+func intSetting(field func(config *Config) *int) func(config *Config, value string) error {
+	return func(config *Config, value string) error {
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("must be an integer, got %q", value)
+		}
+		*field(config) = parsed
+		return nil
+	}
+}
+
+func boolSetting(field func(config *Config) *bool) func(config *Config, value string) error {
+	return func(config *Config, value string) error {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("must be true or false, got %q", value)
+		}
+		*field(config) = parsed
+		return nil
+	}
+}
+
+var settings = []setting{
+	{"server.address", "SERVER_ADDRESS", "addr", "address to listen on", stringSetting(func(c *Config) *string { return &c.Server.Address })},
+	{"server.concurrency", "SERVER_CONCURRENCY", "concurrency", "maximum number of concurrent connections", intSetting(func(c *Config) *int { return &c.Server.Concurrency })},
+	{"server.shutdown_delay_seconds", "SERVER_SHUTDOWN_DELAY_SECONDS", "shutdown-delay-seconds", "seconds readiness fails before shutting down", intSetting(func(c *Config) *int { return &c.Server.ShutdownDelaySeconds })},
+	{"server.shutdown_timeout_seconds", "SERVER_SHUTDOWN_TIMEOUT_SECONDS", "shutdown-timeout-seconds", "seconds in-flight requests are given to finish on shutdown", intSetting(func(c *Config) *int { return &c.Server.ShutdownTimeoutSeconds })},
+	{"db.host", "DB_HOST", "db-host", "database host", stringSetting(func(c *Config) *string { return &c.DB.Host })},
+	{"db.port", "DB_PORT", "db-port", "database port", intSetting(func(c *Config) *int { return &c.DB.Port })},
+	{"db.user", "DB_USER", "db-user", "database user", stringSetting(func(c *Config) *string { return &c.DB.Username })},
+	{"db.password", "DB_PASSWORD", "db-password", "database password", stringSetting(func(c *Config) *string { return &c.DB.Password })},
+	{"db.name", "DB_NAME", "db-name", "database name", stringSetting(func(c *Config) *string { return &c.DB.Name })},
+	{"db.max_open_conns", "DB_MAX_OPEN_CONNS", "db-max-open-conns", "maximum number of open database connections", intSetting(func(c *Config) *int { return &c.DB.MaxOpenConns })},
+	{"db.max_idle_conns", "DB_MAX_IDLE_CONNS", "db-max-idle-conns", "maximum number of idle database connections", intSetting(func(c *Config) *int { return &c.DB.MaxIdleConns })},
+	{"db.migrate_on_start", "DB_MIGRATE_ON_START", "db-migrate-on-start", "apply pending migrations when connecting", boolSetting(func(c *Config) *bool { return &c.DB.MigrateOnStart })},
+	{"auth.token", "API_AUTH_TOKEN", "auth-token", "API token accepted besides stored keys", stringSetting(func(c *Config) *string { return &c.Auth.Token })},
+	{"log.level", "LOG_LEVEL", "log-level", "log level: debug, info, warn or error", stringSetting(func(c *Config) *string { return &c.Log.Level })},
+	{"log.format", "LOG_FORMAT", "log-format", "log format: json or logfmt", stringSetting(func(c *Config) *string { return &c.Log.Format })},
+	{"tracing.exporter", "TRACING_EXPORTER", "tracing-exporter", "where spans are sent: none, stdout or otlp", stringSetting(func(c *Config) *string { return &c.Tracing.Exporter })},
+	{"tracing.otlp_endpoint", "TRACING_OTLP_ENDPOINT", "tracing-otlp-endpoint", "host:port of the OTLP/HTTP collector", stringSetting(func(c *Config) *string { return &c.Tracing.OTLPEndpoint })},
+	{"tracing.otlp_insecure", "TRACING_OTLP_INSECURE", "tracing-otlp-insecure", "reach the OTLP collector over plain HTTP", boolSetting(func(c *Config) *bool { return &c.Tracing.OTLPInsecure })},
+	{"circulation.loan_period_days", "LOAN_PERIOD_DAYS", "loan-period-days", "days an item is lent for", intSetting(func(c *Config) *int { return &c.Circulation.LoanPeriodDays })},
+	{"circulation.max_renewals", "MAX_RENEWALS", "max-renewals", "times a loan can be renewed", intSetting(func(c *Config) *int { return &c.Circulation.MaxRenewals })},
+	{"circulation.hold_pickup_days", "HOLD_PICKUP_DAYS", "hold-pickup-days", "days a ready hold waits for pickup", intSetting(func(c *Config) *int { return &c.Circulation.HoldPickupDays })},
+	{"circulation.fine_daily_cents", "FINE_DAILY_CENTS", "fine-daily-cents", "fine per day late in cents", intSetting(func(c *Config) *int { return &c.Circulation.FineDailyCents })},
+	{"circulation.fine_grace_days", "FINE_GRACE_DAYS", "fine-grace-days", "days late before fines accrue", intSetting(func(c *Config) *int { return &c.Circulation.FineGraceDays })},
+	{"circulation.fine_max_per_item_cents", "FINE_MAX_PER_ITEM_CENTS", "fine-max-per-item-cents", "maximum fine per loan in cents", intSetting(func(c *Config) *int { return &c.Circulation.FineMaxPerItemCents })},
+	{"circulation.fine_block_threshold_cents", "FINE_BLOCK_THRESHOLD_CENTS", "fine-block-threshold-cents", "fines owed that block checkouts in cents", intSetting(func(c *Config) *int { return &c.Circulation.FineBlockThresholdCents })},
+	{"users.phone_default_region", "PHONE_DEFAULT_REGION", "phone-default-region", "region of phone numbers without a country code", stringSetting(func(c *Config) *string { return &c.Users.PhoneDefaultRegion })},
+}
+
+// ConfigFlags are the command line flags that override the configuration
+type ConfigFlags struct {
+	file   string
+	values []flagValue
+}
+
+type flagValue struct {
+	setting *setting
+	value   string
+}
+
+// NewConfigFlags registers -config, naming the config file, and a flag for
+// every setting on flags. Load reads the configuration once flags are
+// parsed.
+func NewConfigFlags(flags *flag.FlagSet) *ConfigFlags {
+	configFlags := &ConfigFlags{}
+	flags.StringVar(&configFlags.file, "config", "", "YAML or JSON config file, CONFIG_FILE by default")
+	for i := range settings {
+		setting := &settings[i]
+		usage := fmt.Sprintf("%s, %s by default", setting.usage, setting.env)
+		flags.Func(setting.flag, usage, func(value string) error {
+			configFlags.values = append(configFlags.values, flagValue{setting: setting, value: value})
+			return nil
+		})
+	}
+	return configFlags
+}
+
+// LoadConfig reads the configuration from the environment and the file
+// named by CONFIG_FILE
+func LoadConfig() (*Config, error) {
+	return (&ConfigFlags{}).Load()
+}
+
+// Load reads the configuration and validates it. Every problem found is
+// returned at once in a *ConfigError.
+func (configFlags *ConfigFlags) Load() (*Config, error) {
+	config := DefaultConfig()
+	errs := &ConfigError{}
+
+	file := configFlags.file
+	if file == "" {
+		file = os.Getenv("CONFIG_FILE")
+	}
+	if file != "" {
+		if err := readConfigFile(file, config); err != nil {
+			errs.add("%s: %v", file, err)
+		}
+	}
+	for i := range settings {
+		value, ok := os.LookupEnv(settings[i].env)
+		if !ok {
+			continue
+		}
+		if err := settings[i].set(config, value); err != nil {
+			errs.add("%s %v", settings[i].env, err)
+		}
+	}
+	for _, flagValue := range configFlags.values {
+		if err := flagValue.setting.set(config, flagValue.value); err != nil {
+			errs.add("-%s %v", flagValue.setting.flag, err)
+		}
+	}
+
+	config.validate(errs)
+	if err := errs.err(); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// readConfigFile decodes a .json file as JSON and anything else as YAML.
+// Keys that are not settings are rejected so that typos are not ignored.
+func readConfigFile(file string, config *Config) error {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	if strings.EqualFold(filepath.Ext(file), ".json") {
+		decoder := json.NewDecoder(bytes.NewReader(content))
+		decoder.DisallowUnknownFields()
+		return decoder.Decode(config)
+	}
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	decoder.KnownFields(true)
+	err = decoder.Decode(config)
+	if errors.Is(err, io.EOF) {
+		// an empty file sets nothing
+		return nil
+	}
+	return err
+}
+
+func settingName(key string) string {
+	for _, setting := range settings {
+		if setting.key == key {
+			return fmt.Sprintf("%s (%s)", setting.key, setting.env)
+		}
+	}
+	return key
+}
+
+func (config *Config) validate(errs *ConfigError) {
+	required := func(key string, value string) {
+		if strings.TrimSpace(value) == "" {
+			errs.add("%s is required", settingName(key))
+		}
+	}
+	atLeast := func(key string, value int, minValue int) {
+		if value < minValue {
+			errs.add("%s must be at least %d, got %d", settingName(key), minValue, value)
+		}
+	}
+
+	required("server.address", config.Server.Address)
+	atLeast("server.concurrency", config.Server.Concurrency, 1)
+	atLeast("server.shutdown_delay_seconds", config.Server.ShutdownDelaySeconds, 0)
+	atLeast("server.shutdown_timeout_seconds", config.Server.ShutdownTimeoutSeconds, 1)
+
+	required("db.host", config.DB.Host)
+	required("db.user", config.DB.Username)
+	required("db.name", config.DB.Name)
+	if config.DB.Port < 1 || config.DB.Port > 65535 {
+		errs.add("%s must be between 1 and 65535, got %d", settingName("db.port"), config.DB.Port)
+	}
+	atLeast("db.max_open_conns", config.DB.MaxOpenConns, 1)
+	atLeast("db.max_idle_conns", config.DB.MaxIdleConns, 0)
+	if config.DB.MaxIdleConns > config.DB.MaxOpenConns {
+		errs.add("%s must not be more than %s", settingName("db.max_idle_conns"), settingName("db.max_open_conns"))
+	}
+
+	config.Log.Level = strings.ToLower(config.Log.Level)
+	if !contains(utils.LogLevels, config.Log.Level) {
+		errs.add("%s must be one of %s, got %q", settingName("log.level"), strings.Join(utils.LogLevels, ", "), config.Log.Level)
+	}
+	config.Log.Format = strings.ToLower(config.Log.Format)
+	if !contains(utils.LogFormats, config.Log.Format) {
+		errs.add("%s must be one of %s, got %q", settingName("log.format"), strings.Join(utils.LogFormats, ", "), config.Log.Format)
+	}
+
+	config.Tracing.Exporter = strings.ToLower(config.Tracing.Exporter)
+	if !contains(TracingExporters, config.Tracing.Exporter) {
+		errs.add("%s must be one of %s, got %q", settingName("tracing.exporter"), strings.Join(TracingExporters, ", "), config.Tracing.Exporter)
+	}
+	if config.Tracing.Exporter == "otlp" {
+		required("tracing.otlp_endpoint", config.Tracing.OTLPEndpoint)
+	}
+
+	atLeast("circulation.loan_period_days", config.Circulation.LoanPeriodDays, 1)
+	atLeast("circulation.max_renewals", config.Circulation.MaxRenewals, 0)
+	atLeast("circulation.hold_pickup_days", config.Circulation.HoldPickupDays, 1)
+	atLeast("circulation.fine_daily_cents", config.Circulation.FineDailyCents, 0)
+	atLeast("circulation.fine_grace_days", config.Circulation.FineGraceDays, 0)
+	atLeast("circulation.fine_max_per_item_cents", config.Circulation.FineMaxPerItemCents, 0)
+	atLeast("circulation.fine_block_threshold_cents", config.Circulation.FineBlockThresholdCents, 0)
+
+	config.Users.PhoneDefaultRegion = strings.ToUpper(config.Users.PhoneDefaultRegion)
+	if !phone.IsSupportedRegion(config.Users.PhoneDefaultRegion) {
+		errs.add("%s %q is not a supported region", settingName("users.phone_default_region"), config.Users.PhoneDefaultRegion)
+	}
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
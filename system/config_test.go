@@ -0,0 +1,215 @@
+package system
+
+import (
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// clearConfigEnv unsets every config environment variable for the test
+func clearConfigEnv(t *testing.T) {
+	t.Setenv("CONFIG_FILE", "")
+	os.Unsetenv("CONFIG_FILE")
+	for _, setting := range settings {
+		t.Setenv(setting.env, "")
+		os.Unsetenv(setting.env)
+	}
+}
+
+func setRequiredEnv(t *testing.T) {
+	t.Setenv("DB_HOST", "localhost")
+	t.Setenv("DB_USER", "library")
+	t.Setenv("DB_NAME", "librarydb")
+}
+
+func writeConfigFile(t *testing.T, name string, content string) string {
+	file := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(file, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
+
+func loadWithFlags(t *testing.T, args ...string) (*Config, error) {
+	flags := flag.NewFlagSet("test", flag.ContinueOnError)
+	configFlags := NewConfigFlags(flags)
+	if err := flags.Parse(args); err != nil {
+		t.Fatal(err)
+	}
+	return configFlags.Load()
+}
+
+func TestLoadConfigDefaults(t *testing.T) {
+	clearConfigEnv(t)
+	setRequiredEnv(t)
+
+	config, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	expected := DefaultConfig()
+	expected.DB.Host = "localhost"
+	expected.DB.Username = "library"
+	expected.DB.Name = "librarydb"
+	if !reflect.DeepEqual(config, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, config)
+	}
+}
+
+func TestLoadConfigPrecedence(t *testing.T) {
+	clearConfigEnv(t)
+	setRequiredEnv(t)
+	file := writeConfigFile(t, "config.yaml", `
+server:
+  address: ":9000"
+  concurrency: 64
+db:
+  port: 5455
+  max_open_conns: 20
+log:
+  level: error
+`)
+	t.Setenv("CONFIG_FILE", file)
+	t.Setenv("DB_PORT", "6000")
+
+	config, err := loadWithFlags(t, "-addr", ":9100", "-db-max-idle-conns", "5")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if config.Server.Address != ":9100" {
+		t.Errorf("Expected the flag to override the file address, got %s", config.Server.Address)
+	}
+	if config.Server.Concurrency != 64 {
+		t.Errorf("Expected the file concurrency, got %d", config.Server.Concurrency)
+	}
+	if config.DB.Port != 6000 {
+		t.Errorf("Expected the environment to override the file port, got %d", config.DB.Port)
+	}
+	if config.DB.MaxOpenConns != 20 || config.DB.MaxIdleConns != 5 {
+		t.Errorf("Expected pool sizes 20 and 5, got %d and %d", config.DB.MaxOpenConns, config.DB.MaxIdleConns)
+	}
+	if config.Log.Level != "error" {
+		t.Errorf("Expected log level error, got %s", config.Log.Level)
+	}
+	if config.Circulation.LoanPeriodDays != defaultLoanPeriodDays {
+		t.Errorf("Expected the default loan period, got %d", config.Circulation.LoanPeriodDays)
+	}
+}
+
+func TestLoadConfigJSONFile(t *testing.T) {
+	clearConfigEnv(t)
+	file := writeConfigFile(t, "config.json", `{
+		"db": {"host": "db", "user": "library", "name": "librarydb"},
+		"users": {"phone_default_region": "gb"}
+	}`)
+
+	config, err := loadWithFlags(t, "-config", file)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if config.DB.Host != "db" {
+		t.Errorf("Expected host db, got %s", config.DB.Host)
+	}
+	if config.Users.PhoneDefaultRegion != "GB" {
+		t.Errorf("Expected region GB, got %s", config.Users.PhoneDefaultRegion)
+	}
+}
+
+func TestLoadConfigProblems(t *testing.T) {
+
+	tc := []struct {
+		name             string
+		env              map[string]string
+		file             string
+		args             []string
+		expectedProblems []string
+	}{
+		{
+			name: "Every problem at once",
+			env: map[string]string{
+				"DB_PORT":              "abc",
+				"LOG_LEVEL":            "verbose",
+				"PHONE_DEFAULT_REGION": "XX",
+				"DB_MAX_IDLE_CONNS":    "200",
+				"TRACING_EXPORTER":     "jaeger",
+			},
+			args: []string{"-loan-period-days", "0"},
+			expectedProblems: []string{
+				"DB_PORT must be an integer, got \"abc\"",
+				"db.host (DB_HOST) is required",
+				"db.user (DB_USER) is required",
+				"db.name (DB_NAME) is required",
+				"db.max_idle_conns (DB_MAX_IDLE_CONNS) must not be more than db.max_open_conns (DB_MAX_OPEN_CONNS)",
+				"log.level (LOG_LEVEL) must be one of debug, info, warn, error, got \"verbose\"",
+				"tracing.exporter (TRACING_EXPORTER) must be one of none, stdout, otlp, got \"jaeger\"",
+				"circulation.loan_period_days (LOAN_PERIOD_DAYS) must be at least 1, got 0",
+				"users.phone_default_region (PHONE_DEFAULT_REGION) \"XX\" is not a supported region",
+			},
+		},
+		{
+			name: "OTLP without an endpoint",
+			env: map[string]string{
+				"DB_HOST":               "localhost",
+				"DB_USER":               "library",
+				"DB_NAME":               "librarydb",
+				"TRACING_OTLP_ENDPOINT": "",
+			},
+			args:             []string{"-tracing-exporter", "OTLP"},
+			expectedProblems: []string{"tracing.otlp_endpoint (TRACING_OTLP_ENDPOINT) is required"},
+		},
+		{
+			name: "Invalid flag value",
+			env: map[string]string{
+				"DB_HOST": "localhost",
+				"DB_USER": "library",
+				"DB_NAME": "librarydb",
+			},
+			args:             []string{"-db-migrate-on-start", "maybe", "-db-port", "70000"},
+			expectedProblems: []string{"-db-migrate-on-start must be true or false, got \"maybe\"", "db.port (DB_PORT) must be between 1 and 65535, got 70000"},
+		},
+		{
+			name: "Unknown key in file",
+			env: map[string]string{
+				"DB_HOST": "localhost",
+				"DB_USER": "library",
+				"DB_NAME": "librarydb",
+			},
+			file:             "server:\n  adress: \":9000\"\n",
+			expectedProblems: []string{"yaml: unmarshal errors:\n  line 2: field adress not found in type system.ServerConfig"},
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			clearConfigEnv(t)
+			for name, value := range tt.env {
+				t.Setenv(name, value)
+			}
+			args := tt.args
+			var file string
+			if tt.file != "" {
+				file = writeConfigFile(t, "config.yaml", tt.file)
+				args = append([]string{"-config", file}, args...)
+			}
+
+			config, err := loadWithFlags(t, args...)
+			if config != nil {
+				t.Errorf("Expected no config, got %+v", config)
+			}
+			var configError *ConfigError
+			if !errors.As(err, &configError) {
+				t.Fatalf("Expected a *ConfigError, got %v", err)
+			}
+			expected := tt.expectedProblems
+			if file != "" {
+				expected = []string{file + ": " + tt.expectedProblems[0]}
+			}
+			if !reflect.DeepEqual(configError.Problems, expected) {
+				t.Errorf("Expected problems %q, got %q", expected, configError.Problems)
+			}
+		})
+	}
+}
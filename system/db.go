@@ -2,10 +2,9 @@ package system
 
 import (
 	"fmt"
-	"os"
-	"strconv"
 
 	"github.com/minand-mohan/library-app-api/database"
+	"github.com/minand-mohan/library-app-api/utils"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
@@ -15,48 +14,26 @@ type DataSource struct {
 }
 
 type DbConfig struct {
-	Host     string `json:"db_host"`
-	Port     int    `json:"db_port"`
-	Username string `json:"db_user"`
-	Password string `json:"db_password"`
-	Name     string `json:"db_name"`
+	Host     string `json:"host" yaml:"host"`
+	Port     int    `json:"port" yaml:"port"`
+	Username string `json:"user" yaml:"user"`
+	Password string `json:"password" yaml:"password"`
+	Name     string `json:"name" yaml:"name"`
+	// Connection pool sizes
+	MaxOpenConns int `json:"max_open_conns" yaml:"max_open_conns"`
+	MaxIdleConns int `json:"max_idle_conns" yaml:"max_idle_conns"`
+	// Whether NewDataSource applies pending migrations, false when they are
+	// run separately with `migrate up`
+	MigrateOnStart bool `json:"migrate_on_start" yaml:"migrate_on_start"`
 }
 
-func NewDbConfig() *DbConfig {
-	var dbConfig DbConfig
-	dbHost, ok := os.LookupEnv("DB_HOST")
-	if !ok {
-		panic("DB_HOST environment variable required but not set")
-	}
-	dbPort, ok := os.LookupEnv("DB_PORT")
-	if !ok {
-		panic("DB_PORT environment variable required but not set")
-	}
-	dbUser, ok := os.LookupEnv("DB_USER")
-	if !ok {
-		panic("DB_USER environment variable required but not set")
-	}
-	dbPassword, ok := os.LookupEnv("DB_PASSWORD")
-	if !ok {
-		panic("DB_PASSWORD environment variable required but not set")
-	}
-	dbName, ok := os.LookupEnv("DB_NAME")
-	if !ok {
-		panic("DB_NAME environment variable required but not set")
-	}
-	dbConfig.Host = dbHost
-	dbConfig.Port, _ = strconv.Atoi(dbPort)
-	dbConfig.Username = dbUser
-	dbConfig.Password = dbPassword
-	dbConfig.Name = dbName
-	return &dbConfig
-}
-
-func NewDataSource() *DataSource {
+// ConnectDataSource connects to the database without migrating it
+func ConnectDataSource(dbConfig *DbConfig) *DataSource {
 	var err error
-	dbConfig := NewDbConfig()
 	dsn := fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=disable", dbConfig.Host, dbConfig.Port, dbConfig.Username, dbConfig.Password, dbConfig.Name)
-	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
+		Logger: database.NewGormLogger(utils.NewLogger()),
+	})
 	if err != nil {
 		panic("failed to connect database")
 	}
@@ -64,8 +41,31 @@ func NewDataSource() *DataSource {
 	if err != nil {
 		panic("failed to connect database")
 	}
-	sqlDB.SetMaxIdleConns(10)
-	sqlDB.SetMaxOpenConns(100)
-	database.Migrate(db)
+	sqlDB.SetMaxIdleConns(dbConfig.MaxIdleConns)
+	sqlDB.SetMaxOpenConns(dbConfig.MaxOpenConns)
 	return &DataSource{DB: db}
 }
+
+// Close closes the connection pool, waiting for queries in progress
+func (dataSource *DataSource) Close() error {
+	sqlDB, err := dataSource.DB.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.Close()
+}
+
+// NewDataSource connects to the database and applies pending migrations
+// when dbConfig.MigrateOnStart is set. userConfig gives the region of phone
+// numbers normalized by the migrations.
+func NewDataSource(dbConfig *DbConfig, userConfig *UserConfig) *DataSource {
+	dataSource := ConnectDataSource(dbConfig)
+	if !dbConfig.MigrateOnStart {
+		return dataSource
+	}
+	err := database.Migrate(dataSource.DB, userConfig.PhoneDefaultRegion)
+	if err != nil {
+		panic(fmt.Sprintf("failed to migrate database: %v", err))
+	}
+	return dataSource
+}
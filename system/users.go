@@ -0,0 +1,9 @@
+package system
+
+const defaultPhoneRegion = "US"
+
+type UserConfig struct {
+	// Region of phone numbers given without a country code, as an ISO 3166-1
+	// alpha-2 code
+	PhoneDefaultRegion string `json:"phone_default_region" yaml:"phone_default_region"`
+}
@@ -0,0 +1,109 @@
+package tracing
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+type book struct {
+	ID    int64
+	Title string
+}
+
+func newRecorder() *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	return recorder
+}
+
+func attributeValue(span sdktrace.ReadOnlySpan, key string) string {
+	for _, attr := range span.Attributes() {
+		if string(attr.Key) == key {
+			return attr.Value.Emit()
+		}
+	}
+	return ""
+}
+
+func TestGormPlugin(t *testing.T) {
+	query := regexp.QuoteMeta(`SELECT * FROM "books" WHERE "books"."id" = $1 ORDER BY "books"."id" LIMIT 1`)
+
+	tc := []struct {
+		name           string
+		mockFunction   func(mock sqlmock.Sqlmock)
+		expectedStatus codes.Code
+	}{
+		{
+			name: "Query traced",
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"id", "title"}).AddRow(1, "Dune"))
+			},
+			expectedStatus: codes.Unset,
+		},
+		{
+			name: "Record not found is not an error",
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"id", "title"}))
+			},
+			expectedStatus: codes.Unset,
+		},
+		{
+			name: "Query failed",
+			mockFunction: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WithArgs(1).WillReturnError(sqlmock.ErrCancelled)
+			},
+			expectedStatus: codes.Error,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := newRecorder()
+			sqlDB, mock, _ := sqlmock.New()
+			db, _ := gorm.Open(postgres.New(postgres.Config{
+				DSN:                  "sqlmock_db_0",
+				DriverName:           "postgres",
+				Conn:                 sqlDB,
+				PreferSimpleProtocol: true,
+			}), &gorm.Config{})
+			if err := db.Use(NewGormPlugin()); err != nil {
+				t.Fatal(err)
+			}
+			tt.mockFunction(mock)
+
+			ctx, parent := Start(context.Background(), "BookRepository.FindByBookId")
+			db.WithContext(ctx).First(&book{}, 1)
+			parent.End()
+
+			spans := recorder.Ended()
+			if len(spans) != 2 {
+				t.Fatalf("Expected 2 spans, got %d", len(spans))
+			}
+			span := spans[0]
+			if span.Name() != "gorm.query" {
+				t.Errorf("Expected span gorm.query, got %s", span.Name())
+			}
+			if span.Parent().SpanID() != parent.SpanContext().SpanID() {
+				t.Errorf("Expected the query span to be a child of the repository span")
+			}
+			if statement := attributeValue(span, "db.statement"); !regexp.MustCompile(query).MatchString(statement) {
+				t.Errorf("Expected the statement to be recorded, got %s", statement)
+			}
+			if table := attributeValue(span, "db.sql.table"); table != "books" {
+				t.Errorf("Expected table books, got %s", table)
+			}
+			if span.Status().Code != tt.expectedStatus {
+				t.Errorf("Expected status %v, got %v", tt.expectedStatus, span.Status().Code)
+			}
+		})
+	}
+}
@@ -1,38 +1,257 @@
 package utils
 
 import (
-	"log"
+	"encoding/json"
+	"fmt"
+	"io"
 	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Log levels, from most to least verbose
+const (
+	LevelDebug = iota
+	LevelInfo
+	LevelWarn
+	LevelError
 )
 
+// Names of the log levels, indexed by level
+var LogLevels = []string{"debug", "info", "warn", "error"}
+
+// Log formats: one JSON object per line, or key=value pairs
+var LogFormats = []string{"json", "logfmt"}
+
+// Field is a key and value attached to a log line
+type Field struct {
+	Key   string
+	Value interface{}
+}
+
+// F makes a Field
+func F(key string, value interface{}) Field {
+	return Field{Key: key, Value: value}
+}
+
+// logOutput is shared by every logger derived from the same base, so that
+// configuring the level and format applies to loggers already handed out
+type logOutput struct {
+	mu     sync.Mutex
+	writer io.Writer
+	level  int
+	format string
+}
+
+// AppLogger writes leveled, structured log lines. Loggers made with With
+// add their fields to every line.
 type AppLogger struct {
-	logger *log.Logger
+	output *logOutput
+	fields []Field
 }
 
 var appLogger *AppLogger = nil
 
+// NewLogger returns the base logger, writing JSON lines of level info and
+// above to stdout until ConfigureLogger is called
 func NewLogger() *AppLogger {
 	if appLogger != nil {
 		return appLogger
 	}
-	logger := AppLogger{}
-	logger.logger = log.New(os.Stdout, "library-app-api", log.LstdFlags|log.Lshortfile)
-	appLogger = &logger
+	appLogger = &AppLogger{
+		output: &logOutput{
+			writer: os.Stdout,
+			level:  LevelInfo,
+			format: "json",
+		},
+	}
 	return appLogger
 }
 
-func (logger *AppLogger) Info(message string) {
-	logger.logger.Println(message)
+// ConfigureLogger sets the level and format of every logger
+func ConfigureLogger(level string, format string) error {
+	levelIndex := indexOf(LogLevels, level)
+	if levelIndex < 0 {
+		return fmt.Errorf("unknown log level %s", level)
+	}
+	if indexOf(LogFormats, format) < 0 {
+		return fmt.Errorf("unknown log format %s", format)
+	}
+	output := NewLogger().output
+	output.mu.Lock()
+	defer output.mu.Unlock()
+	output.level = levelIndex
+	output.format = format
+	return nil
+}
+
+func indexOf(values []string, value string) int {
+	for i, v := range values {
+		if v == value {
+			return i
+		}
+	}
+	return -1
+}
+
+// With returns a logger that adds fields to every line
+func (logger *AppLogger) With(fields ...Field) *AppLogger {
+	combined := make([]Field, 0, len(logger.fields)+len(fields))
+	combined = append(combined, logger.fields...)
+	combined = append(combined, fields...)
+	return &AppLogger{
+		output: logger.output,
+		fields: combined,
+	}
+}
+
+// Enabled reports whether lines of level are written, for callers that
+// should skip building costly fields otherwise
+func (logger *AppLogger) Enabled(level int) bool {
+	output := logger.output
+	output.mu.Lock()
+	defer output.mu.Unlock()
+	return level >= output.level
+}
+
+func (logger *AppLogger) Debug(message string, fields ...Field) {
+	logger.write(LevelDebug, message, fields)
+}
+
+func (logger *AppLogger) Info(message string, fields ...Field) {
+	logger.write(LevelInfo, message, fields)
 }
 
-func (logger *AppLogger) Error(message string) {
-	logger.logger.Println(message)
+func (logger *AppLogger) Warn(message string, fields ...Field) {
+	logger.write(LevelWarn, message, fields)
 }
 
-func (logger *AppLogger) Fatal(message string) {
-	logger.logger.Fatalln(message)
+func (logger *AppLogger) Error(message string, fields ...Field) {
+	logger.write(LevelError, message, fields)
 }
 
-func (logger *AppLogger) Panic(message string) {
-	logger.logger.Panicln(message)
+func (logger *AppLogger) Fatal(message string, fields ...Field) {
+	logger.write(LevelError, message, fields)
+	os.Exit(1)
+}
+
+func (logger *AppLogger) Panic(message string, fields ...Field) {
+	logger.write(LevelError, message, fields)
+	panic(message)
+}
+
+func (logger *AppLogger) write(level int, message string, fields []Field) {
+	output := logger.output
+	output.mu.Lock()
+	defer output.mu.Unlock()
+	if level < output.level {
+		return
+	}
+	line := make([]Field, 0, 4+len(logger.fields)+len(fields))
+	line = append(line,
+		F("time", time.Now().UTC().Format(time.RFC3339Nano)),
+		F("level", LogLevels[level]),
+		F("msg", message),
+		F("caller", caller()),
+	)
+	line = append(line, logger.fields...)
+	line = append(line, fields...)
+	if output.format == "logfmt" {
+		output.writer.Write(formatLogfmt(line))
+	} else {
+		output.writer.Write(formatJSON(line))
+	}
+}
+
+// caller returns the file and line that called the logger
+func caller() string {
+	// skip caller, write and the level method
+	_, file, line, ok := runtime.Caller(3)
+	if !ok {
+		return ""
+	}
+	return filepath.Base(file) + ":" + strconv.Itoa(line)
+}
+
+func fieldValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case error:
+		return v.Error()
+	case time.Duration:
+		return v.String()
+	case fmt.Stringer:
+		return v.String()
+	}
+	return value
+}
+
+// formatJSON writes the fields as one JSON object, keeping their order
+func formatJSON(fields []Field) []byte {
+	var line strings.Builder
+	line.WriteByte('{')
+	for i, field := range fields {
+		if i > 0 {
+			line.WriteByte(',')
+		}
+		key, _ := json.Marshal(field.Key)
+		value, err := json.Marshal(fieldValue(field.Value))
+		if err != nil {
+			value, _ = json.Marshal(fmt.Sprint(field.Value))
+		}
+		line.Write(key)
+		line.WriteByte(':')
+		line.Write(value)
+	}
+	line.WriteString("}\n")
+	return []byte(line.String())
+}
+
+// formatLogfmt writes the fields as key=value pairs, quoting values that
+// contain spaces, quotes or equals signs
+func formatLogfmt(fields []Field) []byte {
+	var line strings.Builder
+	for i, field := range fields {
+		if i > 0 {
+			line.WriteByte(' ')
+		}
+		value := fmt.Sprint(fieldValue(field.Value))
+		if value == "" || strings.ContainsAny(value, " =\"\t\n") {
+			value = strconv.Quote(value)
+		}
+		line.WriteString(field.Key)
+		line.WriteByte('=')
+		line.WriteString(value)
+	}
+	line.WriteByte('\n')
+	return []byte(line.String())
+}
+
+// Key of the request logger in the locals of a Fiber context
+const contextLoggerKey = "logger"
+
+// SetContextLogger attaches the logger of a request to its context
+func SetContextLogger(ctx *fiber.Ctx, logger *AppLogger) {
+	ctx.Locals(contextLoggerKey, logger)
+}
+
+// ContextLogger returns the logger of a request, with the route it matched
+// and the id of the user it is about, if any. Without a request logger it
+// falls back to the base logger.
+func ContextLogger(ctx *fiber.Ctx) *AppLogger {
+	logger, ok := ctx.Locals(contextLoggerKey).(*AppLogger)
+	if !ok {
+		logger = NewLogger()
+	}
+	route := ctx.Route().Path
+	fields := []Field{F("route", route)}
+	if strings.Contains(route, "/users/:id") {
+		fields = append(fields, F("user_id", ctx.Params("id")))
+	}
+	return logger.With(fields...)
 }
@@ -0,0 +1,86 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func newTestLogger(level int, format string) (*AppLogger, *bytes.Buffer) {
+	buffer := &bytes.Buffer{}
+	return &AppLogger{
+		output: &logOutput{
+			writer: buffer,
+			level:  level,
+			format: format,
+		},
+	}, buffer
+}
+
+func TestLoggerJSON(t *testing.T) {
+	logger, buffer := newTestLogger(LevelInfo, "json")
+	logger.With(F("request_id", "abc")).Error("Lookup failed", F("error", errors.New("boom")), F("rows", 2))
+
+	var line map[string]interface{}
+	if err := json.Unmarshal(buffer.Bytes(), &line); err != nil {
+		t.Fatalf("Expected a JSON line, got %s", buffer.String())
+	}
+	expected := map[string]interface{}{
+		"level":      "error",
+		"msg":        "Lookup failed",
+		"request_id": "abc",
+		"error":      "boom",
+		"rows":       float64(2),
+	}
+	for key, value := range expected {
+		if line[key] != value {
+			t.Errorf("Expected %s to be %v, got %v", key, value, line[key])
+		}
+	}
+	if !strings.HasPrefix(line["caller"].(string), "logger_test.go:") {
+		t.Errorf("Expected the caller to be the test, got %v", line["caller"])
+	}
+	if !strings.HasPrefix(buffer.String(), `{"time":`) {
+		t.Errorf("Expected time to come first, got %s", buffer.String())
+	}
+}
+
+func TestLoggerLogfmt(t *testing.T) {
+	logger, buffer := newTestLogger(LevelInfo, "logfmt")
+	logger.Info("Request handled", F("route", "/users/:id"), F("note", `say "hi"`), F("empty", ""))
+
+	line := buffer.String()
+	for _, pair := range []string{`level=info`, `msg="Request handled"`, `route=/users/:id`, `note="say \"hi\""`, `empty=""`} {
+		if !strings.Contains(line, pair) {
+			t.Errorf("Expected %s in %s", pair, line)
+		}
+	}
+}
+
+func TestLoggerLevel(t *testing.T) {
+	logger, buffer := newTestLogger(LevelWarn, "json")
+	logger.Debug("debug")
+	logger.Info("info")
+	logger.With(F("a", 1)).Warn("warn")
+	logger.Error("error")
+
+	lines := strings.Split(strings.TrimSpace(buffer.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Expected 2 lines, got %q", lines)
+	}
+	if !strings.Contains(lines[0], `"msg":"warn"`) || !strings.Contains(lines[1], `"msg":"error"`) {
+		t.Errorf("Expected the warn and error lines, got %q", lines)
+	}
+}
+
+func TestLoggerEnabled(t *testing.T) {
+	logger, _ := newTestLogger(LevelWarn, "json")
+	derived := logger.With(F("a", 1))
+	for level, expected := range map[int]bool{LevelDebug: false, LevelInfo: false, LevelWarn: true, LevelError: true} {
+		if derived.Enabled(level) != expected {
+			t.Errorf("Expected %s to be enabled: %v", LogLevels[level], expected)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+// Package phone parses phone numbers into their E.164 form, e.g.
+// "+12015550123", using the libphonenumber metadata. Numbers written without
+// a country code are read as national numbers of a default region.
+package phone
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/nyaruka/phonenumbers"
+)
+
+var ErrInvalid = errors.New("phone number is invalid")
+
+// IsSupportedRegion reports whether national numbers of a region can be
+// parsed
+func IsSupportedRegion(code string) bool {
+	return phonenumbers.GetSupportedRegions()[strings.ToUpper(code)]
+}
+
+// Normalize returns the E.164 form of number. Formatting such as spaces,
+// dots, dashes and parentheses is ignored. A number without a leading + or
+// international prefix is read as a national number of defaultRegion. The
+// number must be valid for its region, not merely of a plausible length.
+func Normalize(number string, defaultRegion string) (string, error) {
+	parsed, err := phonenumbers.Parse(number, strings.ToUpper(defaultRegion))
+	if err != nil || !phonenumbers.IsValidNumber(parsed) {
+		return "", ErrInvalid
+	}
+	return phonenumbers.Format(parsed, phonenumbers.E164), nil
+}
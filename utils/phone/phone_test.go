@@ -0,0 +1,89 @@
+package phone
+
+import (
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+
+	tc := []struct {
+		name           string
+		number         string
+		defaultRegion  string
+		expectedNumber string
+		expectedError  error
+	}{
+		{
+			name:           "International number with separators",
+			number:         "+1 201-555-0123",
+			defaultRegion:  "US",
+			expectedNumber: "+12015550123",
+		},
+		{
+			name:           "National number with trunk prefix",
+			number:         "1 201 555 0123",
+			defaultRegion:  "US",
+			expectedNumber: "+12015550123",
+		},
+		{
+			name:           "National number without trunk prefix",
+			number:         "(201) 555-0123",
+			defaultRegion:  "US",
+			expectedNumber: "+12015550123",
+		},
+		{
+			name:           "National number of another region",
+			number:         "020 7946 0958",
+			defaultRegion:  "GB",
+			expectedNumber: "+442079460958",
+		},
+		{
+			name:           "International prefix of the region",
+			number:         "0044 20 7946 0958",
+			defaultRegion:  "IN",
+			expectedNumber: "+442079460958",
+		},
+		{
+			name:          "Letters",
+			number:        "call me",
+			defaultRegion: "US",
+			expectedError: ErrInvalid,
+		},
+		{
+			name:          "Unassigned area code",
+			number:        "(555) 123-4567",
+			defaultRegion: "US",
+			expectedError: ErrInvalid,
+		},
+		{
+			name:          "Too long",
+			number:        "+1234567890123456",
+			defaultRegion: "US",
+			expectedError: ErrInvalid,
+		},
+		{
+			name:          "Too short",
+			number:        "123",
+			defaultRegion: "US",
+			expectedError: ErrInvalid,
+		},
+		{
+			name:          "Unsupported region",
+			number:        "2015550123",
+			defaultRegion: "XX",
+			expectedError: ErrInvalid,
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			number, err := Normalize(tt.number, tt.defaultRegion)
+			if err != tt.expectedError {
+				t.Errorf("Expected error %v, got %v", tt.expectedError, err)
+			}
+			if number != tt.expectedNumber {
+				t.Errorf("Expected number %s, got %s", tt.expectedNumber, number)
+			}
+		})
+	}
+}